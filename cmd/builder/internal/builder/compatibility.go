@@ -0,0 +1,250 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package builder // import "go.opentelemetry.io/collector/cmd/builder/internal/builder"
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
+)
+
+const (
+	collectorModulePrefix = "go.opentelemetry.io/collector"
+	contribModulePrefix   = "github.com/open-telemetry/opentelemetry-collector-contrib"
+)
+
+// ErrIncompatibleVersions is returned when components require core collector versions newer than the builder's
+var ErrIncompatibleVersions = errors.New("components require incompatible core collector versions")
+
+// componentConflict describes a component requiring core collector modules
+// at versions newer than the ones the builder builds with, or at other major versions.
+type componentConflict struct {
+	component  string
+	module     string
+	required   []string
+	suggestion string
+}
+
+// CheckCompatibility resolves the go.opentelemetry.io/collector/* modules required by
+// every component and reports the components requiring core versions newer than the
+// ones used by the builder, or other major versions. Older minor versions are fine, as
+// minimal version selection upgrades them to the builder's.
+func CheckCompatibility(cfg *Config) error {
+	cfg.Logger.Info("Checking components compatibility")
+
+	conflicts, err := findConflicts(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to check components compatibility: %w", err)
+	}
+	if len(conflicts) == 0 {
+		cfg.Logger.Info("All components are compatible")
+		return nil
+	}
+	return fmt.Errorf("%w: expected at most %s and %s\n%s", ErrIncompatibleVersions,
+		semver.MajorMinor(defaultBetaOtelColVersion), semver.MajorMinor(defaultStableOtelColVersion), conflictsTable(conflicts))
+}
+
+func findConflicts(cfg *Config) ([]componentConflict, error) {
+	replaces := parseReplaces(cfg.Replaces, cfg.Distribution.OutputPath)
+
+	// Resolve the go.mod files of all the remote modules in a single go command.
+	var queries []string
+	for _, mod := range cfg.allComponents() {
+		module, version, _ := strings.Cut(mod.GoMod, " ")
+		if mod.Path != "" || version == "" {
+			continue
+		}
+		if r, ok := replaces[module]; ok {
+			if r.path != "" {
+				continue
+			}
+			module, version = r.module, r.version
+		}
+		queries = append(queries, module+"@"+version)
+	}
+	goModFiles := map[string]string{}
+	if len(queries) > 0 {
+		stdout, err := runGoCommandInTempDir(cfg, append([]string{"list", "-m", "-json"}, queries...)...)
+		if err != nil {
+			return nil, err
+		}
+		modules, err := parseModuleList(stdout)
+		if err != nil {
+			return nil, err
+		}
+		for _, mod := range modules {
+			goModFiles[mod.Path+"@"+mod.Version] = mod.GoMod
+		}
+	}
+
+	var conflicts []componentConflict
+	for _, kind := range componentKinds(cfg) {
+		for _, mod := range kind.mods {
+			module, version, _ := strings.Cut(mod.GoMod, " ")
+			var goModFile string
+			switch r, replaced := replaces[module]; {
+			case mod.Path != "":
+				goModFile = filepath.Join(mod.Path, "go.mod")
+			case replaced && r.path != "":
+				goModFile = filepath.Join(r.path, "go.mod")
+			case replaced:
+				goModFile = goModFiles[r.module+"@"+r.version]
+			default:
+				goModFile = goModFiles[module+"@"+version]
+			}
+			if goModFile == "" {
+				// Without a version, the module is resolved by "go mod tidy" later on.
+				continue
+			}
+
+			required, err := incompatibleRequirements(goModFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read the go.mod of module %q: %w", module, err)
+			}
+			if len(required) > 0 {
+				conflicts = append(conflicts, componentConflict{
+					component:  kind.name + "/" + mod.Name,
+					module:     mod.GoMod,
+					required:   required,
+					suggestion: suggestVersion(module, version),
+				})
+			}
+		}
+	}
+	return conflicts, nil
+}
+
+// incompatibleRequirements returns the distinct major.minor versions of the core collector
+// modules required by the given go.mod file incompatible with the versions used by the builder.
+func incompatibleRequirements(goModFile string) ([]string, error) {
+	data, err := os.ReadFile(filepath.Clean(goModFile))
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := modfile.ParseLax(goModFile, data, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var required []string
+	for _, req := range parsed.Require {
+		if req.Mod.Path != collectorModulePrefix && !strings.HasPrefix(req.Mod.Path, collectorModulePrefix+"/") {
+			continue
+		}
+		if mm := semver.MajorMinor(req.Mod.Version); isIncompatible(req.Mod.Version) && !slices.Contains(required, mm) {
+			required = append(required, mm)
+		}
+	}
+	semver.Sort(required)
+	return required, nil
+}
+
+// isIncompatible reports whether a core module version has a major version the builder doesn't use,
+// or a minor version newer than the builder's. Older minor versions are upgraded by minimal version selection.
+func isIncompatible(version string) bool {
+	var builderVersion string
+	switch semver.Major(version) {
+	case semver.Major(defaultStableOtelColVersion):
+		builderVersion = defaultStableOtelColVersion
+	case semver.Major(defaultBetaOtelColVersion):
+		builderVersion = defaultBetaOtelColVersion
+	default:
+		return true
+	}
+	return semver.Compare(semver.MajorMinor(version), semver.MajorMinor(builderVersion)) > 0
+}
+
+// suggestVersion suggests a version of a component module compatible with the builder.
+func suggestVersion(module, version string) string {
+	if module == collectorModulePrefix || strings.HasPrefix(module, collectorModulePrefix+"/") || strings.HasPrefix(module, contribModulePrefix+"/") {
+		// These modules are released alongside the core modules.
+		if semver.Major(version) == semver.Major(defaultStableOtelColVersion) {
+			return module + " " + defaultStableOtelColVersion
+		}
+		return module + " " + defaultBetaOtelColVersion
+	}
+	return fmt.Sprintf("a version of %s built with core %s", module, semver.MajorMinor(defaultBetaOtelColVersion))
+}
+
+func conflictsTable(conflicts []componentConflict) string {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "COMPONENT\tMODULE\tREQUIRED CORE VERSIONS\tSUGGESTION")
+	for _, c := range conflicts {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", c.component, c.module, strings.Join(c.required, ", "), c.suggestion)
+	}
+	_ = w.Flush()
+	return buf.String()
+}
+
+type replacement struct {
+	module  string
+	version string
+	path    string
+}
+
+// parseReplaces parses the "old [version] => new [version]" replace directives by replaced module.
+// Relative local paths are resolved from the output path, where the go.mod is generated.
+func parseReplaces(replaces []string, outputPath string) map[string]replacement {
+	parsed := map[string]replacement{}
+	for _, r := range replaces {
+		oldSpec, newSpec, ok := strings.Cut(r, "=>")
+		if !ok {
+			continue
+		}
+		oldFields, newFields := strings.Fields(oldSpec), strings.Fields(newSpec)
+		if len(oldFields) == 0 || len(newFields) == 0 {
+			continue
+		}
+		if len(newFields) == 1 {
+			path := newFields[0]
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(outputPath, path)
+			}
+			parsed[oldFields[0]] = replacement{path: path}
+			continue
+		}
+		parsed[oldFields[0]] = replacement{module: newFields[0], version: newFields[1]}
+	}
+	return parsed
+}
+
+type componentKind struct {
+	name string
+	mods []Module
+}
+
+func componentKinds(cfg *Config) []componentKind {
+	return []componentKind{
+		{"extension", cfg.Extensions},
+		{"receiver", cfg.Receivers},
+		{"exporter", cfg.Exporters},
+		{"processor", cfg.Processors},
+		{"connector", cfg.Connectors},
+		{"provider", cfg.ConfmapProviders},
+		{"converter", cfg.ConfmapConverters},
+	}
+}
+
+// runGoCommandInTempDir runs a go command outside of the generated module,
+// so the module queries aren't affected by its go.mod.
+func runGoCommandInTempDir(cfg *Config, args ...string) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "ocb-check")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	tmpCfg := *cfg
+	tmpCfg.Distribution.OutputPath = dir
+	return runGoCommand(&tmpCfg, args...)
+}
@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+const compatibleGoModTestFile = `module github.com/example/compatiblereceiver
+go 1.22
+require (
+	go.opentelemetry.io/collector/component v0.115.0
+	go.opentelemetry.io/collector/pdata v1.21.0
+	go.opentelemetry.io/collector/receiver v0.115.0
+	go.uber.org/zap v1.27.0
+)`
+
+const incompatibleGoModTestFile = `module go.opentelemetry.io/collector/cmd/builder/internal/tester
+go 1.22
+require (
+	go.opentelemetry.io/collector/component v0.116.0
+	go.opentelemetry.io/collector/pdata v1.22.0
+	go.opentelemetry.io/collector/pdata/v2 v2.0.0
+	go.opentelemetry.io/collector/receiver v0.94.1
+)`
+
+func TestParseReplaces(t *testing.T) {
+	replaces := parseReplaces([]string{
+		"github.com/example/a => /abs/a",
+		"github.com/example/b v1.0.0 => ../b",
+		"github.com/example/c => github.com/fork/c v1.2.0",
+		"invalid",
+	}, "/output")
+	assert.Equal(t, map[string]replacement{
+		"github.com/example/a": {path: "/abs/a"},
+		"github.com/example/b": {path: "/b"},
+		"github.com/example/c": {module: "github.com/fork/c", version: "v1.2.0"},
+	}, replaces)
+}
+
+func TestIncompatibleRequirements(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, makeModule(dir, []byte(incompatibleGoModTestFile)))
+	required, err := incompatibleRequirements(filepath.Join(dir, "go.mod"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v0.116", "v1.22", "v2.0"}, required)
+
+	require.NoError(t, makeModule(dir, []byte(compatibleGoModTestFile)))
+	required, err = incompatibleRequirements(filepath.Join(dir, "go.mod"))
+	require.NoError(t, err)
+	assert.Empty(t, required)
+
+	// Older minor versions are upgraded to the builder's by minimal version selection.
+	require.NoError(t, makeModule(dir, []byte(goModTestFile)))
+	required, err = incompatibleRequirements(filepath.Join(dir, "go.mod"))
+	require.NoError(t, err)
+	assert.Empty(t, required)
+
+	_, err = incompatibleRequirements(filepath.Join(t.TempDir(), "go.mod"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestIsIncompatible(t *testing.T) {
+	assert.False(t, isIncompatible(defaultBetaOtelColVersion))
+	assert.False(t, isIncompatible(defaultStableOtelColVersion))
+	assert.False(t, isIncompatible("v0.94.1"))
+	assert.False(t, isIncompatible("v1.5.0"))
+	assert.False(t, isIncompatible("v0.115.1"))
+	assert.True(t, isIncompatible("v0.116.0"))
+	assert.True(t, isIncompatible("v1.22.0"))
+	assert.True(t, isIncompatible("v2.0.0"))
+}
+
+func TestSuggestVersion(t *testing.T) {
+	assert.Equal(t, "go.opentelemetry.io/collector/exporter/otlpexporter "+defaultBetaOtelColVersion,
+		suggestVersion("go.opentelemetry.io/collector/exporter/otlpexporter", "v0.100.0"))
+	assert.Equal(t, "go.opentelemetry.io/collector/confmap/provider/envprovider "+defaultStableOtelColVersion,
+		suggestVersion("go.opentelemetry.io/collector/confmap/provider/envprovider", "v1.5.0"))
+	assert.Equal(t, "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver "+defaultBetaOtelColVersion,
+		suggestVersion("github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver", "v0.100.0"))
+	assert.Equal(t, "a version of github.com/example/receiver built with core v0.115",
+		suggestVersion("github.com/example/receiver", "v1.0.0"))
+}
+
+func TestCheckCompatibility(t *testing.T) {
+	compatibleDir := t.TempDir()
+	require.NoError(t, makeModule(compatibleDir, []byte(compatibleGoModTestFile)))
+	incompatibleDir := t.TempDir()
+	require.NoError(t, makeModule(incompatibleDir, []byte(incompatibleGoModTestFile)))
+
+	cfg := newTestConfig(t)
+	cfg.ConfmapProviders = nil
+	cfg.Receivers = []Module{{
+		GoMod: "github.com/example/compatiblereceiver v1.0.0",
+		Path:  compatibleDir,
+	}}
+	require.NoError(t, cfg.ParseModules())
+	require.NoError(t, CheckCompatibility(cfg))
+
+	cfg.Exporters = []Module{{
+		GoMod: "go.opentelemetry.io/collector/cmd/builder/internal/tester v0.96.0",
+		Name:  "tester",
+	}}
+	cfg.Replaces = []string{"go.opentelemetry.io/collector/cmd/builder/internal/tester => " + incompatibleDir}
+	require.NoError(t, cfg.ParseModules())
+	err := CheckCompatibility(cfg)
+	require.ErrorIs(t, err, ErrIncompatibleVersions)
+	assert.Contains(t, err.Error(), "COMPONENT")
+	assert.Contains(t, err.Error(), "exporter/tester")
+	assert.Contains(t, err.Error(), "v0.116, v1.22, v2.0")
+	assert.Contains(t, err.Error(), "go.opentelemetry.io/collector/cmd/builder/internal/tester "+defaultBetaOtelColVersion)
+	assert.NotContains(t, err.Error(), "compatiblereceiver")
+
+	// In check-only mode, nothing is generated.
+	cfg.CheckOnly = true
+	cfg.Distribution.OutputPath = t.TempDir()
+	require.ErrorIs(t, GenerateAndCompile(cfg), ErrIncompatibleVersions)
+	entries, err := os.ReadDir(cfg.Distribution.OutputPath)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestGetModulesCompatibilityCheckFailure(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	cfg := newInitializedConfig(t)
+	cfg.Logger = zap.New(core)
+	cfg.Distribution.OutputPath = t.TempDir()
+	// Both the compatibility check and "go mod tidy" fail to run the go command.
+	cfg.Distribution.Go = filepath.Join(t.TempDir(), "go")
+
+	err := GetModules(cfg)
+	require.ErrorContains(t, err, "failed to update go.mod")
+	assert.Equal(t, 1, logs.FilterMessage("Could not check the compatibility of the components").Len())
+
+	// The check is skipped without strict versioning.
+	logs.TakeAll()
+	cfg.SkipStrictVersioning = true
+	err = GetModules(cfg)
+	require.ErrorContains(t, err, "failed to update go.mod")
+	assert.Zero(t, logs.FilterMessage("Checking components compatibility").Len())
+
+	// The check fails in check-only mode.
+	cfg.CheckOnly = true
+	require.ErrorContains(t, GenerateAndCompile(cfg), "failed to check components compatibility")
+}
@@ -15,6 +15,7 @@ import (
 
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
+	"golang.org/x/mod/modfile"
 )
 
 const (
@@ -22,8 +23,17 @@ const (
 	defaultStableOtelColVersion = "v1.21.0"
 )
 
-// errMissingGoMod indicates an empty gomod field
-var errMissingGoMod = errors.New("missing gomod specification for module")
+// localModuleVersion is the version required for modules only specified by their local path.
+const localModuleVersion = "v0.0.0"
+
+var (
+	// errMissingGoMod indicates an empty gomod field
+	errMissingGoMod = errors.New("missing gomod specification for module")
+	// errMissingDistributionName indicates an empty name for one of several distributions
+	errMissingDistributionName = errors.New("missing name")
+	// errDuplicateDistribution indicates several distributions with the same name
+	errDuplicateDistribution = errors.New("duplicate distribution name")
+)
 
 // Config holds the builder's configuration
 type Config struct {
@@ -34,6 +44,7 @@ type Config struct {
 	SkipCompilation      bool   `mapstructure:"-"`
 	SkipGetModules       bool   `mapstructure:"-"`
 	SkipStrictVersioning bool   `mapstructure:"-"`
+	CheckOnly            bool   `mapstructure:"-"`
 	LDFlags              string `mapstructure:"-"`
 	Verbose              bool   `mapstructure:"-"`
 
@@ -49,6 +60,11 @@ type Config struct {
 	Excludes          []string     `mapstructure:"excludes"`
 
 	ConfResolver ConfResolver `mapstructure:"conf_resolver"`
+	Outputs      Outputs      `mapstructure:"outputs"`
+
+	// Distributions lists the distributions to build from this configuration, each of them
+	// including the components listed above in addition to its own ones.
+	Distributions []DistributionConfig `mapstructure:"distributions"`
 
 	downloadModules retry `mapstructure:"-"`
 }
@@ -74,6 +90,36 @@ type Distribution struct {
 	DebugCompilation bool   `mapstructure:"debug_compilation"`
 }
 
+// DistributionConfig holds the parameters and additional components of one of the
+// distributions built from a single configuration
+type DistributionConfig struct {
+	Distribution      Distribution `mapstructure:"dist"`
+	Exporters         []Module     `mapstructure:"exporters"`
+	Extensions        []Module     `mapstructure:"extensions"`
+	Receivers         []Module     `mapstructure:"receivers"`
+	Processors        []Module     `mapstructure:"processors"`
+	Connectors        []Module     `mapstructure:"connectors"`
+	ConfmapProviders  []Module     `mapstructure:"providers"`
+	ConfmapConverters []Module     `mapstructure:"converters"`
+	Replaces          []string     `mapstructure:"replaces"`
+	Excludes          []string     `mapstructure:"excludes"`
+}
+
+// Outputs holds the optional artifacts generated alongside the distribution
+type Outputs struct {
+	// Dockerfile enables generating a minimal Dockerfile for the distribution binary.
+	Dockerfile bool `mapstructure:"dockerfile"`
+	// BaseImage is the image used to fetch the CA certificates in the generated Dockerfile.
+	BaseImage string `mapstructure:"base_image"`
+	// SkipCACertificatesInstall skips installing the CA certificates in the base image, which must then
+	// already include them in /etc/ssl/certs/ca-certificates.crt, e.g. distroless images without a shell.
+	SkipCACertificatesInstall bool `mapstructure:"skip_ca_certificates_install"`
+	// SBOM is the format of the software bill of materials to generate, either "cyclonedx" or "spdx".
+	SBOM string `mapstructure:"sbom"`
+	// Manifest enables generating a JSON manifest of the components included in the distribution.
+	Manifest bool `mapstructure:"manifest"`
+}
+
 // Module represents a receiver, exporter, processor or extension for the distribution
 type Module struct {
 	Name   string `mapstructure:"name"`   // if not specified, this is package part of the go mod (last part of the path)
@@ -106,6 +152,9 @@ func NewDefaultConfig() (*Config, error) {
 			OutputPath: outputDir,
 			Module:     "go.opentelemetry.io/collector/cmd/builder",
 		},
+		Outputs: Outputs{
+			BaseImage: defaultBaseImage,
+		},
 		// basic retry if error from go mod command (in case of transient network error).
 		// retry 3 times with 5 second spacing interval
 		downloadModules: retry{
@@ -138,6 +187,7 @@ func (c *Config) Validate() error {
 		return errors.New("`otelcol_version` has been removed. To build with an older Collector API, use an older (aligned) builder version instead")
 	}
 	return multierr.Combine(
+		c.Outputs.Validate(),
 		validateModules("extension", c.Extensions),
 		validateModules("receiver", c.Receivers),
 		validateModules("exporter", c.Exporters),
@@ -145,9 +195,97 @@ func (c *Config) Validate() error {
 		validateModules("connector", c.Connectors),
 		validateModules("provider", c.ConfmapProviders),
 		validateModules("converter", c.ConfmapConverters),
+		c.validateDistributions(),
 	)
 }
 
+func (c *Config) validateDistributions() error {
+	var errs error
+	names := map[string]bool{}
+	for i, d := range c.Distributions {
+		if d.Distribution.OtelColVersion != "" {
+			errs = multierr.Append(errs, fmt.Errorf("distribution at index %v: `otelcol_version` has been removed", i))
+		}
+		if d.Distribution.Name == "" {
+			errs = multierr.Append(errs, fmt.Errorf("distribution at index %v: %w", i, errMissingDistributionName))
+			continue
+		}
+		if names[d.Distribution.Name] {
+			errs = multierr.Append(errs, fmt.Errorf("distribution %q: %w", d.Distribution.Name, errDuplicateDistribution))
+		}
+		names[d.Distribution.Name] = true
+		errs = multierr.Append(errs, multierr.Combine(
+			validateModules("extension", d.Extensions),
+			validateModules("receiver", d.Receivers),
+			validateModules("exporter", d.Exporters),
+			validateModules("processor", d.Processors),
+			validateModules("connector", d.Connectors),
+			validateModules("provider", d.ConfmapProviders),
+			validateModules("converter", d.ConfmapConverters),
+		))
+	}
+	return errs
+}
+
+// DistributionConfigs returns the configurations of the distributions to build. Without
+// "distributions", this is the configuration itself. Otherwise, there is one configuration
+// per distribution, including the shared components, replaces and excludes, and writing
+// by default to a sub-directory of the output path named after the distribution.
+func (c *Config) DistributionConfigs() []*Config {
+	if len(c.Distributions) == 0 {
+		return []*Config{c}
+	}
+
+	cfgs := make([]*Config, 0, len(c.Distributions))
+	for _, d := range c.Distributions {
+		cfg := *c
+		cfg.Distributions = nil
+		cfg.Distribution = mergeDistribution(c.Distribution, d.Distribution)
+		if d.Distribution.OutputPath == "" {
+			cfg.Distribution.OutputPath = filepath.Join(c.Distribution.OutputPath, d.Distribution.Name)
+		}
+		cfg.Exporters = slices.Concat(c.Exporters, d.Exporters)
+		cfg.Extensions = slices.Concat(c.Extensions, d.Extensions)
+		cfg.Receivers = slices.Concat(c.Receivers, d.Receivers)
+		cfg.Processors = slices.Concat(c.Processors, d.Processors)
+		cfg.Connectors = slices.Concat(c.Connectors, d.Connectors)
+		cfg.ConfmapProviders = slices.Concat(c.ConfmapProviders, d.ConfmapProviders)
+		cfg.ConfmapConverters = slices.Concat(c.ConfmapConverters, d.ConfmapConverters)
+		cfg.Replaces = slices.Concat(c.Replaces, d.Replaces)
+		cfg.Excludes = slices.Concat(c.Excludes, d.Excludes)
+		cfgs = append(cfgs, &cfg)
+	}
+	return cfgs
+}
+
+// mergeDistribution overrides the shared distribution parameters with the ones set for a distribution.
+func mergeDistribution(shared, dist Distribution) Distribution {
+	merged := shared
+	if dist.Module != "" {
+		merged.Module = dist.Module
+	}
+	if dist.Name != "" {
+		merged.Name = dist.Name
+	}
+	if dist.Go != "" {
+		merged.Go = dist.Go
+	}
+	if dist.Description != "" {
+		merged.Description = dist.Description
+	}
+	if dist.OutputPath != "" {
+		merged.OutputPath = dist.OutputPath
+	}
+	if dist.Version != "" {
+		merged.Version = dist.Version
+	}
+	if dist.BuildTags != "" {
+		merged.BuildTags = dist.BuildTags
+	}
+	merged.DebugCompilation = shared.DebugCompilation || dist.DebugCompilation
+	return merged
+}
+
 // SetGoPath sets go path
 func (c *Config) SetGoPath() error {
 	if !c.SkipCompilation || !c.SkipGetModules {
@@ -201,7 +339,9 @@ func (c *Config) ParseModules() error {
 	if err != nil {
 		return err
 	}
-	return nil
+
+	c.Replaces, err = addLocalReplaces(c.Replaces, c.allComponents())
+	return err
 }
 
 func (c *Config) allComponents() []Module {
@@ -210,7 +350,7 @@ func (c *Config) allComponents() []Module {
 
 func validateModules(name string, mods []Module) error {
 	for i, mod := range mods {
-		if mod.GoMod == "" {
+		if mod.GoMod == "" && mod.Path == "" {
 			return fmt.Errorf("%s module at index %v: %w", name, i, errMissingGoMod)
 		}
 	}
@@ -220,6 +360,15 @@ func validateModules(name string, mods []Module) error {
 func parseModules(mods []Module) ([]Module, error) {
 	var parsedModules []Module
 	for _, mod := range mods {
+		if mod.GoMod == "" && mod.Path != "" {
+			// Infer the module from the local copy, which replaces any version.
+			goMod, err := readLocalGoMod(mod.Path)
+			if err != nil {
+				return mods, err
+			}
+			mod.GoMod = goMod.Module.Mod.Path + " " + localModuleVersion
+		}
+
 		if mod.Import == "" {
 			mod.Import = strings.Split(mod.GoMod, " ")[0]
 		}
@@ -247,3 +396,67 @@ func parseModules(mods []Module) ([]Module, error) {
 
 	return parsedModules, nil
 }
+
+// addLocalReplaces adds the replace directives pointing to local paths found in the go.mod
+// of the local modules, and transitively in the go.mod of the replacements, so that a local
+// component depending on other local modules doesn't need listing them in "replaces".
+func addLocalReplaces(replaces []string, mods []Module) ([]string, error) {
+	replaced := map[string]bool{}
+	for _, r := range replaces {
+		oldSpec, _, _ := strings.Cut(r, "=>")
+		if fields := strings.Fields(oldSpec); len(fields) > 0 {
+			replaced[fields[0]] = true
+		}
+	}
+
+	var dirs []string
+	for _, mod := range mods {
+		if mod.Path == "" {
+			continue
+		}
+		replaced[strings.Split(mod.GoMod, " ")[0]] = true
+		dirs = append(dirs, mod.Path)
+	}
+
+	for len(dirs) > 0 {
+		dir := dirs[0]
+		dirs = dirs[1:]
+		goMod, err := readLocalGoMod(dir)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return replaces, err
+		}
+		for _, r := range goMod.Replace {
+			if r.New.Version != "" || replaced[r.Old.Path] {
+				// Only local replacements are carried over.
+				continue
+			}
+			path := r.New.Path
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(dir, path)
+			}
+			replaced[r.Old.Path] = true
+			replaces = append(replaces, r.Old.Path+" => "+path)
+			dirs = append(dirs, path)
+		}
+	}
+	return replaces, nil
+}
+
+func readLocalGoMod(dir string) (*modfile.File, error) {
+	goModPath := filepath.Join(dir, "go.mod")
+	data, err := os.ReadFile(filepath.Clean(goModPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the go.mod of local module: %w", err)
+	}
+	goMod, err := modfile.Parse(goModPath, data, nil)
+	if err != nil {
+		return nil, err
+	}
+	if goMod.Module == nil {
+		return nil, fmt.Errorf("missing module directive in %s", goModPath)
+	}
+	return goMod, nil
+}
@@ -5,6 +5,7 @@ package builder
 
 import (
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -269,3 +270,132 @@ func TestValidateDeprecatedOtelColVersion(t *testing.T) {
 	cfg.Distribution.OtelColVersion = "test"
 	assert.Error(t, cfg.Validate())
 }
+
+func TestLocalModuleWithoutGoMod(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, makeModule(filepath.Join(dir, "myreceiver"), []byte(`module github.com/example/monorepo/myreceiver
+go 1.22
+require github.com/example/monorepo/internal/common v0.0.0
+replace github.com/example/monorepo/internal/common => ../internal/common
+replace go.uber.org/zap => go.uber.org/zap v1.27.0
+`)))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "internal"), 0o750))
+	require.NoError(t, makeModule(filepath.Join(dir, "internal", "common"), []byte(`module github.com/example/monorepo/internal/common
+go 1.22
+replace github.com/example/monorepo/internal/shared => ../shared
+replace github.com/example/monorepo/internal/overridden => ../overridden
+`)))
+
+	cfg := Config{
+		Receivers: []Module{{
+			Path: filepath.Join(dir, "myreceiver"),
+		}},
+		Replaces: []string{"github.com/example/monorepo/internal/overridden => /somewhere/else"},
+	}
+	require.NoError(t, cfg.Validate())
+	require.NoError(t, cfg.ParseModules())
+
+	assert.Equal(t, "github.com/example/monorepo/myreceiver v0.0.0", cfg.Receivers[0].GoMod)
+	assert.Equal(t, "github.com/example/monorepo/myreceiver", cfg.Receivers[0].Import)
+	assert.Equal(t, "myreceiver", cfg.Receivers[0].Name)
+	assert.Equal(t, []string{
+		"github.com/example/monorepo/internal/overridden => /somewhere/else",
+		"github.com/example/monorepo/internal/common => " + filepath.Join(dir, "internal", "common"),
+		"github.com/example/monorepo/internal/shared => " + filepath.Join(dir, "internal", "shared"),
+	}, cfg.Replaces)
+
+	// Parsing the modules again doesn't duplicate the replaces.
+	require.NoError(t, cfg.ParseModules())
+	assert.Len(t, cfg.Replaces, 3)
+}
+
+func TestLocalModuleWithoutGoModFile(t *testing.T) {
+	cfg := Config{
+		Receivers: []Module{{
+			Path: t.TempDir(),
+		}},
+	}
+	require.ErrorIs(t, cfg.ParseModules(), os.ErrNotExist)
+}
+
+func TestDistributionConfigs(t *testing.T) {
+	cfg := Config{
+		Distribution: Distribution{
+			Module:     "github.com/example/monorepo/distributions",
+			Version:    "1.0.0",
+			OutputPath: "/tmp/dist",
+		},
+		Receivers: []Module{{GoMod: "go.opentelemetry.io/collector/receiver/otlpreceiver v0.115.0"}},
+		Exporters: []Module{{GoMod: "go.opentelemetry.io/collector/exporter/otlpexporter v0.115.0"}},
+		Replaces:  []string{"github.com/example/a => ../a"},
+		Distributions: []DistributionConfig{
+			{
+				Distribution: Distribution{Name: "otelcol-edge"},
+			},
+			{
+				Distribution: Distribution{
+					Name:       "otelcol-gateway",
+					Version:    "2.0.0",
+					OutputPath: "/tmp/gateway",
+				},
+				Processors: []Module{{GoMod: "go.opentelemetry.io/collector/processor/batchprocessor v0.115.0"}},
+				Exporters:  []Module{{GoMod: "go.opentelemetry.io/collector/exporter/debugexporter v0.115.0"}},
+				Replaces:   []string{"github.com/example/b => ../b"},
+			},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	cfgs := cfg.DistributionConfigs()
+	require.Len(t, cfgs, 2)
+
+	edge := cfgs[0]
+	assert.Empty(t, edge.Distributions)
+	assert.Equal(t, Distribution{
+		Module:     "github.com/example/monorepo/distributions",
+		Name:       "otelcol-edge",
+		Version:    "1.0.0",
+		OutputPath: filepath.Join("/tmp/dist", "otelcol-edge"),
+	}, edge.Distribution)
+	assert.Equal(t, cfg.Receivers, edge.Receivers)
+	assert.Equal(t, cfg.Exporters, edge.Exporters)
+	assert.Empty(t, edge.Processors)
+	assert.Equal(t, cfg.Replaces, edge.Replaces)
+
+	gateway := cfgs[1]
+	assert.Equal(t, Distribution{
+		Module:     "github.com/example/monorepo/distributions",
+		Name:       "otelcol-gateway",
+		Version:    "2.0.0",
+		OutputPath: "/tmp/gateway",
+	}, gateway.Distribution)
+	assert.Equal(t, cfg.Receivers, gateway.Receivers)
+	assert.Len(t, gateway.Exporters, 2)
+	assert.Len(t, gateway.Processors, 1)
+	assert.Equal(t, []string{"github.com/example/a => ../a", "github.com/example/b => ../b"}, gateway.Replaces)
+
+	// The shared lists aren't modified.
+	assert.Len(t, cfg.Exporters, 1)
+	assert.Len(t, cfg.Replaces, 1)
+
+	single := Config{}
+	assert.Equal(t, []*Config{&single}, single.DistributionConfigs())
+}
+
+func TestValidateDistributions(t *testing.T) {
+	cfg := Config{
+		Distributions: []DistributionConfig{
+			{Distribution: Distribution{Name: "otelcol-edge"}},
+			{Distribution: Distribution{Name: "otelcol-edge"}},
+			{Distribution: Distribution{}},
+			{
+				Distribution: Distribution{Name: "otelcol-gateway"},
+				Receivers:    []Module{{Import: "invalid"}},
+			},
+		},
+	}
+	err := cfg.Validate()
+	require.ErrorIs(t, err, errDuplicateDistribution)
+	require.ErrorIs(t, err, errMissingDistributionName)
+	require.ErrorIs(t, err, errMissingGoMod)
+}
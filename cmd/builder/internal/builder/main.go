@@ -56,6 +56,10 @@ func runGoCommand(cfg *Config, args ...string) ([]byte, error) {
 
 // GenerateAndCompile will generate the source files based on the given configuration, update go mod, and will compile into a binary
 func GenerateAndCompile(cfg *Config) error {
+	if cfg.CheckOnly {
+		return CheckCompatibility(cfg)
+	}
+
 	if err := Generate(cfg); err != nil {
 		return err
 	}
@@ -65,6 +69,10 @@ func GenerateAndCompile(cfg *Config) error {
 		return err
 	}
 
+	if err := GenerateOutputs(cfg); err != nil {
+		return err
+	}
+
 	return Compile(cfg)
 }
 
@@ -96,6 +104,12 @@ func Generate(cfg *Config) error {
 		}
 	}
 
+	if cfg.Outputs.Dockerfile {
+		if err := processAndWrite(cfg, dockerfileTemplate, dockerfileTemplate.Name(), cfg); err != nil {
+			return fmt.Errorf("failed to generate %q: %w", dockerfileTemplate.Name(), err)
+		}
+	}
+
 	cfg.Logger.Info("Sources created", zap.String("path", cfg.Distribution.OutputPath))
 	return nil
 }
@@ -138,6 +152,18 @@ func GetModules(cfg *Config) error {
 		return nil
 	}
 
+	// Report incompatible components before "go mod tidy" fails with a less helpful error.
+	// The check is best effort: failing to run it, e.g. without network access, leaves the
+	// versions to the strict versioning check below. Only --check-only fails on such errors.
+	if !cfg.SkipStrictVersioning {
+		if err := CheckCompatibility(cfg); err != nil {
+			if errors.Is(err, ErrIncompatibleVersions) {
+				return err
+			}
+			cfg.Logger.Warn("Could not check the compatibility of the components", zap.Error(err))
+		}
+	}
+
 	if _, err := runGoCommand(cfg, "mod", "tidy", "-compat=1.22"); err != nil {
 		return fmt.Errorf("failed to update go.mod: %w", err)
 	}
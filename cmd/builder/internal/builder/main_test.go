@@ -86,6 +86,7 @@ var replaceModules = []string{
 	"/internal/memorylimiter",
 	"/internal/fanoutconsumer",
 	"/internal/sharedcomponent",
+	"/internal/consumecontract",
 	"/otelcol",
 	"/pipeline",
 	"/pipeline/pipelineprofiles",
@@ -293,6 +294,21 @@ func TestGenerateAndCompile(t *testing.T) {
 				return cfg
 			},
 		},
+		{
+			name: "With outputs",
+			cfgBuilder: func(t *testing.T) *Config {
+				cfg := newTestConfig(t)
+				cfg.Distribution.OutputPath = t.TempDir()
+				cfg.Replaces = append(cfg.Replaces, replaces...)
+				cfg.Outputs = Outputs{
+					Dockerfile: true,
+					BaseImage:  defaultBaseImage,
+					SBOM:       sbomFormatCycloneDX,
+					Manifest:   true,
+				}
+				return cfg
+			},
+		},
 		{
 			name: "ConfResolverDefaultURIScheme set",
 			cfgBuilder: func(t *testing.T) *Config {
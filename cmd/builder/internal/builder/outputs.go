@@ -0,0 +1,351 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package builder // import "go.opentelemetry.io/collector/cmd/builder/internal/builder"
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	sbomFormatCycloneDX = "cyclonedx"
+	sbomFormatSPDX      = "spdx"
+
+	defaultBaseImage = "alpine:3.20"
+
+	cycloneDXFileName = "sbom.cdx.json"
+	spdxFileName      = "sbom.spdx.json"
+	manifestFileName  = "components.json"
+)
+
+var errUnknownSBOMFormat = errors.New("unknown SBOM format")
+
+// Validate checks whether the outputs configuration is valid
+func (o Outputs) Validate() error {
+	switch o.SBOM {
+	case "", sbomFormatCycloneDX, sbomFormatSPDX:
+		return nil
+	}
+	return fmt.Errorf("%w %q, must be one of %q or %q", errUnknownSBOMFormat, o.SBOM, sbomFormatCycloneDX, sbomFormatSPDX)
+}
+
+// goModule is a module as reported by "go list -m -json".
+type goModule struct {
+	Path    string
+	Version string
+	Main    bool
+	GoMod   string
+	Replace *goModule
+}
+
+// GenerateOutputs writes the optional artifacts derived from the resolved go.mod
+// of the distribution: the SBOM and the components manifest.
+func GenerateOutputs(cfg *Config) error {
+	if cfg.Outputs.SBOM == "" && !cfg.Outputs.Manifest {
+		return nil
+	}
+
+	modules, err := listModules(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to list go modules: %w", err)
+	}
+
+	if cfg.Outputs.SBOM != "" {
+		fileName := cycloneDXFileName
+		var sbom any = newCycloneDXBOM(cfg, modules)
+		if cfg.Outputs.SBOM == sbomFormatSPDX {
+			fileName = spdxFileName
+			sbom = newSPDXDocument(cfg, modules, time.Now().UTC())
+		}
+		if err = writeJSON(cfg, fileName, sbom); err != nil {
+			return fmt.Errorf("failed to write SBOM: %w", err)
+		}
+		cfg.Logger.Info("SBOM created", zap.String("path", filepath.Join(cfg.Distribution.OutputPath, fileName)))
+	}
+
+	if cfg.Outputs.Manifest {
+		if err = writeJSON(cfg, manifestFileName, newManifest(cfg, modules)); err != nil {
+			return fmt.Errorf("failed to write components manifest: %w", err)
+		}
+		cfg.Logger.Info("Components manifest created", zap.String("path", filepath.Join(cfg.Distribution.OutputPath, manifestFileName)))
+	}
+
+	return nil
+}
+
+func listModules(cfg *Config) ([]goModule, error) {
+	stdout, err := runGoCommand(cfg, "list", "-m", "-json", "all")
+	if err != nil {
+		return nil, err
+	}
+	return parseModuleList(stdout)
+}
+
+// parseModuleList decodes the stream of JSON objects printed by "go list -m -json".
+func parseModuleList(data []byte) ([]goModule, error) {
+	var modules []goModule
+	dec := json.NewDecoder(bytes.NewReader(data))
+	for {
+		var mod goModule
+		if err := dec.Decode(&mod); err != nil {
+			if errors.Is(err, io.EOF) {
+				return modules, nil
+			}
+			return nil, err
+		}
+		modules = append(modules, mod)
+	}
+}
+
+// resolvedVersion returns the version of the module actually used in the build.
+func (m goModule) resolvedVersion() string {
+	if m.Replace != nil && m.Replace.Version != "" {
+		return m.Replace.Version
+	}
+	return m.Version
+}
+
+// ocbVersion returns the version of the running builder, as recorded in its build info.
+func ocbVersion() string {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		return info.Main.Version
+	}
+	return ""
+}
+
+func writeJSON(cfg *Config, fileName string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(cfg.Distribution.OutputPath, fileName), append(data, '\n'), 0o600)
+}
+
+func purl(path, version string) string {
+	if version == "" {
+		return "pkg:golang/" + path
+	}
+	return "pkg:golang/" + path + "@" + version
+}
+
+type cycloneDXBOM struct {
+	BOMFormat    string                `json:"bomFormat"`
+	SpecVersion  string                `json:"specVersion"`
+	Version      int                   `json:"version"`
+	Metadata     cycloneDXMetadata     `json:"metadata"`
+	Components   []cycloneDXComponent  `json:"components"`
+	Dependencies []cycloneDXDependency `json:"dependencies"`
+}
+
+type cycloneDXMetadata struct {
+	Tools     cycloneDXTools     `json:"tools"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTools struct {
+	Components []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXComponent struct {
+	BOMRef      string `json:"bom-ref,omitempty"`
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Version     string `json:"version,omitempty"`
+	Description string `json:"description,omitempty"`
+	PURL        string `json:"purl,omitempty"`
+}
+
+type cycloneDXDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn"`
+}
+
+func newCycloneDXBOM(cfg *Config, modules []goModule) cycloneDXBOM {
+	mainRef := purl(cfg.Distribution.Module, cfg.Distribution.Version)
+	bom := cycloneDXBOM{
+		BOMFormat:   "CycloneDX",
+		SpecVersion: "1.5",
+		Version:     1,
+		Metadata: cycloneDXMetadata{
+			Tools: cycloneDXTools{Components: []cycloneDXComponent{{
+				Type:    "application",
+				Name:    "ocb",
+				Version: ocbVersion(),
+			}}},
+			Component: cycloneDXComponent{
+				BOMRef:      mainRef,
+				Type:        "application",
+				Name:        cfg.Distribution.Name,
+				Version:     cfg.Distribution.Version,
+				Description: cfg.Distribution.Description,
+				PURL:        mainRef,
+			},
+		},
+		Components:   []cycloneDXComponent{},
+		Dependencies: []cycloneDXDependency{{Ref: mainRef, DependsOn: []string{}}},
+	}
+	for _, mod := range modules {
+		if mod.Main {
+			continue
+		}
+		ref := purl(mod.Path, mod.resolvedVersion())
+		bom.Components = append(bom.Components, cycloneDXComponent{
+			BOMRef:  ref,
+			Type:    "library",
+			Name:    mod.Path,
+			Version: mod.resolvedVersion(),
+			PURL:    ref,
+		})
+		bom.Dependencies[0].DependsOn = append(bom.Dependencies[0].DependsOn, ref)
+	}
+	return bom
+}
+
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SPDXID           string            `json:"SPDXID"`
+	Name             string            `json:"name"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+func newSPDXDocument(cfg *Config, modules []goModule, created time.Time) spdxDocument {
+	const mainID = "SPDXRef-Package-main"
+	doc := spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              cfg.Distribution.Name,
+		DocumentNamespace: fmt.Sprintf("https://%s/spdx/%s-%s", cfg.Distribution.Module, cfg.Distribution.Name, cfg.Distribution.Version),
+		CreationInfo: spdxCreationInfo{
+			Created:  created.Format(time.RFC3339),
+			Creators: []string{"Tool: ocb-" + ocbVersion()},
+		},
+		Packages: []spdxPackage{
+			newSPDXPackage(mainID, cfg.Distribution.Module, cfg.Distribution.Version),
+		},
+		Relationships: []spdxRelationship{{
+			SPDXElementID:      "SPDXRef-DOCUMENT",
+			RelationshipType:   "DESCRIBES",
+			RelatedSPDXElement: mainID,
+		}},
+	}
+	for i, mod := range modules {
+		if mod.Main {
+			continue
+		}
+		id := fmt.Sprintf("SPDXRef-Package-%d", i)
+		doc.Packages = append(doc.Packages, newSPDXPackage(id, mod.Path, mod.resolvedVersion()))
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			SPDXElementID:      mainID,
+			RelationshipType:   "DEPENDS_ON",
+			RelatedSPDXElement: id,
+		})
+	}
+	return doc
+}
+
+func newSPDXPackage(id, path, version string) spdxPackage {
+	return spdxPackage{
+		SPDXID:           id,
+		Name:             path,
+		VersionInfo:      version,
+		DownloadLocation: "NOASSERTION",
+		ExternalRefs: []spdxExternalRef{{
+			ReferenceCategory: "PACKAGE-MANAGER",
+			ReferenceType:     "purl",
+			ReferenceLocator:  purl(path, version),
+		}},
+	}
+}
+
+// Manifest describes the components included in a distribution.
+type Manifest struct {
+	Name        string              `json:"name"`
+	Module      string              `json:"module"`
+	Version     string              `json:"version"`
+	Description string              `json:"description,omitempty"`
+	Components  []ManifestComponent `json:"components"`
+}
+
+// ManifestComponent describes a single component module included in a distribution.
+type ManifestComponent struct {
+	// Kind is the kind of the component, e.g. "receiver" or "provider".
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Import string `json:"import"`
+	Module string `json:"module"`
+	// Version is the version of the module requested in the build configuration.
+	Version string `json:"version"`
+	// ResolvedVersion is the version of the module selected by the Go toolchain.
+	ResolvedVersion string `json:"resolved_version,omitempty"`
+}
+
+func newManifest(cfg *Config, modules []goModule) Manifest {
+	resolved := map[string]string{}
+	for _, mod := range modules {
+		resolved[mod.Path] = mod.resolvedVersion()
+	}
+
+	manifest := Manifest{
+		Name:        cfg.Distribution.Name,
+		Module:      cfg.Distribution.Module,
+		Version:     cfg.Distribution.Version,
+		Description: cfg.Distribution.Description,
+		Components:  []ManifestComponent{},
+	}
+	for _, kind := range componentKinds(cfg) {
+		for _, mod := range kind.mods {
+			module, version, _ := strings.Cut(mod.GoMod, " ")
+			manifest.Components = append(manifest.Components, ManifestComponent{
+				Kind:            kind.name,
+				Name:            mod.Name,
+				Import:          mod.Import,
+				Module:          module,
+				Version:         version,
+				ResolvedVersion: resolved[module],
+			})
+		}
+	}
+	return manifest
+}
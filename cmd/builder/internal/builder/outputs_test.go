@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const goListOutput = `{
+	"Path": "go.opentelemetry.io/collector/cmd/builder/internal/tester",
+	"Main": true,
+	"Dir": "/tmp/otelcol-distribution"
+}
+{
+	"Path": "go.opentelemetry.io/collector/receiver/otlpreceiver",
+	"Version": "v0.115.0"
+}
+{
+	"Path": "go.opentelemetry.io/collector/exporter/debugexporter",
+	"Version": "v0.115.0",
+	"Replace": {
+		"Path": "go.opentelemetry.io/collector/exporter/debugexporter",
+		"Version": "v0.115.1"
+	}
+}
+`
+
+func newOutputsTestConfig(t *testing.T) *Config {
+	cfg := newTestConfig(t)
+	cfg.Distribution.Name = "otelcol-test"
+	cfg.Distribution.Module = "go.opentelemetry.io/collector/cmd/builder/internal/tester"
+	cfg.Distribution.Version = "1.0.0"
+	cfg.Receivers = []Module{{GoMod: "go.opentelemetry.io/collector/receiver/otlpreceiver v0.115.0"}}
+	cfg.Exporters = []Module{{GoMod: "go.opentelemetry.io/collector/exporter/debugexporter v0.115.0"}}
+	cfg.ConfmapProviders = nil
+	require.NoError(t, cfg.ParseModules())
+	return cfg
+}
+
+func TestOutputsValidate(t *testing.T) {
+	require.NoError(t, Outputs{}.Validate())
+	require.NoError(t, Outputs{SBOM: "cyclonedx"}.Validate())
+	require.NoError(t, Outputs{SBOM: "spdx"}.Validate())
+	require.ErrorIs(t, Outputs{SBOM: "swid"}.Validate(), errUnknownSBOMFormat)
+
+	cfg := newTestConfig(t)
+	cfg.Outputs.SBOM = "swid"
+	require.ErrorIs(t, cfg.Validate(), errUnknownSBOMFormat)
+}
+
+func TestParseModuleList(t *testing.T) {
+	modules, err := parseModuleList([]byte(goListOutput))
+	require.NoError(t, err)
+	require.Len(t, modules, 3)
+	assert.True(t, modules[0].Main)
+	assert.Equal(t, "v0.115.0", modules[1].resolvedVersion())
+	assert.Equal(t, "v0.115.1", modules[2].resolvedVersion())
+
+	_, err = parseModuleList([]byte(`{"Path": `))
+	require.Error(t, err)
+}
+
+func TestCycloneDXBOM(t *testing.T) {
+	cfg := newOutputsTestConfig(t)
+	modules, err := parseModuleList([]byte(goListOutput))
+	require.NoError(t, err)
+
+	bom := newCycloneDXBOM(cfg, modules)
+	assert.Equal(t, "CycloneDX", bom.BOMFormat)
+	assert.Equal(t, "otelcol-test", bom.Metadata.Component.Name)
+	assert.Equal(t, "pkg:golang/go.opentelemetry.io/collector/cmd/builder/internal/tester@1.0.0", bom.Metadata.Component.BOMRef)
+	assert.Equal(t, []cycloneDXComponent{
+		{
+			BOMRef:  "pkg:golang/go.opentelemetry.io/collector/receiver/otlpreceiver@v0.115.0",
+			Type:    "library",
+			Name:    "go.opentelemetry.io/collector/receiver/otlpreceiver",
+			Version: "v0.115.0",
+			PURL:    "pkg:golang/go.opentelemetry.io/collector/receiver/otlpreceiver@v0.115.0",
+		},
+		{
+			BOMRef:  "pkg:golang/go.opentelemetry.io/collector/exporter/debugexporter@v0.115.1",
+			Type:    "library",
+			Name:    "go.opentelemetry.io/collector/exporter/debugexporter",
+			Version: "v0.115.1",
+			PURL:    "pkg:golang/go.opentelemetry.io/collector/exporter/debugexporter@v0.115.1",
+		},
+	}, bom.Components)
+	require.Len(t, bom.Dependencies, 1)
+	assert.Len(t, bom.Dependencies[0].DependsOn, 2)
+}
+
+func TestSPDXDocument(t *testing.T) {
+	cfg := newOutputsTestConfig(t)
+	modules, err := parseModuleList([]byte(goListOutput))
+	require.NoError(t, err)
+
+	created := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+	doc := newSPDXDocument(cfg, modules, created)
+	assert.Equal(t, "SPDX-2.3", doc.SPDXVersion)
+	assert.Equal(t, "2024-12-01T00:00:00Z", doc.CreationInfo.Created)
+	assert.Equal(t, "https://go.opentelemetry.io/collector/cmd/builder/internal/tester/spdx/otelcol-test-1.0.0", doc.DocumentNamespace)
+	require.Len(t, doc.Packages, 3)
+	assert.Equal(t, "SPDXRef-Package-main", doc.Packages[0].SPDXID)
+	assert.Equal(t, "go.opentelemetry.io/collector/exporter/debugexporter", doc.Packages[2].Name)
+	assert.Equal(t, "v0.115.1", doc.Packages[2].VersionInfo)
+	assert.Equal(t, "pkg:golang/go.opentelemetry.io/collector/exporter/debugexporter@v0.115.1", doc.Packages[2].ExternalRefs[0].ReferenceLocator)
+	require.Len(t, doc.Relationships, 3)
+	assert.Equal(t, "DESCRIBES", doc.Relationships[0].RelationshipType)
+	assert.Equal(t, spdxRelationship{
+		SPDXElementID:      "SPDXRef-Package-main",
+		RelationshipType:   "DEPENDS_ON",
+		RelatedSPDXElement: doc.Packages[2].SPDXID,
+	}, doc.Relationships[2])
+}
+
+func TestManifest(t *testing.T) {
+	cfg := newOutputsTestConfig(t)
+	modules, err := parseModuleList([]byte(goListOutput))
+	require.NoError(t, err)
+
+	assert.Equal(t, Manifest{
+		Name:    "otelcol-test",
+		Module:  "go.opentelemetry.io/collector/cmd/builder/internal/tester",
+		Version: "1.0.0",
+		Components: []ManifestComponent{
+			{
+				Kind:            "receiver",
+				Name:            "otlpreceiver",
+				Import:          "go.opentelemetry.io/collector/receiver/otlpreceiver",
+				Module:          "go.opentelemetry.io/collector/receiver/otlpreceiver",
+				Version:         "v0.115.0",
+				ResolvedVersion: "v0.115.0",
+			},
+			{
+				Kind:            "exporter",
+				Name:            "debugexporter",
+				Import:          "go.opentelemetry.io/collector/exporter/debugexporter",
+				Module:          "go.opentelemetry.io/collector/exporter/debugexporter",
+				Version:         "v0.115.0",
+				ResolvedVersion: "v0.115.1",
+			},
+		},
+	}, newManifest(cfg, modules))
+}
+
+func TestGenerateDockerfile(t *testing.T) {
+	cfg := newOutputsTestConfig(t)
+	cfg.Distribution.OutputPath = t.TempDir()
+	cfg.Distribution.Description = "Test distribution"
+
+	require.NoError(t, Generate(cfg))
+	_, err := os.Stat(filepath.Join(cfg.Distribution.OutputPath, "Dockerfile"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	cfg.Outputs.Dockerfile = true
+	require.NoError(t, Generate(cfg))
+	dockerfile, err := os.ReadFile(filepath.Join(cfg.Distribution.OutputPath, "Dockerfile"))
+	require.NoError(t, err)
+	assert.Contains(t, string(dockerfile), "FROM alpine:3.20 AS certs")
+	assert.Contains(t, string(dockerfile), `LABEL org.opencontainers.image.description="Test distribution"`)
+	assert.Contains(t, string(dockerfile), `LABEL org.opencontainers.image.version="1.0.0"`)
+	assert.Contains(t, string(dockerfile), "COPY --chmod=755 otelcol-test /otelcol-test")
+	assert.Contains(t, string(dockerfile), `ENTRYPOINT ["/otelcol-test"]`)
+	assert.Contains(t, string(dockerfile), "command -v apt-get")
+
+	cfg.Outputs.BaseImage = "gcr.io/distroless/static-debian12"
+	cfg.Outputs.SkipCACertificatesInstall = true
+	require.NoError(t, Generate(cfg))
+	dockerfile, err = os.ReadFile(filepath.Join(cfg.Distribution.OutputPath, "Dockerfile"))
+	require.NoError(t, err)
+	assert.Contains(t, string(dockerfile), "FROM gcr.io/distroless/static-debian12 AS certs")
+	assert.NotContains(t, string(dockerfile), "RUN ")
+	assert.Contains(t, string(dockerfile), "COPY --from=certs /etc/ssl/certs/ca-certificates.crt /etc/ssl/certs/ca-certificates.crt")
+}
+
+func TestGenerateOutputsDisabled(t *testing.T) {
+	cfg := newOutputsTestConfig(t)
+	cfg.Distribution.OutputPath = t.TempDir()
+	// No go command is run when no output depending on the resolved modules is enabled.
+	cfg.Distribution.Go = "/non/existent/go"
+	require.NoError(t, GenerateOutputs(cfg))
+}
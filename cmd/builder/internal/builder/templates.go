@@ -28,6 +28,10 @@ var (
 	//go:embed templates/go.mod.tmpl
 	goModBytes    []byte
 	goModTemplate = parseTemplate("go.mod", goModBytes)
+
+	//go:embed templates/Dockerfile.tmpl
+	dockerfileBytes    []byte
+	dockerfileTemplate = parseTemplate("Dockerfile", dockerfileBytes)
 )
 
 func parseTemplate(name string, bytes []byte) *template.Template {
@@ -5,6 +5,7 @@ package internal // import "go.opentelemetry.io/collector/cmd/builder/internal"
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/knadh/koanf/parsers/yaml"
@@ -26,6 +27,7 @@ const (
 	skipCompilationFlag        = "skip-compilation"
 	skipGetModulesFlag         = "skip-get-modules"
 	skipStrictVersioningFlag   = "skip-strict-versioning"
+	checkOnlyFlag              = "check-only"
 	ldflagsFlag                = "ldflags"
 	distributionOutputPathFlag = "output-path"
 	verboseFlag                = "verbose"
@@ -53,15 +55,31 @@ configuration is provided, ocb will generate a default Collector.
 				return fmt.Errorf("invalid configuration: %w", err)
 			}
 
-			if err = cfg.SetGoPath(); err != nil {
-				return fmt.Errorf("go not found: %w", err)
+			distCfgs := cfg.DistributionConfigs()
+			if len(cfg.Distributions) > 0 {
+				if err = os.MkdirAll(cfg.Distribution.OutputPath, 0o750); err != nil {
+					return fmt.Errorf("failed to create output path: %w", err)
+				}
 			}
 
-			if err = cfg.ParseModules(); err != nil {
-				return fmt.Errorf("invalid module configuration: %w", err)
-			}
+			for _, distCfg := range distCfgs {
+				if len(distCfgs) > 1 {
+					cfg.Logger.Info("Building distribution", zap.String("name", distCfg.Distribution.Name))
+				}
+
+				if err = distCfg.SetGoPath(); err != nil {
+					return fmt.Errorf("go not found: %w", err)
+				}
+
+				if err = distCfg.ParseModules(); err != nil {
+					return fmt.Errorf("invalid module configuration: %w", err)
+				}
 
-			return builder.GenerateAndCompile(cfg)
+				if err = builder.GenerateAndCompile(distCfg); err != nil {
+					return err
+				}
+			}
+			return nil
 		},
 	}
 
@@ -82,6 +100,7 @@ func initFlags(flags *flag.FlagSet) error {
 	flags.Bool(skipCompilationFlag, false, "Whether builder should only generate go code with no compile of the collector (default false)")
 	flags.Bool(skipGetModulesFlag, false, "Whether builder should skip updating go.mod and retrieve Go module list (default false)")
 	flags.Bool(skipStrictVersioningFlag, true, "Whether builder should skip strictly checking the calculated versions following dependency resolution")
+	flags.Bool(checkOnlyFlag, false, "Whether builder should only check the compatibility of the components, without generating nor compiling the distribution (default false)")
 	flags.Bool(verboseFlag, false, "Whether builder should print verbose output (default false)")
 	flags.String(ldflagsFlag, "", `ldflags to include in the "go build" command`)
 	flags.String(distributionOutputPathFlag, "", "Where to write the resulting files")
@@ -144,6 +163,8 @@ func applyFlags(flags *flag.FlagSet, cfg *builder.Config) error {
 	errs = multierr.Append(errs, err)
 	cfg.SkipStrictVersioning, err = flags.GetBool(skipStrictVersioningFlag)
 	errs = multierr.Append(errs, err)
+	cfg.CheckOnly, err = flags.GetBool(checkOnlyFlag)
+	errs = multierr.Append(errs, err)
 
 	cfg.LDFlags, err = flags.GetString(ldflagsFlag)
 	errs = multierr.Append(errs, err)
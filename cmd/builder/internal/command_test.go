@@ -4,6 +4,8 @@
 package internal
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -68,12 +70,13 @@ func TestApplyFlags(t *testing.T) {
 		},
 		{
 			name:  "All flag values",
-			flags: []string{"--skip-generate=true", "--skip-compilation=true", "--skip-get-modules=true", "--skip-strict-versioning=true", "--ldflags=test", "--verbose=true"},
+			flags: []string{"--skip-generate=true", "--skip-compilation=true", "--skip-get-modules=true", "--skip-strict-versioning=true", "--check-only=true", "--ldflags=test", "--verbose=true"},
 			want: &builder.Config{
 				SkipGenerate:         true,
 				SkipCompilation:      true,
 				SkipGetModules:       true,
 				SkipStrictVersioning: true,
+				CheckOnly:            true,
 				LDFlags:              "test",
 				Verbose:              true,
 			},
@@ -91,6 +94,7 @@ func TestApplyFlags(t *testing.T) {
 			assert.Equal(t, tt.want.SkipCompilation, cfg.SkipCompilation)
 			assert.Equal(t, tt.want.SkipGetModules, cfg.SkipGetModules)
 			assert.Equal(t, tt.want.SkipStrictVersioning, cfg.SkipStrictVersioning)
+			assert.Equal(t, tt.want.CheckOnly, cfg.CheckOnly)
 			assert.Equal(t, tt.want.LDFlags, cfg.LDFlags)
 			assert.Equal(t, tt.want.Verbose, cfg.Verbose)
 		})
@@ -121,3 +125,38 @@ func TestInitConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestInitConfigDistributions(t *testing.T) {
+	cfgFile := filepath.Join(t.TempDir(), "builder-config.yaml")
+	require.NoError(t, os.WriteFile(cfgFile, []byte(`
+dist:
+  module: github.com/example/monorepo/distributions
+  version: 1.0.0
+receivers:
+  - gomod: go.opentelemetry.io/collector/receiver/otlpreceiver v0.115.0
+distributions:
+  - dist:
+      name: otelcol-edge
+  - dist:
+      name: otelcol-gateway
+    processors:
+      - gomod: go.opentelemetry.io/collector/processor/batchprocessor v0.115.0
+`), 0o600))
+
+	flags := flag.NewFlagSet("version=1.0.0", 1)
+	require.NoError(t, initFlags(flags))
+	require.NoError(t, flags.Parse([]string{"--config=" + cfgFile}))
+	cfg, err := initConfig(flags)
+	require.NoError(t, err)
+	require.NoError(t, cfg.Validate())
+
+	cfgs := cfg.DistributionConfigs()
+	require.Len(t, cfgs, 2)
+	assert.Equal(t, "otelcol-edge", cfgs[0].Distribution.Name)
+	assert.Equal(t, "1.0.0", cfgs[0].Distribution.Version)
+	assert.Len(t, cfgs[0].Receivers, 1)
+	assert.Empty(t, cfgs[0].Processors)
+	assert.Equal(t, "otelcol-gateway", cfgs[1].Distribution.Name)
+	assert.Len(t, cfgs[1].Receivers, 1)
+	assert.Len(t, cfgs[1].Processors, 1)
+}
@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/collector/cmd/loadgen/internal"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"runtime/debug"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	noopmetric "go.opentelemetry.io/otel/metric/noop"
+	nooptrace "go.opentelemetry.io/otel/trace/noop"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/provider/envprovider"
+	"go.opentelemetry.io/collector/confmap/provider/fileprovider"
+	"go.opentelemetry.io/collector/confmap/provider/yamlprovider"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+const (
+	configFlag  = "config"
+	verboseFlag = "verbose"
+)
+
+// NewCommand constructs the loadgen cobra.Command.
+func NewCommand() (*cobra.Command, error) {
+	var configURIs []string
+	var verbose bool
+	cmd := &cobra.Command{
+		Use:          "loadgen",
+		Version:      version(),
+		SilenceUsage: true,
+		Long: `loadgen sends generated traces, metrics, logs or profiles over OTLP at a target rate,
+and reports the achieved throughput, the latency percentiles and a breakdown of the errors.
+
+The load is configured with the "--config" flag, which accepts the same locations as the
+collector, e.g. a file path or "yaml:rate: 100".`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if len(configURIs) == 0 {
+				return errors.New("at least one config flag must be provided")
+			}
+			cfg, err := loadConfig(cmd.Context(), configURIs)
+			if err != nil {
+				return err
+			}
+			logger := zap.NewNop()
+			if verbose {
+				if logger, err = zap.NewDevelopment(); err != nil {
+					return err
+				}
+			}
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+			return run(ctx, cfg, logger, cmd.OutOrStdout())
+		},
+	}
+	cmd.Flags().StringArrayVar(&configURIs, configFlag, nil, "Locations to the load configuration, merged in the given order.")
+	cmd.Flags().BoolVar(&verbose, verboseFlag, false, "Log the exporter output.")
+	return cmd, nil
+}
+
+func version() string {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		return info.Main.Version
+	}
+	return "unknown"
+}
+
+func loadConfig(ctx context.Context, uris []string) (*Config, error) {
+	resolver, err := confmap.NewResolver(confmap.ResolverSettings{
+		URIs: uris,
+		ProviderFactories: []confmap.ProviderFactory{
+			fileprovider.NewFactory(),
+			envprovider.NewFactory(),
+			yamlprovider.NewFactory(),
+		},
+		DefaultScheme: "env",
+	})
+	if err != nil {
+		return nil, err
+	}
+	conf, err := resolver.Resolve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve the configuration: %w", err)
+	}
+	cfg := newDefaultConfig()
+	if err = conf.Unmarshal(cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the configuration: %w", err)
+	}
+	if err = cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	return cfg, nil
+}
+
+// run generates the configured load and writes the report to w.
+func run(ctx context.Context, cfg *Config, logger *zap.Logger, w io.Writer) (err error) {
+	set := exporter.Settings{
+		TelemetrySettings: component.TelemetrySettings{
+			Logger:         logger,
+			TracerProvider: nooptrace.NewTracerProvider(),
+			MeterProvider:  noopmetric.NewMeterProvider(),
+			Resource:       pcommon.NewResource(),
+		},
+		BuildInfo: component.BuildInfo{
+			Command:     "loadgen",
+			Description: "OpenTelemetry Collector load generator",
+			Version:     version(),
+		},
+	}
+	exp, newRequest, err := newExporter(ctx, cfg, set)
+	if err != nil {
+		return fmt.Errorf("failed to create the exporter: %w", err)
+	}
+	if err = exp.Start(ctx, &nopHost{}); err != nil {
+		return fmt.Errorf("failed to start the exporter: %w", err)
+	}
+	defer func() {
+		err = multierr.Append(err, exp.Shutdown(context.Background()))
+	}()
+
+	st := generateLoad(ctx, cfg, newRequest)
+	sig, _ := cfg.signal()
+	return st.write(w, itemUnits[sig])
+}
+
+// nopHost is the host of the exporter, which has no extension.
+type nopHost struct{}
+
+func (*nopHost) GetExtensions() map[component.ID]component.Component {
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/pipeline"
+	"go.opentelemetry.io/collector/pipeline/pipelineprofiles"
+)
+
+func TestRun(t *testing.T) {
+	for _, sig := range []pipeline.Signal{pipeline.SignalTraces, pipeline.SignalMetrics, pipeline.SignalLogs, pipelineprofiles.SignalProfiles} {
+		t.Run(sig.String(), func(t *testing.T) {
+			var received atomic.Int64
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				received.Add(1)
+				w.Header().Set("Content-Type", "application/x-protobuf")
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer srv.Close()
+
+			cfg := newTestConfig(srv.URL)
+			cfg.Signal = sig.String()
+			buf := &bytes.Buffer{}
+			require.NoError(t, run(context.Background(), cfg, zap.NewNop(), buf))
+			assert.Positive(t, received.Load())
+			assert.Contains(t, buf.String(), "0 failed")
+			assert.Contains(t, buf.String(), itemUnits[sig]+"/s")
+			assert.NotContains(t, buf.String(), "Errors:")
+		})
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, run(context.Background(), newTestConfig(srv.URL), zap.NewNop(), buf))
+	assert.Contains(t, buf.String(), "0 succeeded")
+	assert.Contains(t, buf.String(), "Unavailable (retryable)")
+}
+
+func TestRunInvalidExporter(t *testing.T) {
+	cfg := newTestConfig("http://localhost:4318")
+	cfg.Exporter.OTLPHTTP.ClientConfig.TLSSetting.CAFile = "missing.pem"
+	require.ErrorContains(t, run(context.Background(), cfg, zap.NewNop(), &bytes.Buffer{}), "failed to start the exporter")
+}
+
+func TestCommand(t *testing.T) {
+	var received atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		received.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	cmd, err := NewCommand()
+	require.NoError(t, err)
+	buf := &bytes.Buffer{}
+	cmd.SetOut(buf)
+	cmd.SetArgs([]string{
+		"--config", "yaml:exporter::otlphttp::endpoint: " + srv.URL,
+		"--config", "yaml:duration: 200ms",
+		"--config", "yaml:rate: 20",
+	})
+	require.NoError(t, cmd.ExecuteContext(context.Background()))
+	assert.Positive(t, received.Load())
+	assert.Contains(t, buf.String(), "Throughput:")
+}
+
+func TestCommandNoConfig(t *testing.T) {
+	cmd, err := NewCommand()
+	require.NoError(t, err)
+	cmd.SetArgs(nil)
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	require.EqualError(t, cmd.Execute(), "at least one config flag must be provided")
+}
+
+func newTestConfig(endpoint string) *Config {
+	cfg := newDefaultConfig()
+	cfg.Rate = 50
+	cfg.Duration = 200 * time.Millisecond
+	cfg.Shape.ItemsPerRequest = 10
+	cfg.Exporter.OTLPHTTP = newDefaultOTLPHTTPConfig()
+	cfg.Exporter.OTLPHTTP.ClientConfig.Endpoint = endpoint
+	return cfg
+}
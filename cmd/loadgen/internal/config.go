@@ -0,0 +1,195 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/collector/cmd/loadgen/internal"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/exporter/otlpexporter"
+	"go.opentelemetry.io/collector/exporter/otlphttpexporter"
+	"go.opentelemetry.io/collector/pipeline"
+	"go.opentelemetry.io/collector/pipeline/pipelineprofiles"
+)
+
+const (
+	// Exporter keys.
+	otlpKey     = "otlp"
+	otlpHTTPKey = "otlphttp"
+)
+
+// Config defines the load to generate.
+type Config struct {
+	// Signal is the signal of the generated data: traces, metrics, logs or profiles.
+	Signal string `mapstructure:"signal"`
+
+	// Rate is the number of requests sent per second. Zero sends requests as fast as possible.
+	Rate float64 `mapstructure:"rate"`
+
+	// Duration is how long load is generated for.
+	Duration time.Duration `mapstructure:"duration"`
+
+	// Workers is the number of requests sent concurrently.
+	Workers int `mapstructure:"workers"`
+
+	// Shape describes the generated data.
+	Shape ShapeConfig `mapstructure:"shape"`
+
+	// Exporter configures how the generated data is sent.
+	Exporter ExporterConfig `mapstructure:"exporter"`
+}
+
+// ShapeConfig describes the data sent in each request.
+type ShapeConfig struct {
+	// ItemsPerRequest is the number of spans, metric data points, log records or profiles,
+	// of one sample each, per request.
+	ItemsPerRequest int `mapstructure:"items_per_request"`
+
+	// AttributesPerItem is the number of attributes of each item.
+	AttributesPerItem int `mapstructure:"attributes_per_item"`
+
+	// AttributeCardinality is the number of distinct values of each attribute.
+	AttributeCardinality int `mapstructure:"attribute_cardinality"`
+
+	// AttributeValueSize is the size, in bytes, of the attribute values.
+	AttributeValueSize int `mapstructure:"attribute_value_size"`
+
+	// BodySize is the size, in bytes, of the body of log records.
+	BodySize int `mapstructure:"body_size"`
+}
+
+// ExporterConfig configures the exporter sending the generated data.
+// Exactly one of OTLP and OTLPHTTP must be set.
+type ExporterConfig struct {
+	// OTLP is the configuration of an OTLP gRPC exporter.
+	OTLP *otlpexporter.Config `mapstructure:"otlp"`
+
+	// OTLPHTTP is the configuration of an OTLP HTTP exporter.
+	OTLPHTTP *otlphttpexporter.Config `mapstructure:"otlphttp"`
+}
+
+var _ confmap.Unmarshaler = (*ExporterConfig)(nil)
+
+func newDefaultConfig() *Config {
+	return &Config{
+		Signal:   pipeline.SignalTraces.String(),
+		Rate:     10,
+		Duration: 10 * time.Second,
+		Workers:  1,
+		Shape: ShapeConfig{
+			ItemsPerRequest:      100,
+			AttributesPerItem:    5,
+			AttributeCardinality: 10,
+			AttributeValueSize:   16,
+			BodySize:             128,
+		},
+	}
+}
+
+// Validate checks if the configuration is valid.
+func (cfg *Config) Validate() error {
+	var errs error
+	if _, err := cfg.signal(); err != nil {
+		errs = errors.Join(errs, err)
+	}
+	if cfg.Rate < 0 {
+		errs = errors.Join(errs, errors.New("'rate' must not be negative"))
+	}
+	if cfg.Duration <= 0 {
+		errs = errors.Join(errs, errors.New("'duration' must be positive"))
+	}
+	if cfg.Workers <= 0 {
+		errs = errors.Join(errs, errors.New("'workers' must be positive"))
+	}
+	return errors.Join(errs, cfg.Shape.validate(), cfg.Exporter.validate())
+}
+
+func (cfg *Config) signal() (pipeline.Signal, error) {
+	for _, signal := range []pipeline.Signal{pipeline.SignalTraces, pipeline.SignalMetrics, pipeline.SignalLogs, pipelineprofiles.SignalProfiles} {
+		if cfg.Signal == signal.String() {
+			return signal, nil
+		}
+	}
+	return pipeline.Signal{}, fmt.Errorf("unsupported 'signal' %q, must be one of traces, metrics, logs or profiles", cfg.Signal)
+}
+
+func (cfg *ShapeConfig) validate() error {
+	var errs error
+	if cfg.ItemsPerRequest <= 0 {
+		errs = errors.Join(errs, errors.New("'shape::items_per_request' must be positive"))
+	}
+	if cfg.AttributesPerItem < 0 {
+		errs = errors.Join(errs, errors.New("'shape::attributes_per_item' must not be negative"))
+	}
+	if cfg.AttributesPerItem > 0 && cfg.AttributeCardinality <= 0 {
+		errs = errors.Join(errs, errors.New("'shape::attribute_cardinality' must be positive"))
+	}
+	if cfg.AttributeValueSize < 0 {
+		errs = errors.Join(errs, errors.New("'shape::attribute_value_size' must not be negative"))
+	}
+	if cfg.BodySize < 0 {
+		errs = errors.Join(errs, errors.New("'shape::body_size' must not be negative"))
+	}
+	return errs
+}
+
+func (cfg *ExporterConfig) validate() error {
+	switch {
+	case cfg.OTLP == nil && cfg.OTLPHTTP == nil:
+		return errors.New("one of 'exporter::otlp' and 'exporter::otlphttp' must be set")
+	case cfg.OTLP != nil && cfg.OTLPHTTP != nil:
+		return errors.New("only one of 'exporter::otlp' and 'exporter::otlphttp' can be set")
+	case cfg.OTLP != nil:
+		return component.ValidateConfig(cfg.OTLP)
+	default:
+		return component.ValidateConfig(cfg.OTLPHTTP)
+	}
+}
+
+// Unmarshal a confmap.Conf into the config struct, starting from the default configuration
+// of the configured exporter.
+func (cfg *ExporterConfig) Unmarshal(conf *confmap.Conf) error {
+	if conf.IsSet(otlpKey) {
+		cfg.OTLP = newDefaultOTLPConfig()
+		sub, err := conf.Sub(otlpKey)
+		if err != nil {
+			return err
+		}
+		if err = sub.Unmarshal(cfg.OTLP); err != nil {
+			return fmt.Errorf("%s: %w", otlpKey, err)
+		}
+	}
+	if conf.IsSet(otlpHTTPKey) {
+		cfg.OTLPHTTP = newDefaultOTLPHTTPConfig()
+		sub, err := conf.Sub(otlpHTTPKey)
+		if err != nil {
+			return err
+		}
+		if err = sub.Unmarshal(cfg.OTLPHTTP); err != nil {
+			return fmt.Errorf("%s: %w", otlpHTTPKey, err)
+		}
+	}
+	return nil
+}
+
+// newDefaultOTLPConfig returns the default configuration of the OTLP gRPC exporter, without
+// sending queue nor retries so that the outcome of every request is reported.
+func newDefaultOTLPConfig() *otlpexporter.Config {
+	cfg := otlpexporter.NewFactory().CreateDefaultConfig().(*otlpexporter.Config)
+	cfg.QueueConfig.Enabled = false
+	cfg.RetryConfig.Enabled = false
+	return cfg
+}
+
+// newDefaultOTLPHTTPConfig returns the default configuration of the OTLP HTTP exporter, without
+// sending queue nor retries so that the outcome of every request is reported.
+func newDefaultOTLPHTTPConfig() *otlphttpexporter.Config {
+	cfg := otlphttpexporter.NewFactory().CreateDefaultConfig().(*otlphttpexporter.Config)
+	cfg.QueueConfig.Enabled = false
+	cfg.RetryConfig.Enabled = false
+	return cfg
+}
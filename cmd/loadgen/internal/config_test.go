@@ -0,0 +1,158 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/config/configcompression"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cfg, err := loadConfig(context.Background(), []string{filepath.Join("testdata", "config.yaml")})
+	require.NoError(t, err)
+
+	expected := newDefaultConfig()
+	expected.Signal = pipeline.SignalLogs.String()
+	expected.Rate = 50
+	expected.Duration = 30 * time.Second
+	expected.Workers = 4
+	expected.Shape = ShapeConfig{
+		ItemsPerRequest:      10,
+		AttributesPerItem:    3,
+		AttributeCardinality: 100,
+		AttributeValueSize:   32,
+		BodySize:             1024,
+	}
+	expected.Exporter.OTLPHTTP = newDefaultOTLPHTTPConfig()
+	expected.Exporter.OTLPHTTP.ClientConfig.Endpoint = "http://localhost:4318"
+	expected.Exporter.OTLPHTTP.ClientConfig.Compression = configcompression.Type("none")
+	assert.Equal(t, expected, cfg)
+}
+
+func TestLoadConfigMerge(t *testing.T) {
+	cfg, err := loadConfig(context.Background(), []string{
+		filepath.Join("testdata", "config.yaml"),
+		"yaml:signal: traces",
+		"yaml:rate: 0",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pipeline.SignalTraces.String(), cfg.Signal)
+	assert.Zero(t, cfg.Rate)
+	assert.Equal(t, 4, cfg.Workers)
+}
+
+func TestLoadConfigDefaultExporter(t *testing.T) {
+	cfg, err := loadConfig(context.Background(), []string{"yaml:exporter::otlp::endpoint: localhost:4317"})
+	require.NoError(t, err)
+	require.NotNil(t, cfg.Exporter.OTLP)
+	assert.Nil(t, cfg.Exporter.OTLPHTTP)
+	assert.Equal(t, "localhost:4317", cfg.Exporter.OTLP.ClientConfig.Endpoint)
+	assert.False(t, cfg.Exporter.OTLP.QueueConfig.Enabled)
+	assert.False(t, cfg.Exporter.OTLP.RetryConfig.Enabled)
+	assert.Equal(t, newDefaultConfig().Shape, cfg.Shape)
+}
+
+func TestLoadConfigErrors(t *testing.T) {
+	_, err := loadConfig(context.Background(), []string{"yaml:workers: 0"})
+	require.ErrorContains(t, err, "invalid configuration")
+
+	_, err = loadConfig(context.Background(), []string{"yaml:unknown: 1"})
+	require.ErrorContains(t, err, "failed to unmarshal the configuration")
+
+	_, err = loadConfig(context.Background(), []string{filepath.Join("testdata", "missing.yaml")})
+	require.ErrorContains(t, err, "failed to resolve the configuration")
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		errs   []string
+	}{
+		{
+			name:   "valid",
+			modify: func(*Config) {},
+		},
+		{
+			name:   "unsupported signal",
+			modify: func(cfg *Config) { cfg.Signal = "events" },
+			errs:   []string{`unsupported 'signal' "events"`},
+		},
+		{
+			name: "invalid load",
+			modify: func(cfg *Config) {
+				cfg.Rate = -1
+				cfg.Duration = 0
+				cfg.Workers = 0
+			},
+			errs: []string{
+				"'rate' must not be negative",
+				"'duration' must be positive",
+				"'workers' must be positive",
+			},
+		},
+		{
+			name: "invalid shape",
+			modify: func(cfg *Config) {
+				cfg.Shape = ShapeConfig{
+					ItemsPerRequest:      0,
+					AttributesPerItem:    1,
+					AttributeCardinality: 0,
+					AttributeValueSize:   -1,
+					BodySize:             -1,
+				}
+			},
+			errs: []string{
+				"'shape::items_per_request' must be positive",
+				"'shape::attribute_cardinality' must be positive",
+				"'shape::attribute_value_size' must not be negative",
+				"'shape::body_size' must not be negative",
+			},
+		},
+		{
+			name:   "negative attributes",
+			modify: func(cfg *Config) { cfg.Shape.AttributesPerItem = -1 },
+			errs:   []string{"'shape::attributes_per_item' must not be negative"},
+		},
+		{
+			name:   "no exporter",
+			modify: func(cfg *Config) { cfg.Exporter.OTLP = nil },
+			errs:   []string{"one of 'exporter::otlp' and 'exporter::otlphttp' must be set"},
+		},
+		{
+			name:   "both exporters",
+			modify: func(cfg *Config) { cfg.Exporter.OTLPHTTP = newDefaultOTLPHTTPConfig() },
+			errs:   []string{"only one of 'exporter::otlp' and 'exporter::otlphttp' can be set"},
+		},
+		{
+			name:   "invalid exporter",
+			modify: func(cfg *Config) { cfg.Exporter.OTLP.ClientConfig.Endpoint = "" },
+			errs:   []string{"requires a non-empty \"endpoint\""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := newDefaultConfig()
+			cfg.Exporter.OTLP = newDefaultOTLPConfig()
+			cfg.Exporter.OTLP.ClientConfig.Endpoint = "localhost:4317"
+			tt.modify(cfg)
+			err := cfg.Validate()
+			if len(tt.errs) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, e := range tt.errs {
+				assert.ErrorContains(t, err, e)
+			}
+		})
+	}
+}
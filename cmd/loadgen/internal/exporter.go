@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/collector/cmd/loadgen/internal"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterprofiles"
+	"go.opentelemetry.io/collector/exporter/otlpexporter"
+	"go.opentelemetry.io/collector/exporter/otlphttpexporter"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pipeline"
+	"go.opentelemetry.io/collector/pipeline/pipelineprofiles"
+)
+
+// itemUnits are the names of the items of each signal, used in the report.
+var itemUnits = map[pipeline.Signal]string{
+	pipeline.SignalTraces:           "spans",
+	pipeline.SignalMetrics:          "data points",
+	pipeline.SignalLogs:             "log records",
+	pipelineprofiles.SignalProfiles: "samples",
+}
+
+// newExporter creates the configured exporter for the signal, and returns it along with
+// a function generating requests sent through it.
+func newExporter(ctx context.Context, cfg *Config, set exporter.Settings) (component.Component, func() request, error) {
+	var factory exporterprofiles.Factory
+	var expCfg component.Config
+	if cfg.Exporter.OTLP != nil {
+		factory = otlpexporter.NewFactory().(exporterprofiles.Factory)
+		expCfg = cfg.Exporter.OTLP
+	} else {
+		factory = otlphttpexporter.NewFactory().(exporterprofiles.Factory)
+		expCfg = cfg.Exporter.OTLPHTTP
+	}
+	set.ID = component.NewID(factory.Type())
+
+	signal, err := cfg.signal()
+	if err != nil {
+		return nil, nil, err
+	}
+	gen := newGenerator(cfg.Shape)
+	switch signal {
+	case pipeline.SignalTraces:
+		exp, err := factory.CreateTraces(ctx, set, expCfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		sizer := &ptrace.ProtoMarshaler{}
+		return exp, func() request {
+			td := gen.generateTraces()
+			return request{
+				items: td.SpanCount(),
+				bytes: sizer.TracesSize(td),
+				send:  func(ctx context.Context) error { return exp.ConsumeTraces(ctx, td) },
+			}
+		}, nil
+	case pipeline.SignalMetrics:
+		exp, err := factory.CreateMetrics(ctx, set, expCfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		sizer := &pmetric.ProtoMarshaler{}
+		return exp, func() request {
+			md := gen.generateMetrics()
+			return request{
+				items: md.DataPointCount(),
+				bytes: sizer.MetricsSize(md),
+				send:  func(ctx context.Context) error { return exp.ConsumeMetrics(ctx, md) },
+			}
+		}, nil
+	case pipeline.SignalLogs:
+		exp, err := factory.CreateLogs(ctx, set, expCfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		sizer := &plog.ProtoMarshaler{}
+		return exp, func() request {
+			ld := gen.generateLogs()
+			return request{
+				items: ld.LogRecordCount(),
+				bytes: sizer.LogsSize(ld),
+				send:  func(ctx context.Context) error { return exp.ConsumeLogs(ctx, ld) },
+			}
+		}, nil
+	case pipelineprofiles.SignalProfiles:
+		exp, err := factory.CreateProfiles(ctx, set, expCfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		sizer := &pprofile.ProtoMarshaler{}
+		return exp, func() request {
+			pd := gen.generateProfiles()
+			return request{
+				items: pd.SampleCount(),
+				bytes: sizer.ProfilesSize(pd),
+				send:  func(ctx context.Context) error { return exp.ConsumeProfiles(ctx, pd) },
+			}
+		}, nil
+	}
+	return nil, nil, fmt.Errorf("unsupported signal %q", signal)
+}
@@ -0,0 +1,169 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/collector/cmd/loadgen/internal"
+
+import (
+	"encoding/binary"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+const scopeName = "go.opentelemetry.io/collector/cmd/loadgen"
+
+// generator generates data of the configured shape. It is safe for concurrent use.
+type generator struct {
+	shape ShapeConfig
+	// values holds, for each attribute, the distinct values it is drawn from.
+	values [][]string
+	body   string
+}
+
+func newGenerator(shape ShapeConfig) *generator {
+	g := &generator{
+		shape:  shape,
+		values: make([][]string, shape.AttributesPerItem),
+		body:   strings.Repeat("x", shape.BodySize),
+	}
+	for i := range g.values {
+		g.values[i] = make([]string, shape.AttributeCardinality)
+		for j := range g.values[i] {
+			g.values[i][j] = attributeValue(i, j, shape.AttributeValueSize)
+		}
+	}
+	return g
+}
+
+// attributeValue returns a value unique to the attribute and index, padded to size.
+func attributeValue(attr, index, size int) string {
+	v := "value-" + strconv.Itoa(attr) + "-" + strconv.Itoa(index)
+	if len(v) < size {
+		v += strings.Repeat("x", size-len(v))
+	}
+	return v
+}
+
+func (g *generator) generateTraces() ptrace.Traces {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	fillResource(rs.Resource())
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName(scopeName)
+	spans := ss.Spans()
+	spans.EnsureCapacity(g.shape.ItemsPerRequest)
+	end := pcommon.NewTimestampFromTime(time.Now())
+	start := pcommon.NewTimestampFromTime(end.AsTime().Add(-time.Millisecond))
+	traceID := newTraceID()
+	for i := 0; i < g.shape.ItemsPerRequest; i++ {
+		span := spans.AppendEmpty()
+		span.SetTraceID(traceID)
+		span.SetSpanID(newSpanID())
+		span.SetName("loadgen-span")
+		span.SetKind(ptrace.SpanKindInternal)
+		span.SetStartTimestamp(start)
+		span.SetEndTimestamp(end)
+		g.fillAttributes(span.Attributes())
+	}
+	return td
+}
+
+func (g *generator) generateMetrics() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	fillResource(rm.Resource())
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(scopeName)
+	m := sm.Metrics().AppendEmpty()
+	m.SetName("loadgen.metric")
+	dps := m.SetEmptyGauge().DataPoints()
+	dps.EnsureCapacity(g.shape.ItemsPerRequest)
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for i := 0; i < g.shape.ItemsPerRequest; i++ {
+		dp := dps.AppendEmpty()
+		dp.SetTimestamp(now)
+		dp.SetDoubleValue(rand.Float64())
+		g.fillAttributes(dp.Attributes())
+	}
+	return md
+}
+
+func (g *generator) generateLogs() plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	fillResource(rl.Resource())
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(scopeName)
+	lrs := sl.LogRecords()
+	lrs.EnsureCapacity(g.shape.ItemsPerRequest)
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for i := 0; i < g.shape.ItemsPerRequest; i++ {
+		lr := lrs.AppendEmpty()
+		lr.SetTimestamp(now)
+		lr.SetSeverityNumber(plog.SeverityNumberInfo)
+		lr.Body().SetStr(g.body)
+		g.fillAttributes(lr.Attributes())
+	}
+	return ld
+}
+
+func (g *generator) generateProfiles() pprofile.Profiles {
+	pd := pprofile.NewProfiles()
+	rp := pd.ResourceProfiles().AppendEmpty()
+	fillResource(rp.Resource())
+	sp := rp.ScopeProfiles().AppendEmpty()
+	sp.Scope().SetName(scopeName)
+	profiles := sp.Profiles()
+	profiles.EnsureCapacity(g.shape.ItemsPerRequest)
+	now := pcommon.NewTimestampFromTime(time.Now())
+	for i := 0; i < g.shape.ItemsPerRequest; i++ {
+		profile := profiles.AppendEmpty()
+		profile.SetProfileID(pprofile.ProfileID(newTraceID()))
+		profile.SetTime(now)
+		sample := profile.Sample().AppendEmpty()
+		sample.Value().Append(rand.Int64N(100))
+		// Profiles reference their attributes by index in the attribute table.
+		for a := range g.values {
+			attr := profile.AttributeTable().AppendEmpty()
+			attr.SetKey(attributeKey(a))
+			attr.Value().SetStr(g.values[a][rand.IntN(len(g.values[a]))])
+			sample.AttributeIndices().Append(int32(a)) //nolint:gosec // bounded by the number of attributes
+		}
+	}
+	return pd
+}
+
+func (g *generator) fillAttributes(attrs pcommon.Map) {
+	attrs.EnsureCapacity(len(g.values))
+	for a, values := range g.values {
+		attrs.PutStr(attributeKey(a), values[rand.IntN(len(values))])
+	}
+}
+
+func attributeKey(i int) string {
+	return "attr." + strconv.Itoa(i)
+}
+
+func fillResource(res pcommon.Resource) {
+	res.Attributes().PutStr("service.name", "loadgen")
+}
+
+func newTraceID() pcommon.TraceID {
+	var id pcommon.TraceID
+	binary.LittleEndian.PutUint64(id[:8], rand.Uint64())
+	binary.LittleEndian.PutUint64(id[8:], rand.Uint64())
+	return id
+}
+
+func newSpanID() pcommon.SpanID {
+	var id pcommon.SpanID
+	binary.LittleEndian.PutUint64(id[:], rand.Uint64())
+	return id
+}
@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+var testShape = ShapeConfig{
+	ItemsPerRequest:      50,
+	AttributesPerItem:    3,
+	AttributeCardinality: 4,
+	AttributeValueSize:   20,
+	BodySize:             64,
+}
+
+func TestAttributeValue(t *testing.T) {
+	assert.Equal(t, "value-1-2", attributeValue(1, 2, 0))
+	assert.Equal(t, "value-1-2", attributeValue(1, 2, 5))
+	assert.Equal(t, "value-1-2xxx", attributeValue(1, 2, 12))
+}
+
+func TestGenerateTraces(t *testing.T) {
+	td := newGenerator(testShape).generateTraces()
+	require.Equal(t, testShape.ItemsPerRequest, td.SpanCount())
+	spans := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+	for i := 0; i < spans.Len(); i++ {
+		span := spans.At(i)
+		assert.False(t, span.TraceID().IsEmpty())
+		assert.False(t, span.SpanID().IsEmpty())
+		assert.Less(t, span.StartTimestamp(), span.EndTimestamp())
+		assertAttributes(t, span.Attributes())
+	}
+}
+
+func TestGenerateMetrics(t *testing.T) {
+	md := newGenerator(testShape).generateMetrics()
+	require.Equal(t, testShape.ItemsPerRequest, md.DataPointCount())
+	dps := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		assertAttributes(t, dps.At(i).Attributes())
+	}
+}
+
+func TestGenerateLogs(t *testing.T) {
+	ld := newGenerator(testShape).generateLogs()
+	require.Equal(t, testShape.ItemsPerRequest, ld.LogRecordCount())
+	lrs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	for i := 0; i < lrs.Len(); i++ {
+		assert.Len(t, lrs.At(i).Body().Str(), testShape.BodySize)
+		assertAttributes(t, lrs.At(i).Attributes())
+	}
+}
+
+func TestGenerateProfiles(t *testing.T) {
+	pd := newGenerator(testShape).generateProfiles()
+	require.Equal(t, testShape.ItemsPerRequest, pd.SampleCount())
+	profiles := pd.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles()
+	require.Equal(t, testShape.ItemsPerRequest, profiles.Len())
+	for i := 0; i < profiles.Len(); i++ {
+		profile := profiles.At(i)
+		sample := profile.Sample().At(0)
+		require.Equal(t, testShape.AttributesPerItem, sample.AttributeIndices().Len())
+		attrs := pcommon.NewMap()
+		for j := 0; j < sample.AttributeIndices().Len(); j++ {
+			attr := profile.AttributeTable().At(int(sample.AttributeIndices().At(j)))
+			attr.Value().CopyTo(attrs.PutEmpty(attr.Key()))
+		}
+		assertAttributes(t, attrs)
+	}
+}
+
+func TestGenerateCardinality(t *testing.T) {
+	ld := newGenerator(ShapeConfig{
+		ItemsPerRequest:      1000,
+		AttributesPerItem:    2,
+		AttributeCardinality: 3,
+	}).generateLogs()
+	values := map[string]map[string]bool{}
+	lrs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	for i := 0; i < lrs.Len(); i++ {
+		lrs.At(i).Attributes().Range(func(k string, v pcommon.Value) bool {
+			if values[k] == nil {
+				values[k] = map[string]bool{}
+			}
+			values[k][v.Str()] = true
+			return true
+		})
+	}
+	require.Len(t, values, 2)
+	for _, v := range values {
+		assert.Len(t, v, 3)
+	}
+}
+
+func TestGenerateNoAttributes(t *testing.T) {
+	td := newGenerator(ShapeConfig{ItemsPerRequest: 1}).generateTraces()
+	assert.Equal(t, 0, td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes().Len())
+}
+
+func assertAttributes(t *testing.T, attrs pcommon.Map) {
+	require.Equal(t, testShape.AttributesPerItem, attrs.Len())
+	attrs.Range(func(_ string, v pcommon.Value) bool {
+		assert.Len(t, v.Str(), testShape.AttributeValueSize)
+		return true
+	})
+}
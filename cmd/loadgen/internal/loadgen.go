@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/collector/cmd/loadgen/internal"
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// request is a generated request, ready to be sent.
+type request struct {
+	items int
+	bytes int
+	send  func(context.Context) error
+}
+
+// generateLoad sends the requests returned by newRequest at the configured rate, for the
+// configured duration or until the context is done, and returns the statistics of the run.
+// Requests in flight when the duration elapses are waited for.
+func generateLoad(ctx context.Context, cfg *Config, newRequest func() request) *stats {
+	st := newStats()
+	jobs := make(chan struct{})
+	wg := sync.WaitGroup{}
+	for i := 0; i < cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range jobs {
+				req := newRequest()
+				start := time.Now()
+				err := req.send(ctx)
+				st.record(req, time.Since(start), err)
+			}
+		}()
+	}
+
+	start := time.Now()
+	timer := time.NewTimer(cfg.Duration)
+	defer timer.Stop()
+	if cfg.Rate == 0 {
+		dispatchUnlimited(ctx, timer.C, jobs)
+	} else {
+		dispatchAtRate(ctx, timer.C, jobs, cfg.Rate, st)
+	}
+	close(jobs)
+	wg.Wait()
+	st.elapsed = time.Since(start)
+	return st
+}
+
+// dispatchUnlimited hands requests to the workers as soon as one is available.
+func dispatchUnlimited(ctx context.Context, done <-chan time.Time, jobs chan<- struct{}) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-done:
+			return
+		case jobs <- struct{}{}:
+		}
+	}
+}
+
+// dispatchAtRate hands requests to the workers at the given rate per second. Requests
+// are recorded as missed when all the workers are busy.
+func dispatchAtRate(ctx context.Context, done <-chan time.Time, jobs chan<- struct{}, rate float64, st *stats) {
+	ticker := time.NewTicker(max(time.Duration(float64(time.Second)/rate), 1))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-done:
+			return
+		case <-ticker.C:
+			select {
+			case jobs <- struct{}{}:
+			default:
+				st.recordMissed()
+			}
+		}
+	}
+}
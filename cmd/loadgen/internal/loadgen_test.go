@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateLoadAtRate(t *testing.T) {
+	cfg := newDefaultConfig()
+	cfg.Rate = 100
+	cfg.Duration = 500 * time.Millisecond
+	var sent atomic.Int64
+	st := generateLoad(context.Background(), cfg, func() request {
+		return request{items: 2, bytes: 10, send: func(context.Context) error {
+			sent.Add(1)
+			return nil
+		}}
+	})
+	assert.Equal(t, int(sent.Load()), st.requests)
+	assert.Equal(t, st.requests, st.succeeded)
+	assert.Equal(t, 2*st.requests, st.items)
+	assert.Equal(t, 10*st.requests, st.bytes)
+	// The ticker fires about 50 times, leave room for slow test environments.
+	assert.Greater(t, st.requests, 10)
+	assert.LessOrEqual(t, st.requests, 51)
+	assert.GreaterOrEqual(t, st.elapsed, cfg.Duration)
+}
+
+func TestGenerateLoadMissed(t *testing.T) {
+	cfg := newDefaultConfig()
+	cfg.Rate = 1000
+	cfg.Duration = 200 * time.Millisecond
+	st := generateLoad(context.Background(), cfg, func() request {
+		return request{send: func(context.Context) error {
+			time.Sleep(50 * time.Millisecond)
+			return nil
+		}}
+	})
+	assert.Positive(t, st.missed)
+	assert.LessOrEqual(t, st.requests, 5)
+}
+
+func TestGenerateLoadUnlimited(t *testing.T) {
+	cfg := newDefaultConfig()
+	cfg.Rate = 0
+	cfg.Workers = 4
+	cfg.Duration = 100 * time.Millisecond
+	st := generateLoad(context.Background(), cfg, func() request {
+		return request{send: func(context.Context) error {
+			return errors.New("failed")
+		}}
+	})
+	assert.Positive(t, st.requests)
+	assert.Zero(t, st.succeeded)
+	assert.Zero(t, st.missed)
+	assert.Equal(t, map[string]int{"failed (retryable)": st.requests}, st.errors)
+}
+
+func TestGenerateLoadCanceled(t *testing.T) {
+	cfg := newDefaultConfig()
+	cfg.Duration = time.Hour
+	ctx, cancel := context.WithCancel(context.Background())
+	st := generateLoad(ctx, cfg, func() request {
+		return request{send: func(context.Context) error {
+			cancel()
+			return nil
+		}}
+	})
+	assert.Equal(t, 1, st.requests)
+	assert.Less(t, st.elapsed, time.Hour)
+}
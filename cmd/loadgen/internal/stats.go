@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/collector/cmd/loadgen/internal"
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/status"
+
+	"go.opentelemetry.io/collector/consumer/consumererror"
+)
+
+// stats records the outcome of the sent requests.
+type stats struct {
+	mu        sync.Mutex
+	elapsed   time.Duration
+	requests  int
+	succeeded int
+	missed    int
+	items     int
+	bytes     int
+	latencies []time.Duration
+	errors    map[string]int
+}
+
+func newStats() *stats {
+	return &stats{errors: map[string]int{}}
+}
+
+// record records the outcome of a request.
+func (s *stats) record(req request, latency time.Duration, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	s.latencies = append(s.latencies, latency)
+	if err != nil {
+		s.errors[errorKind(err)]++
+		return
+	}
+	s.succeeded++
+	s.items += req.items
+	s.bytes += req.bytes
+}
+
+// recordMissed records a request that could not be sent at the target rate.
+func (s *stats) recordMissed() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.missed++
+}
+
+// errorKind returns the category errors are broken down by in the report: the gRPC status code,
+// which both OTLP exporters report, and whether the error is permanent.
+func errorKind(err error) string {
+	retryable := "retryable"
+	if consumererror.IsPermanent(err) {
+		retryable = "permanent"
+		// Drop the redundant "Permanent error: " prefix from the message.
+		if unwrapped := errors.Unwrap(err); unwrapped != nil {
+			err = unwrapped
+		}
+	}
+	if st, ok := status.FromError(err); ok {
+		return fmt.Sprintf("%s (%s)", st.Code(), retryable)
+	}
+	return fmt.Sprintf("%s (%s)", err, retryable)
+}
+
+// percentile returns the p-th percentile of the sorted latencies.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(p / 100 * float64(len(sorted)))
+	return sorted[min(i, len(sorted)-1)]
+}
+
+// write writes a human readable report of the statistics, counting items with the given unit.
+func (s *stats) write(w io.Writer, itemUnit string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	latencies := slices.Clone(s.latencies)
+	slices.Sort(latencies)
+	seconds := s.elapsed.Seconds()
+	if seconds == 0 {
+		seconds = 1
+	}
+
+	ew := &errWriter{w: w}
+	ew.printf("Duration:    %v\n", s.elapsed.Round(time.Millisecond))
+	ew.printf("Requests:    %d sent, %d succeeded, %d failed, %d missed\n", s.requests, s.succeeded, s.requests-s.succeeded, s.missed)
+	ew.printf("Throughput:  %.2f requests/s, %.2f %s/s, %.2f KiB/s\n",
+		float64(s.succeeded)/seconds, float64(s.items)/seconds, itemUnit, float64(s.bytes)/1024/seconds)
+	ew.printf("Latency:     p50=%v p90=%v p99=%v max=%v\n",
+		percentile(latencies, 50), percentile(latencies, 90), percentile(latencies, 99), percentile(latencies, 100))
+	if s.missed > 0 {
+		ew.printf("Missed requests were not sent because all the workers were busy, increase 'workers' to reach the target rate.\n")
+	}
+	if len(s.errors) > 0 {
+		ew.printf("Errors:\n")
+		kinds := make([]string, 0, len(s.errors))
+		for kind := range s.errors {
+			kinds = append(kinds, kind)
+		}
+		// Most frequent errors first.
+		sort.Slice(kinds, func(i, j int) bool {
+			if s.errors[kinds[i]] != s.errors[kinds[j]] {
+				return s.errors[kinds[i]] > s.errors[kinds[j]]
+			}
+			return kinds[i] < kinds[j]
+		})
+		for _, kind := range kinds {
+			ew.printf("  %d\t%s\n", s.errors[kind], kind)
+		}
+	}
+	return ew.err
+}
+
+// errWriter keeps the first error of a sequence of writes.
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (ew *errWriter) printf(format string, args ...any) {
+	if ew.err == nil {
+		_, ew.err = fmt.Fprintf(ew.w, format, args...)
+	}
+}
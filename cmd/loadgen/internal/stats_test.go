@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.opentelemetry.io/collector/consumer/consumererror"
+)
+
+func TestPercentile(t *testing.T) {
+	assert.Zero(t, percentile(nil, 50))
+	sorted := make([]time.Duration, 100)
+	for i := range sorted {
+		sorted[i] = time.Duration(i+1) * time.Millisecond
+	}
+	assert.Equal(t, 51*time.Millisecond, percentile(sorted, 50))
+	assert.Equal(t, 100*time.Millisecond, percentile(sorted, 99))
+	assert.Equal(t, 100*time.Millisecond, percentile(sorted, 100))
+}
+
+func TestErrorKind(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected string
+	}{
+		{
+			err:      status.Error(codes.Unavailable, "unavailable"),
+			expected: "Unavailable (retryable)",
+		},
+		{
+			err:      consumererror.NewPermanent(status.Error(codes.InvalidArgument, "bad data")),
+			expected: "InvalidArgument (permanent)",
+		},
+		{
+			err:      fmt.Errorf("wrapped: %w", status.Error(codes.ResourceExhausted, "throttled")),
+			expected: "ResourceExhausted (retryable)",
+		},
+		{
+			err:      errors.New("connection refused"),
+			expected: "connection refused (retryable)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, errorKind(tt.err))
+		})
+	}
+}
+
+func TestStatsWrite(t *testing.T) {
+	st := newStats()
+	for i := 0; i < 8; i++ {
+		st.record(request{items: 10, bytes: 1024}, time.Duration(i+1)*time.Millisecond, nil)
+	}
+	st.record(request{items: 10, bytes: 1024}, 20*time.Millisecond, status.Error(codes.Unavailable, "unavailable"))
+	st.record(request{items: 10, bytes: 1024}, 30*time.Millisecond, status.Error(codes.Unavailable, "unavailable"))
+	st.record(request{items: 10, bytes: 1024}, 40*time.Millisecond, consumererror.NewPermanent(errors.New("bad data")))
+	st.recordMissed()
+	st.elapsed = 2 * time.Second
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, st.write(buf, "spans"))
+	assert.Equal(t, `Duration:    2s
+Requests:    11 sent, 8 succeeded, 3 failed, 1 missed
+Throughput:  4.00 requests/s, 40.00 spans/s, 4.00 KiB/s
+Latency:     p50=6ms p90=30ms p99=40ms max=40ms
+Missed requests were not sent because all the workers were busy, increase 'workers' to reach the target rate.
+Errors:
+  2	Unavailable (retryable)
+  1	bad data (permanent)
+`, buf.String())
+}
+
+func TestStatsWriteError(t *testing.T) {
+	require.Error(t, newStats().write(&failWriter{}, "spans"))
+}
+
+type failWriter struct{}
+
+func (*failWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
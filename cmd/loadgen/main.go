@@ -0,0 +1,16 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"go.opentelemetry.io/collector/cmd/loadgen/internal"
+)
+
+func main() {
+	cmd, err := internal.NewCommand()
+	cobra.CheckErr(err)
+	cobra.CheckErr(cmd.Execute())
+}
@@ -116,7 +116,32 @@ func run(ymlPath string) error {
 		toGenerate[filepath.Join(tmplDir, "telemetry_test.go.tmpl")] = filepath.Join(codeDir, "generated_telemetry_test.go")
 	}
 
-	if len(md.Metrics) != 0 || len(md.Telemetry.Metrics) != 0 || len(md.ResourceAttributes) != 0 { // if there's metrics or internal metrics, generate documentation for them
+	if md.Config != nil { // if the configuration is described, generate the Config struct and its JSON schema
+		if err = generateFile(filepath.Join(tmplDir, "component_config.go.tmpl"),
+			filepath.Join(ymlDir, "generated_component_config.go"), md, packageName); err != nil {
+			return err
+		}
+		if err = generateFile(filepath.Join(tmplDir, "component_config_test.go.tmpl"),
+			filepath.Join(ymlDir, "generated_component_config_test.go"), md, packageName); err != nil {
+			return err
+		}
+		if err = generateConfigSchema(md, filepath.Join(ymlDir, "config.schema.json")); err != nil {
+			return err
+		}
+	}
+
+	if len(md.FeatureGates) != 0 { // if there are feature gates, generate their registration
+		if err = generateFile(filepath.Join(tmplDir, "feature_gates.go.tmpl"),
+			filepath.Join(codeDir, "generated_feature_gates.go"), md, md.GeneratedPackageName); err != nil {
+			return err
+		}
+		if err = generateFile(filepath.Join(tmplDir, "feature_gates_test.go.tmpl"),
+			filepath.Join(codeDir, "generated_feature_gates_test.go"), md, md.GeneratedPackageName); err != nil {
+			return err
+		}
+	}
+
+	if md.Config != nil || len(md.FeatureGates) != 0 || len(md.Metrics) != 0 || len(md.Events) != 0 || len(md.Telemetry.Metrics) != 0 || len(md.ResourceAttributes) != 0 { // if there's a config, feature gates, metrics, events or internal metrics, generate documentation for them
 		toGenerate[filepath.Join(tmplDir, "documentation.md.tmpl")] = filepath.Join(ymlDir, "documentation.md")
 	}
 
@@ -126,7 +151,7 @@ func run(ymlPath string) error {
 		}
 	}
 
-	if len(md.Metrics) == 0 && len(md.ResourceAttributes) == 0 {
+	if len(md.Metrics) == 0 && len(md.Events) == 0 && len(md.ResourceAttributes) == 0 {
 		return nil
 	}
 
@@ -150,6 +175,11 @@ func run(ymlPath string) error {
 		toGenerate[filepath.Join(tmplDir, "metrics_test.go.tmpl")] = filepath.Join(codeDir, "generated_metrics_test.go")
 	}
 
+	if len(md.Events) > 0 { // only generate logs if events are present
+		toGenerate[filepath.Join(tmplDir, "logs.go.tmpl")] = filepath.Join(codeDir, "generated_logs.go")
+		toGenerate[filepath.Join(tmplDir, "logs_test.go.tmpl")] = filepath.Join(codeDir, "generated_logs_test.go")
+	}
+
 	for tmpl, dst := range toGenerate {
 		if err = generateFile(tmpl, dst, md, md.GeneratedPackageName); err != nil {
 			return err
@@ -177,6 +207,9 @@ func templatize(tmplFile string, md Metadata) *template.Template {
 				"telemetryInfo": func(mn MetricName) Metric {
 					return md.Telemetry.Metrics[mn]
 				},
+				"eventInfo": func(en EventName) Event {
+					return md.Events[en]
+				},
 				"parseImportsRequired": func(metrics map[MetricName]Metric) bool {
 					for _, m := range metrics {
 						if m.Data().HasMetricInputType() {
@@ -185,6 +218,14 @@ func templatize(tmplFile string, md Metadata) *template.Template {
 					}
 					return false
 				},
+				"hasMetricType": func(typ string, metrics map[MetricName]Metric) bool {
+					for _, m := range metrics {
+						if m.Data().Type() == typ {
+							return true
+						}
+					}
+					return false
+				},
 				"stringsJoin":  strings.Join,
 				"stringsSplit": strings.Split,
 				"userLinks": func(elems []string) []string {
@@ -223,6 +264,16 @@ func templatize(tmplFile string, md Metadata) *template.Template {
 				"isExtension": func() bool {
 					return md.Status.Class == "extension"
 				},
+				"componentID": func() string {
+					if md.Status == nil || md.Status.NotComponent {
+						return ""
+					}
+					switch md.Status.Class {
+					case "receiver", "processor", "exporter", "connector", "extension":
+						return md.Status.Class + "/" + md.Type
+					}
+					return ""
+				},
 				"isConnector": func() bool {
 					return md.Status.Class == "connector"
 				},
@@ -398,6 +449,17 @@ func inlineReplace(tmplFile string, outputFile string, md Metadata, start string
 	return nil
 }
 
+func generateConfigSchema(md Metadata, outputFile string) error {
+	schema, err := md.Config.JSONSchema(md.Type)
+	if err != nil {
+		return fmt.Errorf("failed to generate JSON schema: %w", err)
+	}
+	if err = os.WriteFile(outputFile, schema, 0o600); err != nil {
+		return fmt.Errorf("failed writing %q: %w", outputFile, err)
+	}
+	return nil
+}
+
 func generateFile(tmplFile string, outputFile string, md Metadata, goPackage string) error {
 	if err := os.Remove(outputFile); err != nil && !errors.Is(err, os.ErrNotExist) {
 		return fmt.Errorf("unable to remove generated file %q: %w", outputFile, err)
@@ -6,10 +6,15 @@ package internal
 import (
 	"bytes"
 	"fmt"
+	"go/ast"
 	"go/parser"
 	"go/token"
+	"io/fs"
 	"os"
+	pathpkg "path"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -35,8 +40,10 @@ func TestRunContents(t *testing.T) {
 		wantMetricsGenerated            bool
 		wantMetricsContext              bool
 		wantConfigGenerated             bool
+		wantComponentConfigGenerated    bool
 		wantTelemetryGenerated          bool
 		wantResourceAttributesGenerated bool
+		wantLogsGenerated               bool
 		wantStatusGenerated             bool
 		wantGoleakIgnore                bool
 		wantGoleakSkip                  bool
@@ -70,6 +77,22 @@ func TestRunContents(t *testing.T) {
 			wantStatusGenerated:             true,
 			wantResourceAttributesGenerated: true,
 		},
+		{
+			yml:                 "events_only.yaml",
+			wantConfigGenerated: true,
+			wantLogsGenerated:   true,
+			wantStatusGenerated: true,
+		},
+		{
+			yml:                          "config_required_only.yaml",
+			wantComponentConfigGenerated: true,
+			wantStatusGenerated:          true,
+		},
+		{
+			yml:                          "config_enum.yaml",
+			wantComponentConfigGenerated: true,
+			wantStatusGenerated:          true,
+		},
 		{
 			yml:                 "status_only.yaml",
 			wantStatusGenerated: true,
@@ -184,6 +207,15 @@ foo
 				require.NoFileExists(t, filepath.Join(tmpdir, generatedPackageDir, "generated_config_test.go"))
 			}
 
+			if tt.wantComponentConfigGenerated {
+				require.FileExists(t, filepath.Join(tmpdir, "generated_component_config.go"))
+				require.FileExists(t, filepath.Join(tmpdir, "generated_component_config_test.go"))
+				require.FileExists(t, filepath.Join(tmpdir, "config.schema.json"))
+			} else {
+				require.NoFileExists(t, filepath.Join(tmpdir, "generated_component_config.go"))
+				require.NoFileExists(t, filepath.Join(tmpdir, "generated_component_config_test.go"))
+			}
+
 			if tt.wantTelemetryGenerated {
 				require.FileExists(t, filepath.Join(tmpdir, generatedPackageDir, "generated_telemetry.go"))
 				require.FileExists(t, filepath.Join(tmpdir, generatedPackageDir, "generated_telemetry_test.go"))
@@ -200,7 +232,16 @@ foo
 				require.NoFileExists(t, filepath.Join(tmpdir, generatedPackageDir, "generated_telemetry_test.go"))
 			}
 
-			if !tt.wantMetricsGenerated && !tt.wantTelemetryGenerated && !tt.wantResourceAttributesGenerated {
+			if tt.wantLogsGenerated {
+				require.FileExists(t, filepath.Join(tmpdir, generatedPackageDir, "generated_logs.go"))
+				require.FileExists(t, filepath.Join(tmpdir, generatedPackageDir, "generated_logs_test.go"))
+				require.FileExists(t, filepath.Join(tmpdir, "documentation.md"))
+			} else {
+				require.NoFileExists(t, filepath.Join(tmpdir, generatedPackageDir, "generated_logs.go"))
+				require.NoFileExists(t, filepath.Join(tmpdir, generatedPackageDir, "generated_logs_test.go"))
+			}
+
+			if !tt.wantMetricsGenerated && !tt.wantTelemetryGenerated && !tt.wantResourceAttributesGenerated && !tt.wantLogsGenerated && !tt.wantComponentConfigGenerated {
 				require.NoFileExists(t, filepath.Join(tmpdir, "documentation.md"))
 			}
 
@@ -230,6 +271,8 @@ foo
 			_, err = parser.ParseFile(token.NewFileSet(), "", contents, parser.DeclarationErrors)
 			require.NoError(t, err)
 
+			requireConfigImportsUsed(t, tmpdir)
+
 			if tt.wantGoleakSkip {
 				require.Contains(t, string(contents), "skipping goleak test")
 			} else {
@@ -259,6 +302,40 @@ foo
 	}
 }
 
+// requireConfigImportsUsed checks that the generated config files in dir use all the packages they import,
+// as the compiler requires.
+func requireConfigImportsUsed(t *testing.T, dir string) {
+	require.NoError(t, filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Ext(path) != ".go" || !strings.Contains(d.Name(), "config") {
+			return err
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		require.NoError(t, err)
+		used := map[string]bool{}
+		ast.Inspect(file, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if id, ok := sel.X.(*ast.Ident); ok {
+					used[id.Name] = true
+				}
+			}
+			return true
+		})
+		for _, imp := range file.Imports {
+			importPath, err := strconv.Unquote(imp.Path.Value)
+			require.NoError(t, err)
+			name := pathpkg.Base(importPath)
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+			if name == "_" || name == "." {
+				continue
+			}
+			assert.True(t, used[name], "%s: %q imported and not used", path, importPath)
+		}
+		return nil
+	}))
+}
+
 func TestRun(t *testing.T) {
 	type args struct {
 		ymlPath string
@@ -0,0 +1,456 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/collector/cmd/mdatagen/internal"
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ComponentConfig describes the configuration of a component, used to generate its Config struct,
+// the default configuration, the validation and the documentation.
+type ComponentConfig struct {
+	// Description of the configuration.
+	Description string `mapstructure:"description"`
+	// Fields of the configuration.
+	Fields map[ConfigFieldName]ConfigField `mapstructure:"fields"`
+}
+
+type ConfigFieldName string
+
+func (fn ConfigFieldName) Render() (string, error) {
+	return FormatIdentifier(string(fn), true)
+}
+
+// ConfigFieldType defines the type of configuration field.
+type ConfigFieldType string
+
+const (
+	ConfigFieldTypeString      ConfigFieldType = "string"
+	ConfigFieldTypeInt         ConfigFieldType = "int"
+	ConfigFieldTypeFloat       ConfigFieldType = "float"
+	ConfigFieldTypeBool        ConfigFieldType = "bool"
+	ConfigFieldTypeDuration    ConfigFieldType = "duration"
+	ConfigFieldTypeStringSlice ConfigFieldType = "string_slice"
+	ConfigFieldTypeStringMap   ConfigFieldType = "string_map"
+)
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (t *ConfigFieldType) UnmarshalText(text []byte) error {
+	switch ft := ConfigFieldType(text); ft {
+	case ConfigFieldTypeString, ConfigFieldTypeInt, ConfigFieldTypeFloat, ConfigFieldTypeBool,
+		ConfigFieldTypeDuration, ConfigFieldTypeStringSlice, ConfigFieldTypeStringMap:
+		*t = ft
+		return nil
+	default:
+		return fmt.Errorf("invalid type: %q", string(text))
+	}
+}
+
+// Primitive returns the Go type of the field.
+func (t ConfigFieldType) Primitive() string {
+	switch t {
+	case ConfigFieldTypeInt:
+		return "int"
+	case ConfigFieldTypeFloat:
+		return "float64"
+	case ConfigFieldTypeBool:
+		return "bool"
+	case ConfigFieldTypeDuration:
+		return "time.Duration"
+	case ConfigFieldTypeStringSlice:
+		return "[]string"
+	case ConfigFieldTypeStringMap:
+		return "map[string]string"
+	default:
+		return "string"
+	}
+}
+
+// ConfigField describes a field of the component configuration.
+type ConfigField struct {
+	// Type of the field.
+	Type ConfigFieldType `mapstructure:"type"`
+	// Description of the field.
+	Description string `mapstructure:"description"`
+	// Default value of the field. Durations are specified as strings, e.g. "5s".
+	Default any `mapstructure:"default"`
+	// Required fields must be set to a non-empty value.
+	Required bool `mapstructure:"required"`
+	// Min is the minimum value of int, float and duration fields.
+	Min any `mapstructure:"min"`
+	// Max is the maximum value of int, float and duration fields.
+	Max any `mapstructure:"max"`
+	// Enum is the list of allowed values of string fields.
+	Enum []string `mapstructure:"enum"`
+}
+
+func (f *ConfigField) validate() error {
+	var errs error
+	if f.Type == "" {
+		errs = errors.Join(errs, errors.New("missing type"))
+	}
+	if f.Description == "" {
+		errs = errors.Join(errs, errors.New("missing description"))
+	}
+	if f.Required && f.Type != ConfigFieldTypeString && f.Type != ConfigFieldTypeStringSlice && f.Type != ConfigFieldTypeStringMap {
+		errs = errors.Join(errs, fmt.Errorf("`required` is not supported for %s fields, use `min` instead", f.Type))
+	}
+	if (f.Min != nil || f.Max != nil) && f.Type != ConfigFieldTypeInt && f.Type != ConfigFieldTypeFloat && f.Type != ConfigFieldTypeDuration {
+		errs = errors.Join(errs, fmt.Errorf("`min` and `max` are not supported for %s fields", f.Type))
+	}
+	if len(f.Enum) > 0 && f.Type != ConfigFieldTypeString {
+		errs = errors.Join(errs, fmt.Errorf("`enum` is not supported for %s fields", f.Type))
+	}
+	if errs != nil {
+		return errs
+	}
+
+	for _, v := range []struct {
+		name string
+		val  any
+	}{{"default", f.Default}, {"min", f.Min}, {"max", f.Max}} {
+		if v.val == nil {
+			continue
+		}
+		if _, err := f.literal(v.val); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("invalid `%s`: %w", v.name, err))
+		}
+	}
+	if errs != nil {
+		return errs
+	}
+
+	if f.Default != nil {
+		if len(f.Enum) > 0 && !slices.Contains(f.Enum, f.Default.(string)) {
+			errs = errors.Join(errs, fmt.Errorf("default value %q is not one of %v", f.Default, f.Enum))
+		}
+		if f.Min != nil && f.number(f.Default) < f.number(f.Min) {
+			errs = errors.Join(errs, fmt.Errorf("default value %v is lower than `min` %v", f.Default, f.Min))
+		}
+		if f.Max != nil && f.number(f.Default) > f.number(f.Max) {
+			errs = errors.Join(errs, fmt.Errorf("default value %v is greater than `max` %v", f.Default, f.Max))
+		}
+	}
+	if f.Min != nil && f.Max != nil && f.number(f.Min) > f.number(f.Max) {
+		errs = errors.Join(errs, fmt.Errorf("`min` %v is greater than `max` %v", f.Min, f.Max))
+	}
+	return errs
+}
+
+// HasMin returns true if the field has a minimum value.
+func (f ConfigField) HasMin() bool {
+	return f.Min != nil
+}
+
+// HasMax returns true if the field has a maximum value.
+func (f ConfigField) HasMax() bool {
+	return f.Max != nil
+}
+
+// DefaultValue returns the Go literal of the default value of the field.
+func (f ConfigField) DefaultValue() (string, error) {
+	return f.literal(f.Default)
+}
+
+// MinValue returns the Go literal of the minimum value of the field.
+func (f ConfigField) MinValue() (string, error) {
+	return f.literal(f.Min)
+}
+
+// MaxValue returns the Go literal of the maximum value of the field.
+func (f ConfigField) MaxValue() (string, error) {
+	return f.literal(f.Max)
+}
+
+// TestValue returns the Go literal of a valid value of the field, used in generated tests.
+func (f ConfigField) TestValue() (string, error) {
+	switch {
+	case f.Default != nil:
+		return f.DefaultValue()
+	case f.Min != nil:
+		return f.MinValue()
+	case f.Max != nil:
+		return f.MaxValue()
+	case len(f.Enum) > 0:
+		return strconv.Quote(f.Enum[0]), nil
+	}
+	switch f.Type {
+	case ConfigFieldTypeStringSlice:
+		return `[]string{"value"}`, nil
+	case ConfigFieldTypeStringMap:
+		return `map[string]string{"key": "value"}`, nil
+	default:
+		return `"value"`, nil
+	}
+}
+
+// Constraints returns a human readable description of the constraints of the field.
+func (f ConfigField) Constraints() string {
+	var constraints []string
+	if f.Min != nil {
+		constraints = append(constraints, fmt.Sprintf(">= %v", f.Min))
+	}
+	if f.Max != nil {
+		constraints = append(constraints, fmt.Sprintf("<= %v", f.Max))
+	}
+	if len(f.Enum) > 0 {
+		constraints = append(constraints, "one of: "+strings.Join(f.Enum, ", "))
+	}
+	return strings.Join(constraints, ", ")
+}
+
+// DefaultString returns a human readable representation of the default value of the field.
+func (f ConfigField) DefaultString() string {
+	if f.Default == nil {
+		return ""
+	}
+	if f.Type == ConfigFieldTypeStringSlice || f.Type == ConfigFieldTypeStringMap {
+		b, _ := json.Marshal(f.Default)
+		return string(b)
+	}
+	return fmt.Sprintf("%v", f.Default)
+}
+
+// literal returns the Go literal of the given value for the type of the field.
+func (f ConfigField) literal(val any) (string, error) {
+	switch f.Type {
+	case ConfigFieldTypeString:
+		if s, ok := val.(string); ok {
+			return strconv.Quote(s), nil
+		}
+	case ConfigFieldTypeInt:
+		if i, ok := val.(int); ok {
+			return strconv.Itoa(i), nil
+		}
+	case ConfigFieldTypeFloat:
+		switch v := val.(type) {
+		case int:
+			return strconv.Itoa(v), nil
+		case float64:
+			return strconv.FormatFloat(v, 'g', -1, 64), nil
+		}
+	case ConfigFieldTypeBool:
+		if b, ok := val.(bool); ok {
+			return strconv.FormatBool(b), nil
+		}
+	case ConfigFieldTypeDuration:
+		if s, ok := val.(string); ok {
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return "", err
+			}
+			return durationLiteral(d), nil
+		}
+	case ConfigFieldTypeStringSlice:
+		if vals, ok := val.([]any); ok {
+			items := make([]string, 0, len(vals))
+			for _, v := range vals {
+				s, isStr := v.(string)
+				if !isStr {
+					return "", fmt.Errorf("expected a list of strings, got %v", val)
+				}
+				items = append(items, strconv.Quote(s))
+			}
+			return "[]string{" + strings.Join(items, ", ") + "}", nil
+		}
+	case ConfigFieldTypeStringMap:
+		if m, ok := val.(map[string]any); ok {
+			keys := make([]string, 0, len(m))
+			for k := range m {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			items := make([]string, 0, len(m))
+			for _, k := range keys {
+				s, isStr := m[k].(string)
+				if !isStr {
+					return "", fmt.Errorf("expected a map of strings, got %v", val)
+				}
+				items = append(items, strconv.Quote(k)+": "+strconv.Quote(s))
+			}
+			return "map[string]string{" + strings.Join(items, ", ") + "}", nil
+		}
+	}
+	return "", fmt.Errorf("value %v is not a valid %s", val, f.Type)
+}
+
+// number returns the value of int, float and duration fields as a float, for comparisons.
+// The value must be valid for the field type.
+func (f ConfigField) number(val any) float64 {
+	switch v := val.(type) {
+	case int:
+		return float64(v)
+	case float64:
+		return v
+	case string:
+		d, _ := time.ParseDuration(v)
+		return float64(d)
+	}
+	return 0
+}
+
+func durationLiteral(d time.Duration) string {
+	for _, unit := range []struct {
+		d    time.Duration
+		name string
+	}{{time.Hour, "time.Hour"}, {time.Minute, "time.Minute"}, {time.Second, "time.Second"}, {time.Millisecond, "time.Millisecond"}} {
+		if d != 0 && d%unit.d == 0 {
+			return strconv.FormatInt(int64(d/unit.d), 10) + " * " + unit.name
+		}
+	}
+	return "time.Duration(" + strconv.FormatInt(int64(d), 10) + ")"
+}
+
+func (c *ComponentConfig) validate() error {
+	var errs error
+	if len(c.Fields) == 0 {
+		errs = errors.Join(errs, errors.New("config: missing fields"))
+	}
+	names := make([]string, 0, len(c.Fields))
+	for fn := range c.Fields {
+		names = append(names, string(fn))
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fn := ConfigFieldName(name)
+		f := c.Fields[fn]
+		if err := f.validate(); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("config field %q: %w", fn, err))
+		}
+	}
+	return errs
+}
+
+// HasFieldType returns true if the configuration has a field of the given type.
+func (c ComponentConfig) HasFieldType(t ConfigFieldType) bool {
+	for _, f := range c.Fields {
+		if f.Type == t {
+			return true
+		}
+	}
+	return false
+}
+
+// HasRequired returns true if the configuration has required fields.
+func (c ComponentConfig) HasRequired() bool {
+	for _, f := range c.Fields {
+		if f.Required {
+			return true
+		}
+	}
+	return false
+}
+
+// HasLimits returns true if the configuration has fields with minimum, maximum or allowed values.
+func (c ComponentConfig) HasLimits() bool {
+	for _, f := range c.Fields {
+		if f.Min != nil || f.Max != nil || len(f.Enum) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// HasDurationLimits returns true if the configuration has duration fields with minimum or maximum values.
+func (c ComponentConfig) HasDurationLimits() bool {
+	for _, f := range c.Fields {
+		if f.Type == ConfigFieldTypeDuration && (f.Min != nil || f.Max != nil) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasEnum returns true if the configuration has fields with allowed values.
+func (c ComponentConfig) HasEnum() bool {
+	for _, f := range c.Fields {
+		if len(f.Enum) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// durationPattern matches the durations accepted by time.ParseDuration.
+const durationPattern = `^[-+]?(0|([0-9]+(\.[0-9]*)?|\.[0-9]+)(ns|us|µs|ms|s|m|h))+$`
+
+// JSONSchema returns the JSON schema of the configuration.
+func (c ComponentConfig) JSONSchema(componentType string) ([]byte, error) {
+	properties := make(map[string]any, len(c.Fields))
+	var required []string
+	for fn, f := range c.Fields {
+		prop := map[string]any{"description": f.Description}
+		switch f.Type {
+		case ConfigFieldTypeString:
+			prop["type"] = "string"
+			if len(f.Enum) > 0 {
+				prop["enum"] = f.Enum
+			}
+		case ConfigFieldTypeInt:
+			prop["type"] = "integer"
+		case ConfigFieldTypeFloat:
+			prop["type"] = "number"
+		case ConfigFieldTypeBool:
+			prop["type"] = "boolean"
+		case ConfigFieldTypeDuration:
+			prop["type"] = "string"
+			prop["pattern"] = durationPattern
+		case ConfigFieldTypeStringSlice:
+			prop["type"] = "array"
+			prop["items"] = map[string]any{"type": "string"}
+		case ConfigFieldTypeStringMap:
+			prop["type"] = "object"
+			prop["additionalProperties"] = map[string]any{"type": "string"}
+		}
+		if f.Type != ConfigFieldTypeDuration {
+			if f.Min != nil {
+				prop["minimum"] = f.Min
+			}
+			if f.Max != nil {
+				prop["maximum"] = f.Max
+			}
+		}
+		if f.Default != nil {
+			prop["default"] = f.Default
+		}
+		switch {
+		case f.Required && f.Type == ConfigFieldTypeString:
+			prop["minLength"] = 1
+		case f.Required && f.Type == ConfigFieldTypeStringSlice:
+			prop["minItems"] = 1
+		case f.Required && f.Type == ConfigFieldTypeStringMap:
+			prop["minProperties"] = 1
+		}
+		if f.Required {
+			required = append(required, string(fn))
+		}
+		properties[string(fn)] = prop
+	}
+	sort.Strings(required)
+	schema := map[string]any{
+		"$schema":              "https://json-schema.org/draft/2020-12/schema",
+		"title":                componentType,
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if c.Description != "" {
+		schema["description"] = c.Description
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	b, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
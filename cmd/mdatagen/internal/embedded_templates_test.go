@@ -21,9 +21,15 @@ func TestEnsureTemplatesLoaded(t *testing.T) {
 
 	var (
 		templateFiles = map[string]struct{}{
+			path.Join(rootDir, "component_config.go.tmpl"):         {},
+			path.Join(rootDir, "component_config_test.go.tmpl"):    {},
 			path.Join(rootDir, "component_test.go.tmpl"):           {},
 			path.Join(rootDir, "component_telemetry_test.go.tmpl"): {},
 			path.Join(rootDir, "documentation.md.tmpl"):            {},
+			path.Join(rootDir, "feature_gates.go.tmpl"):            {},
+			path.Join(rootDir, "feature_gates_test.go.tmpl"):       {},
+			path.Join(rootDir, "logs.go.tmpl"):                     {},
+			path.Join(rootDir, "logs_test.go.tmpl"):                {},
 			path.Join(rootDir, "metrics.go.tmpl"):                  {},
 			path.Join(rootDir, "metrics_test.go.tmpl"):             {},
 			path.Join(rootDir, "resource.go.tmpl"):                 {},
@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/collector/cmd/mdatagen/internal"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+type EventName string
+
+func (en EventName) Render() (string, error) {
+	return FormatIdentifier(string(en), true)
+}
+
+func (en EventName) RenderUnexported() (string, error) {
+	return FormatIdentifier(string(en), false)
+}
+
+type Event struct {
+	// Enabled defines whether the event is enabled by default.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Description of the event.
+	Description string `mapstructure:"description"`
+
+	// ExtendedDocumentation of the event. If specified, this will
+	// be appended to the description used in generated documentation.
+	ExtendedDocumentation string `mapstructure:"extended_documentation"`
+
+	// Severity of the log records emitted for the event.
+	Severity Severity `mapstructure:"severity"`
+
+	// Body of the log records emitted for the event.
+	Body *EventBody `mapstructure:"body"`
+
+	// Attributes is the list of attributes that the event emits.
+	Attributes []AttributeName `mapstructure:"attributes"`
+}
+
+// EventBody describes the body of the log records emitted for an event.
+type EventBody struct {
+	// Description of the body.
+	Description string `mapstructure:"description"`
+}
+
+func (e *Event) validate() error {
+	var errs error
+	if e.Description == "" {
+		errs = errors.Join(errs, errors.New(`missing event description`))
+	}
+	if e.Body != nil && e.Body.Description == "" {
+		errs = errors.Join(errs, errors.New(`missing event body description`))
+	}
+	return errs
+}
+
+func (e *Event) Unmarshal(parser *confmap.Conf) error {
+	if !parser.IsSet("enabled") {
+		return errors.New("missing required field: `enabled`")
+	}
+	e.Severity = Severity{SeverityNumber: plog.SeverityNumberInfo}
+	return parser.Unmarshal(e)
+}
+
+// Severity defines the severity of an event.
+type Severity struct {
+	SeverityNumber plog.SeverityNumber
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (s *Severity) UnmarshalText(text []byte) error {
+	switch sevStr := string(text); sevStr {
+	case "trace":
+		s.SeverityNumber = plog.SeverityNumberTrace
+	case "debug":
+		s.SeverityNumber = plog.SeverityNumberDebug
+	case "info":
+		s.SeverityNumber = plog.SeverityNumberInfo
+	case "warn":
+		s.SeverityNumber = plog.SeverityNumberWarn
+	case "error":
+		s.SeverityNumber = plog.SeverityNumberError
+	case "fatal":
+		s.SeverityNumber = plog.SeverityNumberFatal
+	default:
+		return fmt.Errorf("invalid severity: %q", sevStr)
+	}
+	return nil
+}
+
+// String returns name of the plog.SeverityNumber constant for the severity, e.g. "Info".
+func (s Severity) String() string {
+	return s.SeverityNumber.String()
+}
+
+// Text returns the severity text of the log records emitted for the event, e.g. "INFO".
+func (s Severity) Text() string {
+	return strings.ToUpper(s.SeverityNumber.String())
+}
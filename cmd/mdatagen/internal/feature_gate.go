@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "go.opentelemetry.io/collector/cmd/mdatagen/internal"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+type FeatureGateID string
+
+func (id FeatureGateID) Render() (string, error) {
+	return FormatIdentifier(string(id), true)
+}
+
+type FeatureGate struct {
+	// Stage of the feature gate.
+	Stage FeatureGateStage `mapstructure:"stage"`
+
+	// Description of the feature gate.
+	Description string `mapstructure:"description"`
+
+	// FromVersion is the release in which the feature gate was introduced.
+	FromVersion string `mapstructure:"from_version"`
+
+	// ToVersion is the last release in which the feature gate can be used.
+	// Required for stable and deprecated feature gates.
+	ToVersion string `mapstructure:"to_version"`
+
+	// ReferenceURL is a link to the contextual information about the feature gate.
+	ReferenceURL string `mapstructure:"reference_url"`
+}
+
+// FeatureGateStage wraps featuregate.Stage to unmarshal it from metadata.yaml.
+type FeatureGateStage struct {
+	featuregate.Stage
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (s *FeatureGateStage) UnmarshalText(text []byte) error {
+	stages := map[string]featuregate.Stage{
+		"alpha":      featuregate.StageAlpha,
+		"beta":       featuregate.StageBeta,
+		"stable":     featuregate.StageStable,
+		"deprecated": featuregate.StageDeprecated,
+	}
+	stage, ok := stages[string(text)]
+	if !ok {
+		return fmt.Errorf("invalid stage: %q", string(text))
+	}
+	s.Stage = stage
+	return nil
+}
+
+func (g *FeatureGate) validate(id FeatureGateID) error {
+	var errs error
+	if g.Description == "" {
+		errs = errors.Join(errs, errors.New("missing feature gate description"))
+	}
+	// Register the gate in a throwaway registry to apply the same rules as at runtime.
+	opts := []featuregate.RegisterOption{featuregate.WithRegisterDescription(g.Description)}
+	if g.FromVersion != "" {
+		opts = append(opts, featuregate.WithRegisterFromVersion(g.FromVersion))
+	}
+	if g.ToVersion != "" {
+		opts = append(opts, featuregate.WithRegisterToVersion(g.ToVersion))
+	}
+	if g.ReferenceURL != "" {
+		opts = append(opts, featuregate.WithRegisterReferenceURL(g.ReferenceURL))
+	}
+	if _, err := featuregate.NewRegistry().Register(string(id), g.Stage.Stage, opts...); err != nil {
+		errs = errors.Join(errs, err)
+	}
+	return errs
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 )
 
@@ -239,6 +241,108 @@ func TestLoadMetadata(t *testing.T) {
 						},
 						Attributes: []AttributeName{"string_attr", "overridden_int_attr", "enum_attr", "slice_attr", "map_attr"},
 					},
+					"histogram.metric": {
+						Enabled:     true,
+						Description: "Cumulative histogram double metric with explicit bucket boundaries enabled by default.",
+						Unit:        strPtr("s"),
+						Histogram: &Histogram{
+							MetricValueType:        MetricValueType{pmetric.NumberDataPointValueTypeDouble},
+							AggregationTemporality: AggregationTemporality{Aggregation: pmetric.AggregationTemporalityCumulative},
+							Boundaries:             []float64{0.1, 1, 10},
+						},
+						Attributes: []AttributeName{"string_attr", "enum_attr"},
+					},
+					"optional.exponential_histogram.metric": {
+						Enabled:     false,
+						Description: "Delta exponential histogram int metric disabled by default.",
+						Unit:        strPtr("By"),
+						ExponentialHistogram: &ExponentialHistogram{
+							MetricValueType:        MetricValueType{pmetric.NumberDataPointValueTypeInt},
+							AggregationTemporality: AggregationTemporality{Aggregation: pmetric.AggregationTemporalityDelta},
+							MaxSize:                20,
+							MaxScale:               20,
+						},
+						Attributes: []AttributeName{"string_attr"},
+					},
+				},
+				Events: map[EventName]Event{
+					"default.event": {
+						Enabled:               true,
+						Description:           "Example event enabled by default.",
+						ExtendedDocumentation: "The event will be become optional soon.",
+						Severity:              Severity{SeverityNumber: plog.SeverityNumberWarn},
+						Body:                  &EventBody{Description: "Human readable message describing the event."},
+						Attributes:            []AttributeName{"string_attr", "overridden_int_attr", "enum_attr", "slice_attr", "map_attr"},
+					},
+					"optional.event": {
+						Enabled:     false,
+						Description: "Example event disabled by default.",
+						Severity:    Severity{SeverityNumber: plog.SeverityNumberInfo},
+						Attributes:  []AttributeName{"string_attr", "boolean_attr"},
+					},
+				},
+				FeatureGates: map[FeatureGateID]FeatureGate{
+					"receiver.sample.featuregate.example": {
+						Stage:        FeatureGateStage{Stage: featuregate.StageAlpha},
+						Description:  "Example feature gate disabled by default.",
+						FromVersion:  "v0.116.0",
+						ReferenceURL: "https://github.com/open-telemetry/opentelemetry-collector/issues/1",
+					},
+					"receiver.sample.featuregate.stable": {
+						Stage:       FeatureGateStage{Stage: featuregate.StageStable},
+						Description: "Example feature gate that can no longer be disabled.",
+						FromVersion: "v0.100.0",
+						ToVersion:   "v0.120.0",
+					},
+				},
+				Config: &ComponentConfig{
+					Description: "Sample configuration covering all the supported field types.",
+					Fields: map[ConfigFieldName]ConfigField{
+						"endpoint": {
+							Type:        ConfigFieldTypeString,
+							Description: "Address to listen on.",
+							Default:     "localhost:4317",
+							Required:    true,
+						},
+						"collection_interval": {
+							Type:        ConfigFieldTypeDuration,
+							Description: "Interval at which data is collected.",
+							Default:     "10s",
+							Min:         "1s",
+							Max:         "1h",
+						},
+						"max_batch_size": {
+							Type:        ConfigFieldTypeInt,
+							Description: "Maximum number of items in a batch.",
+							Default:     100,
+							Min:         1,
+						},
+						"sampling_ratio": {
+							Type:        ConfigFieldTypeFloat,
+							Description: "Ratio of items to keep.",
+							Default:     1,
+							Min:         0,
+							Max:         1,
+						},
+						"mode": {
+							Type:        ConfigFieldTypeString,
+							Description: "Collection mode.",
+							Enum:        []string{"push", "pull"},
+						},
+						"include_metadata": {
+							Type:        ConfigFieldTypeBool,
+							Description: "Whether to include metadata in the collected data.",
+						},
+						"tags": {
+							Type:        ConfigFieldTypeStringSlice,
+							Description: "Tags added to the collected data.",
+						},
+						"headers": {
+							Type:        ConfigFieldTypeStringMap,
+							Description: "Headers sent with every request.",
+							Default:     map[string]any{"user-agent": "sample"},
+						},
+					},
 				},
 				Telemetry: Telemetry{
 					Metrics: map[MetricName]Metric{
@@ -349,6 +453,21 @@ func TestLoadMetadata(t *testing.T) {
 			want:    Metadata{},
 			wantErr: "decoding failed due to the following error(s):\n\nerror decoding 'metrics[default.metric]': decoding failed due to the following error(s):\n\nerror decoding 'sum': decoding failed due to the following error(s):\n\nerror decoding 'aggregation_temporality': invalid aggregation: \"invalidaggregation\"",
 		},
+		{
+			name:    "testdata/invalid_config_field_type.yaml",
+			want:    Metadata{},
+			wantErr: "decoding failed due to the following error(s):\n\nerror decoding 'config.fields[endpoint].type': invalid type: \"url\"",
+		},
+		{
+			name:    "testdata/invalid_feature_gate_stage.yaml",
+			want:    Metadata{},
+			wantErr: "decoding failed due to the following error(s):\n\nerror decoding 'feature_gates[receiver.sample.gate].stage': invalid stage: \"experimental\"",
+		},
+		{
+			name:    "testdata/invalid_severity.yaml",
+			want:    Metadata{},
+			wantErr: "decoding failed due to the following error(s):\n\nerror decoding 'events[host.restarted]': decoding failed due to the following error(s):\n\nerror decoding 'severity': invalid severity: \"critical\"",
+		},
 		{
 			name:    "testdata/invalid_type_attr.yaml",
 			want:    Metadata{},
@@ -7,11 +7,13 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
 	"go.opentelemetry.io/collector/filter"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 )
 
 type Metadata struct {
@@ -33,6 +35,12 @@ type Metadata struct {
 	Attributes map[AttributeName]Attribute `mapstructure:"attributes"`
 	// Metrics that can be emitted by the component.
 	Metrics map[MetricName]Metric `mapstructure:"metrics"`
+	// Events that can be emitted by the component.
+	Events map[EventName]Event `mapstructure:"events"`
+	// FeatureGates registered by the component.
+	FeatureGates map[FeatureGateID]FeatureGate `mapstructure:"feature_gates"`
+	// Config describes the configuration of the component, used to generate its Config struct.
+	Config *ComponentConfig `mapstructure:"config"`
 	// GithubProject is the project where the component README lives in the format of org/repo, defaults to open-telemetry/opentelemetry-collector-contrib
 	GithubProject string `mapstructure:"github_project"`
 	// ScopeName of the metrics emitted by the component.
@@ -65,6 +73,30 @@ func (md *Metadata) Validate() error {
 	if err := md.validateMetrics(); err != nil {
 		errs = errors.Join(errs, err)
 	}
+
+	if md.Config != nil {
+		errs = errors.Join(errs, md.Config.validate())
+	}
+
+	if err := md.validateFeatureGates(); err != nil {
+		errs = errors.Join(errs, err)
+	}
+	return errs
+}
+
+func (md *Metadata) validateFeatureGates() error {
+	var errs error
+	ids := make([]string, 0, len(md.FeatureGates))
+	for id := range md.FeatureGates {
+		ids = append(ids, string(id))
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		g := md.FeatureGates[FeatureGateID(id)]
+		if err := g.validate(FeatureGateID(id)); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("feature gate %q: %w", id, err))
+		}
+	}
 	return errs
 }
 
@@ -110,7 +142,24 @@ func (md *Metadata) validateMetrics() error {
 	usedAttrs := map[AttributeName]bool{}
 	errs = errors.Join(errs, validateMetrics(md.Metrics, md.Attributes, usedAttrs),
 		validateMetrics(md.Telemetry.Metrics, md.Attributes, usedAttrs),
+		validateEvents(md.Events, md.Attributes, usedAttrs),
 		md.validateAttributes(usedAttrs))
+	for mn, m := range md.Metrics {
+		if m.Histogram != nil && len(m.Histogram.Boundaries) == 0 {
+			errs = errors.Join(errs, fmt.Errorf("metric %q: missing required field: `bucket_boundaries`", mn))
+		}
+		// The temporality of the internal telemetry histograms is chosen by the SDK readers, it is only
+		// required for the histograms recorded by the generated MetricsBuilder.
+		if (m.Histogram != nil && m.Histogram.Aggregation == pmetric.AggregationTemporalityUnspecified) ||
+			(m.ExponentialHistogram != nil && m.ExponentialHistogram.Aggregation == pmetric.AggregationTemporalityUnspecified) {
+			errs = errors.Join(errs, fmt.Errorf("metric %q: missing required field: `aggregation_temporality`", mn))
+		}
+	}
+	for mn, m := range md.Telemetry.Metrics {
+		if m.ExponentialHistogram != nil {
+			errs = errors.Join(errs, fmt.Errorf("telemetry metric %q: exponential histograms are not supported", mn))
+		}
+	}
 	return errs
 }
 
@@ -157,6 +206,28 @@ func validateMetrics(metrics map[MetricName]Metric, attributes map[AttributeName
 	return errs
 }
 
+func validateEvents(events map[EventName]Event, attributes map[AttributeName]Attribute, usedAttrs map[AttributeName]bool) error {
+	var errs error
+	for en, e := range events {
+		if err := e.validate(); err != nil {
+			errs = errors.Join(errs, fmt.Errorf(`event "%v": %w`, en, err))
+			continue
+		}
+		unknownAttrs := make([]AttributeName, 0, len(e.Attributes))
+		for _, attr := range e.Attributes {
+			if _, ok := attributes[attr]; ok {
+				usedAttrs[attr] = true
+			} else {
+				unknownAttrs = append(unknownAttrs, attr)
+			}
+		}
+		if len(unknownAttrs) > 0 {
+			errs = errors.Join(errs, fmt.Errorf(`event "%v" refers to undefined attributes: %v`, en, unknownAttrs))
+		}
+	}
+	return errs
+}
+
 type AttributeName string
 
 func (mn AttributeName) Render() (string, error) {
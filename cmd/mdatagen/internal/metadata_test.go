@@ -68,17 +68,61 @@ func TestValidate(t *testing.T) {
 		{
 			name: "testdata/no_metric_type.yaml",
 			wantErr: "metric \"system.cpu.time\": missing metric type key, " +
-				"one of the following has to be specified: sum, gauge, histogram",
+				"one of the following has to be specified: sum, gauge, histogram, exponential_histogram",
 		},
 		{
 			name: "testdata/two_metric_types.yaml",
 			wantErr: "metric \"system.cpu.time\": more than one metric type keys, " +
-				"only one of the following has to be specified: sum, gauge, histogram",
+				"only one of the following has to be specified: sum, gauge, histogram, exponential_histogram",
 		},
 		{
 			name:    "testdata/invalid_input_type.yaml",
 			wantErr: "metric \"system.cpu.time\": invalid `input_type` value \"double\", must be \"\" or \"string\"",
 		},
+		{
+			name:    "testdata/no_histogram_bucket_boundaries.yaml",
+			wantErr: "metric \"system.cpu.time\": missing required field: `bucket_boundaries`",
+		},
+		{
+			name:    "testdata/no_histogram_aggregation.yaml",
+			wantErr: "metric \"system.cpu.time\": missing required field: `aggregation_temporality`",
+		},
+		{
+			name:    "testdata/no_exponential_histogram_aggregation.yaml",
+			wantErr: "metric \"system.cpu.time\": missing required field: `aggregation_temporality`",
+		},
+		{
+			name:    "testdata/unsorted_histogram_bucket_boundaries.yaml",
+			wantErr: "metric \"system.cpu.time\": `bucket_boundaries` must be sorted in increasing order, got [1 10 5]",
+		},
+		{
+			name:    "testdata/invalid_exponential_histogram.yaml",
+			wantErr: "metric \"system.cpu.time\": `max_size` must be at least 2, got 1\n`max_scale` must be between -10 and 20, got 21",
+		},
+		{
+			name:    "testdata/telemetry_exponential_histogram.yaml",
+			wantErr: "telemetry metric \"request_duration\": exponential histograms are not supported",
+		},
+		{
+			name: "testdata/invalid_config.yaml",
+			wantErr: "config field \"endpoint\": missing description\n" +
+				"config field \"interval\": `min` 10s is greater than `max` 1s\n" +
+				"config field \"mode\": default value \"both\" is not one of [push pull]\n" +
+				"config field \"size\": `required` is not supported for int fields, use `min` instead",
+		},
+		{
+			name: "testdata/invalid_feature_gates.yaml",
+			wantErr: "feature gate \"receiver.sample.deprecated\": no removal version set for Deprecated gate \"receiver.sample.deprecated\"\n" +
+				"feature gate \"receiver.sample.nodescription\": missing feature gate description",
+		},
+		{
+			name:    "testdata/no_event_description.yaml",
+			wantErr: "event \"host.restarted\": missing event description",
+		},
+		{
+			name:    "testdata/unknown_event_attribute.yaml",
+			wantErr: "event \"host.restarted\" refers to undefined attributes: [missing]",
+		},
 		{
 			name:    "testdata/unknown_metric_attribute.yaml",
 			wantErr: "metric \"system.cpu.time\" refers to undefined attributes: [missing]",
@@ -57,6 +57,8 @@ type Metric struct {
 	Gauge *Gauge `mapstructure:"gauge,omitempty"`
 	// Histogram stores metadata for histogram metric type
 	Histogram *Histogram `mapstructure:"histogram,omitempty"`
+	// ExponentialHistogram stores metadata for exponential histogram metric type
+	ExponentialHistogram *ExponentialHistogram `mapstructure:"exponential_histogram,omitempty"`
 
 	// Attributes is the list of attributes that the metric emits.
 	Attributes []AttributeName `mapstructure:"attributes"`
@@ -84,13 +86,19 @@ func (s Stability) String() string {
 
 func (m *Metric) validate() error {
 	var errs error
-	if m.Sum == nil && m.Gauge == nil && m.Histogram == nil {
+	types := 0
+	for _, set := range []bool{m.Sum != nil, m.Gauge != nil, m.Histogram != nil, m.ExponentialHistogram != nil} {
+		if set {
+			types++
+		}
+	}
+	if types == 0 {
 		errs = errors.Join(errs, errors.New("missing metric type key, "+
-			"one of the following has to be specified: sum, gauge, histogram"))
+			"one of the following has to be specified: sum, gauge, histogram, exponential_histogram"))
 	}
-	if (m.Sum != nil && m.Gauge != nil) || (m.Sum != nil && m.Histogram != nil) || (m.Gauge != nil && m.Histogram != nil) {
+	if types > 1 {
 		errs = errors.Join(errs, errors.New("more than one metric type keys, "+
-			"only one of the following has to be specified: sum, gauge, histogram"))
+			"only one of the following has to be specified: sum, gauge, histogram, exponential_histogram"))
 	}
 	if m.Description == "" {
 		errs = errors.Join(errs, errors.New(`missing metric description`))
@@ -104,6 +112,12 @@ func (m *Metric) validate() error {
 	if m.Gauge != nil {
 		errs = errors.Join(errs, m.Gauge.Validate())
 	}
+	if m.Histogram != nil {
+		errs = errors.Join(errs, m.Histogram.Validate())
+	}
+	if m.ExponentialHistogram != nil {
+		errs = errors.Join(errs, m.ExponentialHistogram.Validate())
+	}
 	return errs
 }
 
@@ -124,6 +138,9 @@ func (m Metric) Data() MetricData {
 	if m.Histogram != nil {
 		return m.Histogram
 	}
+	if m.ExponentialHistogram != nil {
+		return m.ExponentialHistogram
+	}
 	return nil
 }
 
@@ -356,7 +373,7 @@ func (d *Histogram) HasMonotonic() bool {
 }
 
 func (d *Histogram) HasAggregated() bool {
-	return false
+	return true
 }
 
 func (d *Histogram) Instrument() string {
@@ -375,3 +392,84 @@ func (d *Histogram) Unmarshal(parser *confmap.Conf) error {
 func (d *Histogram) IsAsync() bool {
 	return d.Async
 }
+
+func (d *Histogram) Validate() error {
+	var errs error
+	for i := 1; i < len(d.Boundaries); i++ {
+		if d.Boundaries[i] <= d.Boundaries[i-1] {
+			errs = errors.Join(errs, fmt.Errorf("`bucket_boundaries` must be sorted in increasing order, got %v", d.Boundaries))
+			break
+		}
+	}
+	if d.InputType != "" {
+		errs = errors.Join(errs, errors.New("`input_type` is not supported for histograms"))
+	}
+	return errs
+}
+
+const (
+	// defaultExponentialHistogramMaxSize is the default maximum number of buckets of each range
+	// of an exponential histogram, matches the default of the OpenTelemetry SDK aggregation.
+	defaultExponentialHistogramMaxSize = 160
+	// defaultExponentialHistogramMaxScale is the default maximum scale of an exponential histogram,
+	// matches the default of the OpenTelemetry SDK aggregation.
+	defaultExponentialHistogramMaxScale = 20
+)
+
+var _ MetricData = (*ExponentialHistogram)(nil)
+
+type ExponentialHistogram struct {
+	AggregationTemporality `mapstructure:"aggregation_temporality"`
+	MetricValueType        `mapstructure:"value_type"`
+	MetricInputType        `mapstructure:",squash"`
+	// MaxSize is the maximum number of buckets of the positive and negative ranges
+	// of data points recorded from observations.
+	MaxSize int32 `mapstructure:"max_size"`
+	// MaxScale is the maximum scale of data points recorded from observations.
+	MaxScale int32 `mapstructure:"max_scale"`
+}
+
+// Unmarshal is a custom unmarshaler for exponential histogram. Needed mostly to avoid MetricValueType.Unmarshal inheritance.
+func (d *ExponentialHistogram) Unmarshal(parser *confmap.Conf) error {
+	if err := d.MetricValueType.Unmarshal(parser); err != nil {
+		return err
+	}
+	d.MaxSize = defaultExponentialHistogramMaxSize
+	d.MaxScale = defaultExponentialHistogramMaxScale
+	return parser.Unmarshal(d, confmap.WithIgnoreUnused())
+}
+
+func (d *ExponentialHistogram) Validate() error {
+	var errs error
+	if d.MaxSize < 2 {
+		errs = errors.Join(errs, fmt.Errorf("`max_size` must be at least 2, got %d", d.MaxSize))
+	}
+	if d.MaxScale < -10 || d.MaxScale > 20 {
+		errs = errors.Join(errs, fmt.Errorf("`max_scale` must be between -10 and 20, got %d", d.MaxScale))
+	}
+	if d.InputType != "" {
+		errs = errors.Join(errs, errors.New("`input_type` is not supported for exponential histograms"))
+	}
+	return errs
+}
+
+func (d *ExponentialHistogram) Type() string {
+	return "ExponentialHistogram"
+}
+
+func (d *ExponentialHistogram) HasMonotonic() bool {
+	return false
+}
+
+func (d *ExponentialHistogram) HasAggregated() bool {
+	return true
+}
+
+func (d *ExponentialHistogram) Instrument() string {
+	instrumentName := cases.Title(language.English).String(d.MetricValueType.BasicType())
+	return instrumentName + "Histogram"
+}
+
+func (d *ExponentialHistogram) IsAsync() bool {
+	return false
+}
@@ -29,7 +29,10 @@ func TestMetricData(t *testing.T) {
 		{&Sum{Async: true}, "Sum", true, true, "ObservableUpDownCounter", true},
 		{&Sum{MetricValueType: MetricValueType{pmetric.NumberDataPointValueTypeInt}, Async: true}, "Sum", true, true, "Int64ObservableUpDownCounter", true},
 		{&Sum{MetricValueType: MetricValueType{pmetric.NumberDataPointValueTypeDouble}, Async: true}, "Sum", true, true, "Float64ObservableUpDownCounter", true},
-		{&Histogram{}, "Histogram", false, false, "Histogram", false},
+		{&Histogram{}, "Histogram", true, false, "Histogram", false},
+		{&Histogram{MetricValueType: MetricValueType{pmetric.NumberDataPointValueTypeDouble}}, "Histogram", true, false, "Float64Histogram", false},
+		{&ExponentialHistogram{}, "ExponentialHistogram", true, false, "Histogram", false},
+		{&ExponentialHistogram{MetricValueType: MetricValueType{pmetric.NumberDataPointValueTypeInt}}, "ExponentialHistogram", true, false, "Int64Histogram", false},
 	} {
 		assert.Equal(t, arg.wantType, arg.metricData.Type())
 		assert.Equal(t, arg.wantHasAggregated, arg.metricData.HasAggregated())
@@ -0,0 +1,73 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package samplereceiver
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config defines the configuration of the sample receiver.
+//
+// Sample configuration covering all the supported field types.
+type Config struct {
+	// Interval at which data is collected.
+	CollectionInterval time.Duration `mapstructure:"collection_interval"`
+	// Address to listen on.
+	Endpoint string `mapstructure:"endpoint"`
+	// Headers sent with every request.
+	Headers map[string]string `mapstructure:"headers"`
+	// Whether to include metadata in the collected data.
+	IncludeMetadata bool `mapstructure:"include_metadata"`
+	// Maximum number of items in a batch.
+	MaxBatchSize int `mapstructure:"max_batch_size"`
+	// Collection mode.
+	Mode string `mapstructure:"mode"`
+	// Ratio of items to keep.
+	SamplingRatio float64 `mapstructure:"sampling_ratio"`
+	// Tags added to the collected data.
+	Tags []string `mapstructure:"tags"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		CollectionInterval: 10 * time.Second,
+		Endpoint:           "localhost:4317",
+		Headers:            map[string]string{"user-agent": "sample"},
+		MaxBatchSize:       100,
+		SamplingRatio:      1,
+	}
+}
+
+// Validate checks if the configuration is valid.
+func (cfg *Config) Validate() error {
+	var errs error
+	if cfg.CollectionInterval < 1*time.Second {
+		errs = errors.Join(errs, fmt.Errorf("`collection_interval` must be greater than or equal to 1s, got %v", cfg.CollectionInterval))
+	}
+	if cfg.CollectionInterval > 1*time.Hour {
+		errs = errors.Join(errs, fmt.Errorf("`collection_interval` must be lower than or equal to 1h, got %v", cfg.CollectionInterval))
+	}
+	if len(cfg.Endpoint) == 0 {
+		errs = errors.Join(errs, errors.New("`endpoint` must be specified"))
+	}
+	if cfg.MaxBatchSize < 1 {
+		errs = errors.Join(errs, fmt.Errorf("`max_batch_size` must be greater than or equal to 1, got %v", cfg.MaxBatchSize))
+	}
+	if cfg.Mode != "" && !slices.Contains([]string{"push", "pull"}, cfg.Mode) {
+		errs = errors.Join(errs, fmt.Errorf("`mode` must be one of %s, got %q", "push, pull", cfg.Mode))
+	}
+	if cfg.SamplingRatio < 0 {
+		errs = errors.Join(errs, fmt.Errorf("`sampling_ratio` must be greater than or equal to 0, got %v", cfg.SamplingRatio))
+	}
+	if cfg.SamplingRatio > 1 {
+		errs = errors.Join(errs, fmt.Errorf("`sampling_ratio` must be lower than or equal to 1, got %v", cfg.SamplingRatio))
+	}
+	return errs
+}
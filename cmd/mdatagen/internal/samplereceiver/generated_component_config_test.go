@@ -0,0 +1,85 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package samplereceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		update      func(*Config)
+		expectedErr string
+	}{
+		{
+			name:   "valid",
+			update: func(*Config) {},
+		},
+		{
+			name: "collection_interval_below_min",
+			update: func(cfg *Config) {
+				cfg.CollectionInterval = 1*time.Second - 1
+			},
+			expectedErr: "`collection_interval` must be greater than or equal to 1s",
+		},
+		{
+			name: "collection_interval_above_max",
+			update: func(cfg *Config) {
+				cfg.CollectionInterval = 1*time.Hour + 1
+			},
+			expectedErr: "`collection_interval` must be lower than or equal to 1h",
+		},
+		{
+			name: "missing_endpoint",
+			update: func(cfg *Config) {
+				cfg.Endpoint = ""
+			},
+			expectedErr: "`endpoint` must be specified",
+		},
+		{
+			name: "max_batch_size_below_min",
+			update: func(cfg *Config) {
+				cfg.MaxBatchSize = 1 - 1
+			},
+			expectedErr: "`max_batch_size` must be greater than or equal to 1",
+		},
+		{
+			name: "invalid_mode",
+			update: func(cfg *Config) {
+				cfg.Mode = "invalid_value"
+			},
+			expectedErr: "`mode` must be one of",
+		},
+		{
+			name: "sampling_ratio_below_min",
+			update: func(cfg *Config) {
+				cfg.SamplingRatio = 0 - 1
+			},
+			expectedErr: "`sampling_ratio` must be greater than or equal to 0",
+		},
+		{
+			name: "sampling_ratio_above_max",
+			update: func(cfg *Config) {
+				cfg.SamplingRatio = 1 + 1
+			},
+			expectedErr: "`sampling_ratio` must be lower than or equal to 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Mode = "push"
+			tt.update(cfg)
+			err := cfg.Validate()
+			if tt.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metadata
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestRecordExponentialHistogramObservationsMinScale(t *testing.T) {
+	dp := pmetric.NewExponentialHistogramDataPoint()
+	// The smallest and the largest float64 values are in buckets -2 and 0 at the lowest scale,
+	// which don't fit in 2 buckets: the scale is not lowered further.
+	recordExponentialHistogramObservations(dp, []float64{math.SmallestNonzeroFloat64, math.MaxFloat64}, 2, 20)
+	assert.Equal(t, int32(-10), dp.Scale())
+	assert.Equal(t, int32(-2), dp.Positive().Offset())
+	assert.Equal(t, []uint64{1, 0, 1}, dp.Positive().BucketCounts().AsRaw())
+	assert.Equal(t, uint64(2), dp.Count())
+}
@@ -28,11 +28,13 @@ func (ms *MetricConfig) Unmarshal(parser *confmap.Conf) error {
 
 // MetricsConfig provides config for sample metrics.
 type MetricsConfig struct {
-	DefaultMetric            MetricConfig `mapstructure:"default.metric"`
-	DefaultMetricToBeRemoved MetricConfig `mapstructure:"default.metric.to_be_removed"`
-	MetricInputType          MetricConfig `mapstructure:"metric.input_type"`
-	OptionalMetric           MetricConfig `mapstructure:"optional.metric"`
-	OptionalMetricEmptyUnit  MetricConfig `mapstructure:"optional.metric.empty_unit"`
+	DefaultMetric                      MetricConfig `mapstructure:"default.metric"`
+	DefaultMetricToBeRemoved           MetricConfig `mapstructure:"default.metric.to_be_removed"`
+	HistogramMetric                    MetricConfig `mapstructure:"histogram.metric"`
+	MetricInputType                    MetricConfig `mapstructure:"metric.input_type"`
+	OptionalExponentialHistogramMetric MetricConfig `mapstructure:"optional.exponential_histogram.metric"`
+	OptionalMetric                     MetricConfig `mapstructure:"optional.metric"`
+	OptionalMetricEmptyUnit            MetricConfig `mapstructure:"optional.metric.empty_unit"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
@@ -43,9 +45,15 @@ func DefaultMetricsConfig() MetricsConfig {
 		DefaultMetricToBeRemoved: MetricConfig{
 			Enabled: true,
 		},
+		HistogramMetric: MetricConfig{
+			Enabled: true,
+		},
 		MetricInputType: MetricConfig{
 			Enabled: true,
 		},
+		OptionalExponentialHistogramMetric: MetricConfig{
+			Enabled: false,
+		},
 		OptionalMetric: MetricConfig{
 			Enabled: false,
 		},
@@ -55,6 +63,28 @@ func DefaultMetricsConfig() MetricsConfig {
 	}
 }
 
+// EventConfig provides common config for a particular event.
+type EventConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// EventsConfig provides config for sample events.
+type EventsConfig struct {
+	DefaultEvent  EventConfig `mapstructure:"default.event"`
+	OptionalEvent EventConfig `mapstructure:"optional.event"`
+}
+
+func DefaultEventsConfig() EventsConfig {
+	return EventsConfig{
+		DefaultEvent: EventConfig{
+			Enabled: true,
+		},
+		OptionalEvent: EventConfig{
+			Enabled: false,
+		},
+	}
+}
+
 // ResourceAttributeConfig provides common config for a particular resource attribute.
 type ResourceAttributeConfig struct {
 	Enabled bool `mapstructure:"enabled"`
@@ -134,3 +164,16 @@ func DefaultMetricsBuilderConfig() MetricsBuilderConfig {
 		ResourceAttributes: DefaultResourceAttributesConfig(),
 	}
 }
+
+// LogsBuilderConfig is a configuration for sample logs builder.
+type LogsBuilderConfig struct {
+	Events             EventsConfig             `mapstructure:"events"`
+	ResourceAttributes ResourceAttributesConfig `mapstructure:"resource_attributes"`
+}
+
+func DefaultLogsBuilderConfig() LogsBuilderConfig {
+	return LogsBuilderConfig{
+		Events:             DefaultEventsConfig(),
+		ResourceAttributes: DefaultResourceAttributesConfig(),
+	}
+}
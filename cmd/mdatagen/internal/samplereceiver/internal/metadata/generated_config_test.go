@@ -10,6 +10,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 )
 
@@ -26,11 +27,13 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					DefaultMetric:            MetricConfig{Enabled: true},
-					DefaultMetricToBeRemoved: MetricConfig{Enabled: true},
-					MetricInputType:          MetricConfig{Enabled: true},
-					OptionalMetric:           MetricConfig{Enabled: true},
-					OptionalMetricEmptyUnit:  MetricConfig{Enabled: true},
+					DefaultMetric:                      MetricConfig{Enabled: true},
+					DefaultMetricToBeRemoved:           MetricConfig{Enabled: true},
+					HistogramMetric:                    MetricConfig{Enabled: true},
+					MetricInputType:                    MetricConfig{Enabled: true},
+					OptionalExponentialHistogramMetric: MetricConfig{Enabled: true},
+					OptionalMetric:                     MetricConfig{Enabled: true},
+					OptionalMetricEmptyUnit:            MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					MapResourceAttr:                  ResourceAttributeConfig{Enabled: true},
@@ -48,11 +51,13 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					DefaultMetric:            MetricConfig{Enabled: false},
-					DefaultMetricToBeRemoved: MetricConfig{Enabled: false},
-					MetricInputType:          MetricConfig{Enabled: false},
-					OptionalMetric:           MetricConfig{Enabled: false},
-					OptionalMetricEmptyUnit:  MetricConfig{Enabled: false},
+					DefaultMetric:                      MetricConfig{Enabled: false},
+					DefaultMetricToBeRemoved:           MetricConfig{Enabled: false},
+					HistogramMetric:                    MetricConfig{Enabled: false},
+					MetricInputType:                    MetricConfig{Enabled: false},
+					OptionalExponentialHistogramMetric: MetricConfig{Enabled: false},
+					OptionalMetric:                     MetricConfig{Enabled: false},
+					OptionalMetricEmptyUnit:            MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					MapResourceAttr:                  ResourceAttributeConfig{Enabled: false},
@@ -82,7 +87,76 @@ func loadMetricsBuilderConfig(t *testing.T, name string) MetricsBuilderConfig {
 	sub, err := cm.Sub(name)
 	require.NoError(t, err)
 	cfg := DefaultMetricsBuilderConfig()
-	require.NoError(t, sub.Unmarshal(&cfg))
+	// The test configurations also contain the events settings of the logs builder.
+	require.NoError(t, sub.Unmarshal(&cfg, confmap.WithIgnoreUnused()))
+	return cfg
+}
+
+func TestLogsBuilderConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		want LogsBuilderConfig
+	}{
+		{
+			name: "default",
+			want: DefaultLogsBuilderConfig(),
+		},
+		{
+			name: "all_set",
+			want: LogsBuilderConfig{
+				Events: EventsConfig{
+					DefaultEvent:  EventConfig{Enabled: true},
+					OptionalEvent: EventConfig{Enabled: true},
+				},
+				ResourceAttributes: ResourceAttributesConfig{
+					MapResourceAttr:                  ResourceAttributeConfig{Enabled: true},
+					OptionalResourceAttr:             ResourceAttributeConfig{Enabled: true},
+					SliceResourceAttr:                ResourceAttributeConfig{Enabled: true},
+					StringEnumResourceAttr:           ResourceAttributeConfig{Enabled: true},
+					StringResourceAttr:               ResourceAttributeConfig{Enabled: true},
+					StringResourceAttrDisableWarning: ResourceAttributeConfig{Enabled: true},
+					StringResourceAttrRemoveWarning:  ResourceAttributeConfig{Enabled: true},
+					StringResourceAttrToBeRemoved:    ResourceAttributeConfig{Enabled: true},
+				},
+			},
+		},
+		{
+			name: "none_set",
+			want: LogsBuilderConfig{
+				Events: EventsConfig{
+					DefaultEvent:  EventConfig{Enabled: false},
+					OptionalEvent: EventConfig{Enabled: false},
+				},
+				ResourceAttributes: ResourceAttributesConfig{
+					MapResourceAttr:                  ResourceAttributeConfig{Enabled: false},
+					OptionalResourceAttr:             ResourceAttributeConfig{Enabled: false},
+					SliceResourceAttr:                ResourceAttributeConfig{Enabled: false},
+					StringEnumResourceAttr:           ResourceAttributeConfig{Enabled: false},
+					StringResourceAttr:               ResourceAttributeConfig{Enabled: false},
+					StringResourceAttrDisableWarning: ResourceAttributeConfig{Enabled: false},
+					StringResourceAttrRemoveWarning:  ResourceAttributeConfig{Enabled: false},
+					StringResourceAttrToBeRemoved:    ResourceAttributeConfig{Enabled: false},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := loadLogsBuilderConfig(t, tt.name)
+			diff := cmp.Diff(tt.want, cfg, cmpopts.IgnoreUnexported(ResourceAttributeConfig{}))
+			require.Emptyf(t, diff, "Config mismatch (-expected +actual):\n%s", diff)
+		})
+	}
+}
+
+func loadLogsBuilderConfig(t *testing.T, name string) LogsBuilderConfig {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	sub, err := cm.Sub(name)
+	require.NoError(t, err)
+	cfg := DefaultLogsBuilderConfig()
+	// The test configurations also contain the metrics settings of the metrics builder.
+	require.NoError(t, sub.Unmarshal(&cfg, confmap.WithIgnoreUnused()))
 	return cfg
 }
 
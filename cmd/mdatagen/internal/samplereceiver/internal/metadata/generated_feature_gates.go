@@ -0,0 +1,29 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+// ReceiverSampleFeaturegateExampleFeatureGate is the "receiver.sample.featuregate.example" feature gate.
+// Example feature gate disabled by default.
+var ReceiverSampleFeaturegateExampleFeatureGate = featuregate.GlobalRegistry().MustRegister(
+	"receiver.sample.featuregate.example",
+	featuregate.StageAlpha,
+	featuregate.WithRegisterDescription("Example feature gate disabled by default."),
+	featuregate.WithRegisterFromVersion("v0.116.0"),
+	featuregate.WithRegisterReferenceURL("https://github.com/open-telemetry/opentelemetry-collector/issues/1"),
+	featuregate.WithRegisterComponent("receiver/sample"),
+)
+
+// ReceiverSampleFeaturegateStableFeatureGate is the "receiver.sample.featuregate.stable" feature gate.
+// Example feature gate that can no longer be disabled.
+var ReceiverSampleFeaturegateStableFeatureGate = featuregate.GlobalRegistry().MustRegister(
+	"receiver.sample.featuregate.stable",
+	featuregate.StageStable,
+	featuregate.WithRegisterDescription("Example feature gate that can no longer be disabled."),
+	featuregate.WithRegisterFromVersion("v0.100.0"),
+	featuregate.WithRegisterToVersion("v0.120.0"),
+	featuregate.WithRegisterComponent("receiver/sample"),
+)
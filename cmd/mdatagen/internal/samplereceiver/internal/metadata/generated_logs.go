@@ -0,0 +1,121 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver"
+	conventions "go.opentelemetry.io/collector/semconv/v1.9.0"
+)
+
+// LogsBuilder provides an interface for receivers to report events as log records while taking care of all the
+// transformations required to produce the representation defined in metadata and user config.
+type LogsBuilder struct {
+	config           LogsBuilderConfig   // config of the logs builder.
+	logRecordsBuffer plog.LogRecordSlice // accumulates log records of the current resource before emitting.
+	logsBuffer       plog.Logs           // accumulates logs data before emitting.
+	buildInfo        component.BuildInfo // contains version information.
+}
+
+// NewLogsBuilder returns a new logs builder for the given configuration.
+func NewLogsBuilder(lbc LogsBuilderConfig, settings receiver.Settings) *LogsBuilder {
+	return &LogsBuilder{
+		config:           lbc,
+		logRecordsBuffer: plog.NewLogRecordSlice(),
+		logsBuffer:       plog.NewLogs(),
+		buildInfo:        settings.BuildInfo,
+	}
+}
+
+// NewResourceBuilder returns a new resource builder that should be used to build a resource associated with for the emitted logs.
+func (lb *LogsBuilder) NewResourceBuilder() *ResourceBuilder {
+	return NewResourceBuilder(lb.config.ResourceAttributes)
+}
+
+// ResourceLogsOption applies changes to provided resource logs.
+type ResourceLogsOption interface {
+	apply(plog.ResourceLogs)
+}
+
+type resourceLogsOptionFunc func(plog.ResourceLogs)
+
+func (rlof resourceLogsOptionFunc) apply(rl plog.ResourceLogs) {
+	rlof(rl)
+}
+
+// WithLogsResource sets the provided resource on the emitted ResourceLogs.
+// It's recommended to use ResourceBuilder to create the resource.
+func WithLogsResource(res pcommon.Resource) ResourceLogsOption {
+	return resourceLogsOptionFunc(func(rl plog.ResourceLogs) {
+		res.CopyTo(rl.Resource())
+	})
+}
+
+// EmitForResource saves all the recorded events under a new resource and updates the internal state to be ready for
+// recording another set of events as part of another resource. This function can be helpful when one receiver
+// needs to emit events from several resources. Otherwise calling this function is not required,
+// just `Emit` function can be called instead.
+// Resource attributes should be provided as ResourceLogsOption arguments.
+func (lb *LogsBuilder) EmitForResource(options ...ResourceLogsOption) {
+	rl := plog.NewResourceLogs()
+	rl.SetSchemaUrl(conventions.SchemaURL)
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName("go.opentelemetry.io/collector/internal/receiver/samplereceiver")
+	sl.Scope().SetVersion(lb.buildInfo.Version)
+
+	for _, op := range options {
+		op.apply(rl)
+	}
+
+	if lb.logRecordsBuffer.Len() > 0 {
+		lb.logRecordsBuffer.MoveAndAppendTo(sl.LogRecords())
+		rl.MoveTo(lb.logsBuffer.ResourceLogs().AppendEmpty())
+	}
+}
+
+// Emit returns all the logs accumulated by the logs builder and updates the internal state to be ready for
+// recording another set of events.
+func (lb *LogsBuilder) Emit(options ...ResourceLogsOption) plog.Logs {
+	lb.EmitForResource(options...)
+	logs := lb.logsBuffer
+	lb.logsBuffer = plog.NewLogs()
+	return logs
+}
+
+// RecordDefaultEventEvent adds a log record for default.event event.
+func (lb *LogsBuilder) RecordDefaultEventEvent(ts pcommon.Timestamp, body string, stringAttrAttributeValue string, overriddenIntAttrAttributeValue int64, enumAttrAttributeValue AttributeEnumAttr, sliceAttrAttributeValue []any, mapAttrAttributeValue map[string]any) {
+	if !lb.config.Events.DefaultEvent.Enabled {
+		return
+	}
+	lr := lb.logRecordsBuffer.AppendEmpty()
+	lr.SetTimestamp(ts)
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	lr.SetSeverityNumber(plog.SeverityNumberWarn)
+	lr.SetSeverityText("WARN")
+	lr.Body().SetStr(body)
+	lr.Attributes().PutStr("event.name", "default.event")
+	lr.Attributes().PutStr("string_attr", stringAttrAttributeValue)
+	lr.Attributes().PutInt("state", overriddenIntAttrAttributeValue)
+	lr.Attributes().PutStr("enum_attr", enumAttrAttributeValue.String())
+	lr.Attributes().PutEmptySlice("slice_attr").FromRaw(sliceAttrAttributeValue)
+	lr.Attributes().PutEmptyMap("map_attr").FromRaw(mapAttrAttributeValue)
+}
+
+// RecordOptionalEventEvent adds a log record for optional.event event.
+func (lb *LogsBuilder) RecordOptionalEventEvent(ts pcommon.Timestamp, stringAttrAttributeValue string, booleanAttrAttributeValue bool) {
+	if !lb.config.Events.OptionalEvent.Enabled {
+		return
+	}
+	lr := lb.logRecordsBuffer.AppendEmpty()
+	lr.SetTimestamp(ts)
+	lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	lr.SetSeverityText("INFO")
+	lr.Attributes().PutStr("event.name", "optional.event")
+	lr.Attributes().PutStr("string_attr", stringAttrAttributeValue)
+	lr.Attributes().PutBool("boolean_attr", booleanAttrAttributeValue)
+}
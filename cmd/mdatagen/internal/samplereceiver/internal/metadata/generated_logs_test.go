@@ -0,0 +1,120 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func TestLogsBuilder(t *testing.T) {
+	tests := []struct {
+		name        string
+		expectEmpty bool
+	}{
+		{
+			name: "default",
+		},
+		{
+			name: "all_set",
+		},
+		{
+			name:        "none_set",
+			expectEmpty: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := pcommon.Timestamp(1_000_001_000)
+			lb := NewLogsBuilder(loadLogsBuilderConfig(t, tt.name), receivertest.NewNopSettings())
+
+			defaultEventsCount := 0
+			allEventsCount := 0
+
+			defaultEventsCount++
+			allEventsCount++
+			lb.RecordDefaultEventEvent(ts, "default.event-body", "string_attr-val", 19, AttributeEnumAttrRed, []any{"slice_attr-item1", "slice_attr-item2"}, map[string]any{"key1": "map_attr-val1", "key2": "map_attr-val2"})
+
+			allEventsCount++
+			lb.RecordOptionalEventEvent(ts, "string_attr-val", true)
+
+			rb := lb.NewResourceBuilder()
+			rb.SetMapResourceAttr(map[string]any{"key1": "map.resource.attr-val1", "key2": "map.resource.attr-val2"})
+			rb.SetOptionalResourceAttr("optional.resource.attr-val")
+			rb.SetSliceResourceAttr([]any{"slice.resource.attr-item1", "slice.resource.attr-item2"})
+			rb.SetStringEnumResourceAttrOne()
+			rb.SetStringResourceAttr("string.resource.attr-val")
+			rb.SetStringResourceAttrDisableWarning("string.resource.attr_disable_warning-val")
+			rb.SetStringResourceAttrRemoveWarning("string.resource.attr_remove_warning-val")
+			rb.SetStringResourceAttrToBeRemoved("string.resource.attr_to_be_removed-val")
+			res := rb.Emit()
+			logs := lb.Emit(WithLogsResource(res))
+
+			if tt.expectEmpty {
+				assert.Equal(t, 0, logs.ResourceLogs().Len())
+				return
+			}
+
+			assert.Equal(t, 1, logs.ResourceLogs().Len())
+			rl := logs.ResourceLogs().At(0)
+			assert.Equal(t, res, rl.Resource())
+			assert.Equal(t, 1, rl.ScopeLogs().Len())
+			lrs := rl.ScopeLogs().At(0).LogRecords()
+			if tt.name == "default" {
+				assert.Equal(t, defaultEventsCount, lrs.Len())
+			}
+			if tt.name == "all_set" {
+				assert.Equal(t, allEventsCount, lrs.Len())
+			}
+			validatedEvents := make(map[string]bool)
+			for i := 0; i < lrs.Len(); i++ {
+				lr := lrs.At(i)
+				eventName, ok := lr.Attributes().Get("event.name")
+				assert.True(t, ok)
+				switch eventName.Str() {
+				case "default.event":
+					assert.False(t, validatedEvents["default.event"], "Found a duplicate in the log records slice: default.event")
+					validatedEvents["default.event"] = true
+					assert.Equal(t, ts, lr.Timestamp())
+					assert.NotEqual(t, pcommon.Timestamp(0), lr.ObservedTimestamp())
+					assert.Equal(t, plog.SeverityNumberWarn, lr.SeverityNumber())
+					assert.Equal(t, "WARN", lr.SeverityText())
+					assert.Equal(t, "default.event-body", lr.Body().Str())
+					attrVal, ok := lr.Attributes().Get("string_attr")
+					assert.True(t, ok)
+					assert.EqualValues(t, "string_attr-val", attrVal.Str())
+					attrVal, ok = lr.Attributes().Get("state")
+					assert.True(t, ok)
+					assert.EqualValues(t, 19, attrVal.Int())
+					attrVal, ok = lr.Attributes().Get("enum_attr")
+					assert.True(t, ok)
+					assert.EqualValues(t, "red", attrVal.Str())
+					attrVal, ok = lr.Attributes().Get("slice_attr")
+					assert.True(t, ok)
+					assert.EqualValues(t, []any{"slice_attr-item1", "slice_attr-item2"}, attrVal.Slice().AsRaw())
+					attrVal, ok = lr.Attributes().Get("map_attr")
+					assert.True(t, ok)
+					assert.EqualValues(t, map[string]any{"key1": "map_attr-val1", "key2": "map_attr-val2"}, attrVal.Map().AsRaw())
+				case "optional.event":
+					assert.False(t, validatedEvents["optional.event"], "Found a duplicate in the log records slice: optional.event")
+					validatedEvents["optional.event"] = true
+					assert.Equal(t, ts, lr.Timestamp())
+					assert.NotEqual(t, pcommon.Timestamp(0), lr.ObservedTimestamp())
+					assert.Equal(t, plog.SeverityNumberInfo, lr.SeverityNumber())
+					assert.Equal(t, "INFO", lr.SeverityText())
+					attrVal, ok := lr.Attributes().Get("string_attr")
+					assert.True(t, ok)
+					assert.EqualValues(t, "string_attr-val", attrVal.Str())
+					attrVal, ok = lr.Attributes().Get("boolean_attr")
+					assert.True(t, ok)
+					assert.True(t, attrVal.Bool())
+				}
+			}
+		})
+	}
+}
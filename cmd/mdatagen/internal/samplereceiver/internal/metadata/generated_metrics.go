@@ -4,6 +4,8 @@ package metadata
 
 import (
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
 	"time"
 
@@ -153,6 +155,75 @@ func newMetricDefaultMetricToBeRemoved(cfg MetricConfig) metricDefaultMetricToBe
 	return m
 }
 
+type metricHistogramMetric struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills histogram.metric metric with initial data.
+func (m *metricHistogramMetric) init() {
+	m.data.SetName("histogram.metric")
+	m.data.SetDescription("Cumulative histogram double metric with explicit bucket boundaries enabled by default.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyHistogram()
+	m.data.Histogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Histogram().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricHistogramMetric) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, count uint64, sum float64, bucketCounts []uint64, stringAttrAttributeValue string, enumAttrAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.appendDataPoint(start, ts, stringAttrAttributeValue, enumAttrAttributeValue)
+	dp.SetCount(count)
+	dp.SetSum(sum)
+	dp.BucketCounts().FromRaw(bucketCounts)
+}
+
+func (m *metricHistogramMetric) recordObservations(start pcommon.Timestamp, ts pcommon.Timestamp, vals []float64, stringAttrAttributeValue string, enumAttrAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.appendDataPoint(start, ts, stringAttrAttributeValue, enumAttrAttributeValue)
+	recordHistogramObservations(dp, vals)
+}
+
+func (m *metricHistogramMetric) appendDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, stringAttrAttributeValue string, enumAttrAttributeValue string) pmetric.HistogramDataPoint {
+	dp := m.data.Histogram().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.ExplicitBounds().FromRaw([]float64{0.1, 1, 10})
+	dp.Attributes().PutStr("string_attr", stringAttrAttributeValue)
+	dp.Attributes().PutStr("enum_attr", enumAttrAttributeValue)
+	return dp
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricHistogramMetric) updateCapacity() {
+	if m.data.Histogram().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Histogram().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricHistogramMetric) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Histogram().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricHistogramMetric(cfg MetricConfig) metricHistogramMetric {
+	m := metricHistogramMetric{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricMetricInputType struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -210,6 +281,78 @@ func newMetricMetricInputType(cfg MetricConfig) metricMetricInputType {
 	return m
 }
 
+type metricOptionalExponentialHistogramMetric struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills optional.exponential_histogram.metric metric with initial data.
+func (m *metricOptionalExponentialHistogramMetric) init() {
+	m.data.SetName("optional.exponential_histogram.metric")
+	m.data.SetDescription("Delta exponential histogram int metric disabled by default.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyExponentialHistogram()
+	m.data.ExponentialHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	m.data.ExponentialHistogram().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricOptionalExponentialHistogramMetric) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, count uint64, sum float64, scale int32, zeroCount uint64, positive, negative ExponentialHistogramBuckets, stringAttrAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.appendDataPoint(start, ts, stringAttrAttributeValue)
+	dp.SetCount(count)
+	dp.SetSum(sum)
+	dp.SetScale(scale)
+	dp.SetZeroCount(zeroCount)
+	dp.Positive().SetOffset(positive.Offset)
+	dp.Positive().BucketCounts().FromRaw(positive.BucketCounts)
+	dp.Negative().SetOffset(negative.Offset)
+	dp.Negative().BucketCounts().FromRaw(negative.BucketCounts)
+}
+
+func (m *metricOptionalExponentialHistogramMetric) recordObservations(start pcommon.Timestamp, ts pcommon.Timestamp, vals []int64, stringAttrAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.appendDataPoint(start, ts, stringAttrAttributeValue)
+	recordExponentialHistogramObservations(dp, vals, 20, 20)
+}
+
+func (m *metricOptionalExponentialHistogramMetric) appendDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, stringAttrAttributeValue string) pmetric.ExponentialHistogramDataPoint {
+	dp := m.data.ExponentialHistogram().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.Attributes().PutStr("string_attr", stringAttrAttributeValue)
+	return dp
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricOptionalExponentialHistogramMetric) updateCapacity() {
+	if m.data.ExponentialHistogram().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.ExponentialHistogram().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricOptionalExponentialHistogramMetric) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.ExponentialHistogram().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricOptionalExponentialHistogramMetric(cfg MetricConfig) metricOptionalExponentialHistogramMetric {
+	m := metricOptionalExponentialHistogramMetric{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricOptionalMetric struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -318,18 +461,20 @@ func newMetricOptionalMetricEmptyUnit(cfg MetricConfig) metricOptionalMetricEmpt
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                         MetricsBuilderConfig // config of the metrics builder.
-	startTime                      pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity                int                  // maximum observed number of metrics per resource.
-	metricsBuffer                  pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                      component.BuildInfo  // contains version information.
-	resourceAttributeIncludeFilter map[string]filter.Filter
-	resourceAttributeExcludeFilter map[string]filter.Filter
-	metricDefaultMetric            metricDefaultMetric
-	metricDefaultMetricToBeRemoved metricDefaultMetricToBeRemoved
-	metricMetricInputType          metricMetricInputType
-	metricOptionalMetric           metricOptionalMetric
-	metricOptionalMetricEmptyUnit  metricOptionalMetricEmptyUnit
+	config                                   MetricsBuilderConfig // config of the metrics builder.
+	startTime                                pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                          int                  // maximum observed number of metrics per resource.
+	metricsBuffer                            pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                                component.BuildInfo  // contains version information.
+	resourceAttributeIncludeFilter           map[string]filter.Filter
+	resourceAttributeExcludeFilter           map[string]filter.Filter
+	metricDefaultMetric                      metricDefaultMetric
+	metricDefaultMetricToBeRemoved           metricDefaultMetricToBeRemoved
+	metricHistogramMetric                    metricHistogramMetric
+	metricMetricInputType                    metricMetricInputType
+	metricOptionalExponentialHistogramMetric metricOptionalExponentialHistogramMetric
+	metricOptionalMetric                     metricOptionalMetric
+	metricOptionalMetricEmptyUnit            metricOptionalMetricEmptyUnit
 }
 
 // MetricBuilderOption applies changes to default metrics builder.
@@ -373,17 +518,19 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		settings.Logger.Warn("[WARNING] `string.resource.attr_to_be_removed` should not be enabled: This resource_attribute is deprecated and will be removed soon.")
 	}
 	mb := &MetricsBuilder{
-		config:                         mbc,
-		startTime:                      pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                  pmetric.NewMetrics(),
-		buildInfo:                      settings.BuildInfo,
-		metricDefaultMetric:            newMetricDefaultMetric(mbc.Metrics.DefaultMetric),
-		metricDefaultMetricToBeRemoved: newMetricDefaultMetricToBeRemoved(mbc.Metrics.DefaultMetricToBeRemoved),
-		metricMetricInputType:          newMetricMetricInputType(mbc.Metrics.MetricInputType),
-		metricOptionalMetric:           newMetricOptionalMetric(mbc.Metrics.OptionalMetric),
-		metricOptionalMetricEmptyUnit:  newMetricOptionalMetricEmptyUnit(mbc.Metrics.OptionalMetricEmptyUnit),
-		resourceAttributeIncludeFilter: make(map[string]filter.Filter),
-		resourceAttributeExcludeFilter: make(map[string]filter.Filter),
+		config:                                   mbc,
+		startTime:                                pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                            pmetric.NewMetrics(),
+		buildInfo:                                settings.BuildInfo,
+		metricDefaultMetric:                      newMetricDefaultMetric(mbc.Metrics.DefaultMetric),
+		metricDefaultMetricToBeRemoved:           newMetricDefaultMetricToBeRemoved(mbc.Metrics.DefaultMetricToBeRemoved),
+		metricHistogramMetric:                    newMetricHistogramMetric(mbc.Metrics.HistogramMetric),
+		metricMetricInputType:                    newMetricMetricInputType(mbc.Metrics.MetricInputType),
+		metricOptionalExponentialHistogramMetric: newMetricOptionalExponentialHistogramMetric(mbc.Metrics.OptionalExponentialHistogramMetric),
+		metricOptionalMetric:                     newMetricOptionalMetric(mbc.Metrics.OptionalMetric),
+		metricOptionalMetricEmptyUnit:            newMetricOptionalMetricEmptyUnit(mbc.Metrics.OptionalMetricEmptyUnit),
+		resourceAttributeIncludeFilter:           make(map[string]filter.Filter),
+		resourceAttributeExcludeFilter:           make(map[string]filter.Filter),
 	}
 	if mbc.ResourceAttributes.MapResourceAttr.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["map.resource.attr"] = filter.CreateFilter(mbc.ResourceAttributes.MapResourceAttr.MetricsInclude)
@@ -483,6 +630,18 @@ func WithStartTimeOverride(start pcommon.Timestamp) ResourceMetricsOption {
 				dps = metrics.At(i).Gauge().DataPoints()
 			case pmetric.MetricTypeSum:
 				dps = metrics.At(i).Sum().DataPoints()
+			case pmetric.MetricTypeHistogram:
+				hdps := metrics.At(i).Histogram().DataPoints()
+				for j := 0; j < hdps.Len(); j++ {
+					hdps.At(j).SetStartTimestamp(start)
+				}
+				continue
+			case pmetric.MetricTypeExponentialHistogram:
+				edps := metrics.At(i).ExponentialHistogram().DataPoints()
+				for j := 0; j < edps.Len(); j++ {
+					edps.At(j).SetStartTimestamp(start)
+				}
+				continue
 			}
 			for j := 0; j < dps.Len(); j++ {
 				dps.At(j).SetStartTimestamp(start)
@@ -505,7 +664,9 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
 	mb.metricDefaultMetric.emit(ils.Metrics())
 	mb.metricDefaultMetricToBeRemoved.emit(ils.Metrics())
+	mb.metricHistogramMetric.emit(ils.Metrics())
 	mb.metricMetricInputType.emit(ils.Metrics())
+	mb.metricOptionalExponentialHistogramMetric.emit(ils.Metrics())
 	mb.metricOptionalMetric.emit(ils.Metrics())
 	mb.metricOptionalMetricEmptyUnit.emit(ils.Metrics())
 
@@ -549,6 +710,21 @@ func (mb *MetricsBuilder) RecordDefaultMetricToBeRemovedDataPoint(ts pcommon.Tim
 	mb.metricDefaultMetricToBeRemoved.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordHistogramMetricDataPoint adds a data point with the given count, sum and bucket counts to histogram.metric metric.
+// bucketCounts must contain one count more than the number of bucket boundaries of the metric.
+func (mb *MetricsBuilder) RecordHistogramMetricDataPoint(ts pcommon.Timestamp, count uint64, sum float64, bucketCounts []uint64, stringAttrAttributeValue string, enumAttrAttributeValue AttributeEnumAttr) error {
+	if len(bucketCounts) != 4 {
+		return fmt.Errorf("failed to record HistogramMetric, expected 4 bucket counts, got %d", len(bucketCounts))
+	}
+	mb.metricHistogramMetric.recordDataPoint(mb.startTime, ts, count, sum, bucketCounts, stringAttrAttributeValue, enumAttrAttributeValue.String())
+	return nil
+}
+
+// RecordHistogramMetricDataPointFromObservations adds a data point aggregating the given observations to histogram.metric metric.
+func (mb *MetricsBuilder) RecordHistogramMetricDataPointFromObservations(ts pcommon.Timestamp, vals []float64, stringAttrAttributeValue string, enumAttrAttributeValue AttributeEnumAttr) {
+	mb.metricHistogramMetric.recordObservations(mb.startTime, ts, vals, stringAttrAttributeValue, enumAttrAttributeValue.String())
+}
+
 // RecordMetricInputTypeDataPoint adds a data point to metric.input_type metric.
 func (mb *MetricsBuilder) RecordMetricInputTypeDataPoint(ts pcommon.Timestamp, inputVal string, stringAttrAttributeValue string, overriddenIntAttrAttributeValue int64, enumAttrAttributeValue AttributeEnumAttr, sliceAttrAttributeValue []any, mapAttrAttributeValue map[string]any) error {
 	val, err := strconv.ParseInt(inputVal, 10, 64)
@@ -559,6 +735,18 @@ func (mb *MetricsBuilder) RecordMetricInputTypeDataPoint(ts pcommon.Timestamp, i
 	return nil
 }
 
+// RecordOptionalExponentialHistogramMetricDataPoint adds a data point with the given count, sum, scale and buckets to optional.exponential_histogram.metric metric.
+func (mb *MetricsBuilder) RecordOptionalExponentialHistogramMetricDataPoint(ts pcommon.Timestamp, count uint64, sum float64, scale int32, zeroCount uint64, positive, negative ExponentialHistogramBuckets, stringAttrAttributeValue string) {
+	mb.metricOptionalExponentialHistogramMetric.recordDataPoint(mb.startTime, ts, count, sum, scale, zeroCount, positive, negative, stringAttrAttributeValue)
+}
+
+// RecordOptionalExponentialHistogramMetricDataPointFromObservations adds a data point aggregating the given observations to optional.exponential_histogram.metric metric.
+// The data point uses the highest scale, up to 20, at which the observations fit in 20 buckets.
+// Non-finite observations are ignored.
+func (mb *MetricsBuilder) RecordOptionalExponentialHistogramMetricDataPointFromObservations(ts pcommon.Timestamp, vals []int64, stringAttrAttributeValue string) {
+	mb.metricOptionalExponentialHistogramMetric.recordObservations(mb.startTime, ts, vals, stringAttrAttributeValue)
+}
+
 // RecordOptionalMetricDataPoint adds a data point to optional.metric metric.
 func (mb *MetricsBuilder) RecordOptionalMetricDataPoint(ts pcommon.Timestamp, val float64, stringAttrAttributeValue string, booleanAttrAttributeValue bool, booleanAttr2AttributeValue bool) {
 	mb.metricOptionalMetric.recordDataPoint(mb.startTime, ts, val, stringAttrAttributeValue, booleanAttrAttributeValue, booleanAttr2AttributeValue)
@@ -577,3 +765,115 @@ func (mb *MetricsBuilder) Reset(options ...MetricBuilderOption) {
 		op.apply(mb)
 	}
 }
+
+// recordHistogramObservations sets the count, sum, min, max and bucket counts of dp from the given observations.
+// The explicit bounds of dp must already be set.
+func recordHistogramObservations[T int64 | float64](dp pmetric.HistogramDataPoint, vals []T) {
+	bounds := dp.ExplicitBounds().AsRaw()
+	bucketCounts := make([]uint64, len(bounds)+1)
+	var sum float64
+	for i, val := range vals {
+		v := float64(val)
+		// Buckets are upper-inclusive: bucket i contains values in (bounds[i-1], bounds[i]].
+		bucketCounts[sort.SearchFloat64s(bounds, v)]++
+		sum += v
+		if i == 0 || v < dp.Min() {
+			dp.SetMin(v)
+		}
+		if i == 0 || v > dp.Max() {
+			dp.SetMax(v)
+		}
+	}
+	dp.SetCount(uint64(len(vals)))
+	dp.SetSum(sum)
+	dp.BucketCounts().FromRaw(bucketCounts)
+}
+
+// ExponentialHistogramBuckets is a range of consecutive buckets of an exponential histogram data point.
+type ExponentialHistogramBuckets struct {
+	// Offset is the index of the first bucket.
+	Offset int32
+	// BucketCounts are the counts of the buckets, starting with the bucket at Offset.
+	BucketCounts []uint64
+}
+
+// minExponentialHistogramScale is the lowest scale of the exponential histograms recorded from observations,
+// the lowest one supported by the OpenTelemetry SDKs. All the float64 values fit in 3 buckets at this scale.
+const minExponentialHistogramScale = -10
+
+// recordExponentialHistogramObservations sets the count, sum, min, max, scale and buckets of dp from the given
+// observations. The scale is the highest one, up to maxScale, at which both the positive and the negative
+// observations fit in maxSize buckets, but not lower than minExponentialHistogramScale. Non-finite observations
+// cannot be mapped to a bucket and are ignored.
+func recordExponentialHistogramObservations[T int64 | float64](dp pmetric.ExponentialHistogramDataPoint, vals []T, maxSize int32, maxScale int32) {
+	var positive, negative []int64
+	var count, zeroCount uint64
+	var sum float64
+	for _, val := range vals {
+		v := float64(val)
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			continue
+		}
+		if count == 0 || v < dp.Min() {
+			dp.SetMin(v)
+		}
+		if count == 0 || v > dp.Max() {
+			dp.SetMax(v)
+		}
+		count++
+		sum += v
+		switch {
+		case v > 0:
+			positive = append(positive, exponentialHistogramIndex(v, maxScale))
+		case v < 0:
+			negative = append(negative, exponentialHistogramIndex(-v, maxScale))
+		default:
+			zeroCount++
+		}
+	}
+	posLow, posHigh := exponentialHistogramIndexRange(positive)
+	negLow, negHigh := exponentialHistogramIndexRange(negative)
+	// Lowering the scale by one merges pairs of adjacent buckets, which halves the indexes.
+	shift := 0
+	for maxScale-int32(shift) > minExponentialHistogramScale &&
+		((posHigh>>shift)-(posLow>>shift) >= int64(maxSize) || (negHigh>>shift)-(negLow>>shift) >= int64(maxSize)) {
+		shift++
+	}
+	dp.SetCount(count)
+	dp.SetSum(sum)
+	dp.SetZeroCount(zeroCount)
+	dp.SetScale(maxScale - int32(shift))
+	fillExponentialHistogramBuckets(dp.Positive(), positive, posLow>>shift, posHigh>>shift, shift)
+	fillExponentialHistogramBuckets(dp.Negative(), negative, negLow>>shift, negHigh>>shift, shift)
+}
+
+// exponentialHistogramIndex returns the index of the bucket containing the positive value v at the given scale.
+// Buckets are upper-inclusive: bucket i contains values in (base^i, base^(i+1)], where base = 2^(2^-scale).
+func exponentialHistogramIndex(v float64, scale int32) int64 {
+	return int64(math.Ceil(math.Ldexp(math.Log2(v), int(scale)))) - 1
+}
+
+// exponentialHistogramIndexRange returns the lowest and the highest of the given bucket indexes.
+func exponentialHistogramIndexRange(indexes []int64) (int64, int64) {
+	if len(indexes) == 0 {
+		return 0, 0
+	}
+	low, high := indexes[0], indexes[0]
+	for _, idx := range indexes[1:] {
+		low = min(low, idx)
+		high = max(high, idx)
+	}
+	return low, high
+}
+
+func fillExponentialHistogramBuckets(buckets pmetric.ExponentialHistogramDataPointBuckets, indexes []int64, low int64, high int64, shift int) {
+	if len(indexes) == 0 {
+		return
+	}
+	bucketCounts := make([]uint64, high-low+1)
+	for _, idx := range indexes {
+		bucketCounts[(idx>>shift)-low]++
+	}
+	buckets.SetOffset(int32(low))
+	buckets.BucketCounts().FromRaw(bucketCounts)
+}
@@ -105,10 +105,18 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordDefaultMetricToBeRemovedDataPoint(ts, 1)
 
+			defaultMetricsCount++
+			allMetricsCount++
+			assert.Error(t, mb.RecordHistogramMetricDataPoint(ts, 1, 1, nil, "string_attr-val", AttributeEnumAttrRed))
+			mb.RecordHistogramMetricDataPointFromObservations(ts, []float64{1}, "string_attr-val", AttributeEnumAttrRed)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordMetricInputTypeDataPoint(ts, "1", "string_attr-val", 19, AttributeEnumAttrRed, []any{"slice_attr-item1", "slice_attr-item2"}, map[string]any{"key1": "map_attr-val1", "key2": "map_attr-val2"})
 
+			allMetricsCount++
+			mb.RecordOptionalExponentialHistogramMetricDataPointFromObservations(ts, []int64{1}, "string_attr-val")
+
 			allMetricsCount++
 			mb.RecordOptionalMetricDataPoint(ts, 1, "string_attr-val", true, false)
 
@@ -189,6 +197,29 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
 					assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+				case "histogram.metric":
+					assert.False(t, validatedMetrics["histogram.metric"], "Found a duplicate in the metrics slice: histogram.metric")
+					validatedMetrics["histogram.metric"] = true
+					assert.Equal(t, pmetric.MetricTypeHistogram, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Histogram().DataPoints().Len())
+					assert.Equal(t, "Cumulative histogram double metric with explicit bucket boundaries enabled by default.", ms.At(i).Description())
+					assert.Equal(t, "s", ms.At(i).Unit())
+					assert.Equal(t, pmetric.AggregationTemporalityCumulative, ms.At(i).Histogram().AggregationTemporality())
+					dp := ms.At(i).Histogram().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, uint64(1), dp.Count())
+					assert.InDelta(t, float64(1), dp.Sum(), 0.01)
+					assert.InDelta(t, float64(1), dp.Min(), 0.01)
+					assert.InDelta(t, float64(1), dp.Max(), 0.01)
+					assert.Equal(t, []float64{0.1, 1, 10}, dp.ExplicitBounds().AsRaw())
+					assert.Equal(t, 4, dp.BucketCounts().Len())
+					attrVal, ok := dp.Attributes().Get("string_attr")
+					assert.True(t, ok)
+					assert.EqualValues(t, "string_attr-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("enum_attr")
+					assert.True(t, ok)
+					assert.EqualValues(t, "red", attrVal.Str())
 				case "metric.input_type":
 					assert.False(t, validatedMetrics["metric.input_type"], "Found a duplicate in the metrics slice: metric.input_type")
 					validatedMetrics["metric.input_type"] = true
@@ -218,6 +249,28 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok = dp.Attributes().Get("map_attr")
 					assert.True(t, ok)
 					assert.EqualValues(t, map[string]any{"key1": "map_attr-val1", "key2": "map_attr-val2"}, attrVal.Map().AsRaw())
+				case "optional.exponential_histogram.metric":
+					assert.False(t, validatedMetrics["optional.exponential_histogram.metric"], "Found a duplicate in the metrics slice: optional.exponential_histogram.metric")
+					validatedMetrics["optional.exponential_histogram.metric"] = true
+					assert.Equal(t, pmetric.MetricTypeExponentialHistogram, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).ExponentialHistogram().DataPoints().Len())
+					assert.Equal(t, "Delta exponential histogram int metric disabled by default.", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					assert.Equal(t, pmetric.AggregationTemporalityDelta, ms.At(i).ExponentialHistogram().AggregationTemporality())
+					dp := ms.At(i).ExponentialHistogram().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, uint64(1), dp.Count())
+					assert.InDelta(t, float64(1), dp.Sum(), 0.01)
+					assert.InDelta(t, float64(1), dp.Min(), 0.01)
+					assert.InDelta(t, float64(1), dp.Max(), 0.01)
+					assert.Equal(t, int32(20), dp.Scale())
+					assert.Equal(t, int32(-1), dp.Positive().Offset())
+					assert.Equal(t, []uint64{1}, dp.Positive().BucketCounts().AsRaw())
+					assert.Equal(t, 0, dp.Negative().BucketCounts().Len())
+					attrVal, ok := dp.Attributes().Get("string_attr")
+					assert.True(t, ok)
+					assert.EqualValues(t, "string_attr-val", attrVal.Str())
 				case "optional.metric":
 					assert.False(t, validatedMetrics["optional.metric"], "Found a duplicate in the metrics slice: optional.metric")
 					validatedMetrics["optional.metric"] = true
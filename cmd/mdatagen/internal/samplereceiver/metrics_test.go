@@ -7,12 +7,14 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 
 	"go.opentelemetry.io/collector/cmd/mdatagen/internal/samplereceiver/internal/metadata"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 )
 
@@ -23,6 +25,51 @@ func TestGeneratedMetrics(t *testing.T) {
 	require.Equal(t, 0, m.ResourceMetrics().Len())
 }
 
+func TestGeneratedHistogramMetrics(t *testing.T) {
+	cfg := metadata.DefaultMetricsBuilderConfig()
+	cfg.Metrics.OptionalExponentialHistogramMetric.Enabled = true
+	mb := metadata.NewMetricsBuilder(cfg, receivertest.NewNopSettings())
+	ts := pcommon.Timestamp(1_000_001_000)
+
+	require.NoError(t, mb.RecordHistogramMetricDataPoint(ts, 3, 12.5, []uint64{0, 1, 1, 1}, "a", metadata.AttributeEnumAttrRed))
+	mb.RecordHistogramMetricDataPointFromObservations(ts, []float64{0.1, 0.5, 1, 20}, "b", metadata.AttributeEnumAttrBlue)
+	mb.RecordOptionalExponentialHistogramMetricDataPoint(ts, 2, 3, 0, 0,
+		metadata.ExponentialHistogramBuckets{Offset: -1, BucketCounts: []uint64{1, 1}}, metadata.ExponentialHistogramBuckets{}, "a")
+	// 1 and 1000 are too far apart to fit in 20 buckets at the highest scale, so the scale is lowered.
+	mb.RecordOptionalExponentialHistogramMetricDataPointFromObservations(ts, []int64{-4, 0, 1, 1000}, "b")
+
+	ms := mb.Emit().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, ms.Len())
+
+	hdps := ms.At(0).Histogram().DataPoints()
+	require.Equal(t, 2, hdps.Len())
+	assert.Equal(t, []uint64{0, 1, 1, 1}, hdps.At(0).BucketCounts().AsRaw())
+	assert.Equal(t, []float64{0.1, 1, 10}, hdps.At(1).ExplicitBounds().AsRaw())
+	assert.Equal(t, []uint64{1, 2, 0, 1}, hdps.At(1).BucketCounts().AsRaw())
+	assert.Equal(t, uint64(4), hdps.At(1).Count())
+	assert.InDelta(t, 21.6, hdps.At(1).Sum(), 1e-9)
+	assert.InDelta(t, 0.1, hdps.At(1).Min(), 1e-9)
+	assert.InDelta(t, 20, hdps.At(1).Max(), 1e-9)
+
+	edps := ms.At(1).ExponentialHistogram().DataPoints()
+	require.Equal(t, 2, edps.Len())
+	assert.Equal(t, int32(0), edps.At(0).Scale())
+	assert.Equal(t, []uint64{1, 1}, edps.At(0).Positive().BucketCounts().AsRaw())
+	dp := edps.At(1)
+	assert.Equal(t, uint64(4), dp.Count())
+	assert.Equal(t, uint64(1), dp.ZeroCount())
+	assert.InDelta(t, 997, dp.Sum(), 1e-9)
+	assert.InDelta(t, -4, dp.Min(), 1e-9)
+	assert.InDelta(t, 1000, dp.Max(), 1e-9)
+	// At scale 1 the buckets of 1 and 1000 are -1 and 19, so scale 0 is the highest one fitting in 20 buckets.
+	assert.Equal(t, int32(0), dp.Scale())
+	assert.Equal(t, int32(-1), dp.Positive().Offset())
+	assert.Equal(t, []uint64{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}, dp.Positive().BucketCounts().AsRaw())
+	// 4 is the upper boundary of bucket 1 at scale 0.
+	assert.Equal(t, int32(1), dp.Negative().Offset())
+	assert.Equal(t, []uint64{1}, dp.Negative().BucketCounts().AsRaw())
+}
+
 func TestComponentTelemetry(t *testing.T) {
 	tt := setupTestTelemetry()
 	factory := NewFactory()
@@ -27,6 +27,7 @@ import (
 	"go.opentelemetry.io/collector/config/configauth"
 	"go.opentelemetry.io/collector/config/configcompression"
 	"go.opentelemetry.io/collector/config/confighttp/internal"
+	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtelemetry"
 	"go.opentelemetry.io/collector/config/configtls"
@@ -279,8 +280,21 @@ func (interceptor *headerRoundTripper) RoundTrip(req *http.Request) (*http.Respo
 // ServerConfig defines settings for creating an HTTP server.
 type ServerConfig struct {
 	// Endpoint configures the listening address for the server.
+	// For the "unix" transport, it is the path of the socket, or on Linux, the name of
+	// a socket in the abstract namespace when it starts with "@".
 	Endpoint string `mapstructure:"endpoint"`
 
+	// Transport to listen on. Allowed values are "tcp", "tcp4" (IPv4-only), "tcp6" (IPv6-only)
+	// and "unix". Default: "tcp".
+	Transport confignet.TransportType `mapstructure:"transport"`
+
+	// UnixSocket configures the socket file created for the "unix" transport.
+	UnixSocket UnixSocketConfig `mapstructure:"unix_socket"`
+
+	// SocketActivation makes the server listen on a socket passed by systemd, instead of
+	// the one configured by Endpoint and Transport.
+	SocketActivation *SocketActivationConfig `mapstructure:"socket_activation"`
+
 	// TLSSetting struct exposes TLS client configuration.
 	TLSSetting *configtls.ServerConfig `mapstructure:"tls"`
 
@@ -358,9 +372,32 @@ type AuthConfig struct {
 	RequestParameters []string `mapstructure:"request_params"`
 }
 
+// Validate checks that the listening configuration of the server is valid.
+func (hss *ServerConfig) Validate() error {
+	switch hss.Transport {
+	case "", confignet.TransportTypeTCP, confignet.TransportTypeTCP4, confignet.TransportTypeTCP6:
+		if hss.UnixSocket != (UnixSocketConfig{}) {
+			return fmt.Errorf("'unix_socket' can only be set for the %q transport", confignet.TransportTypeUnix)
+		}
+	case confignet.TransportTypeUnix:
+		if hss.SocketActivation == nil && hss.Endpoint == "" {
+			return errors.New("'endpoint' must be set to the path of the socket for the \"unix\" transport")
+		}
+		if hss.SocketActivation == nil && isAbstractSocket(hss.Endpoint) && hss.UnixSocket != (UnixSocketConfig{}) {
+			return errors.New("'unix_socket' cannot be set for an abstract unix socket, which has no file")
+		}
+		if err := hss.UnixSocket.validate(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported transport %q, must be one of \"tcp\", \"tcp4\", \"tcp6\" or \"unix\"", hss.Transport)
+	}
+	return nil
+}
+
 // ToListener creates a net.Listener.
 func (hss *ServerConfig) ToListener(ctx context.Context) (net.Listener, error) {
-	listener, err := net.Listen("tcp", hss.Endpoint)
+	listener, err := hss.listen(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -407,7 +444,9 @@ func WithDecoder(key string, dec func(body io.ReadCloser) (io.ReadCloser, error)
 
 // ToServer creates an http.Server from settings object.
 func (hss *ServerConfig) ToServer(_ context.Context, host component.Host, settings component.TelemetrySettings, handler http.Handler, opts ...ToServerOption) (*http.Server, error) {
-	configinternal.WarnOnUnspecifiedHost(settings.Logger, hss.Endpoint)
+	if hss.SocketActivation == nil && hss.Transport != confignet.TransportTypeUnix {
+		configinternal.WarnOnUnspecifiedHost(settings.Logger, hss.Endpoint)
+	}
 
 	serverOpts := &toServerOptions{}
 	serverOpts.Apply(opts...)
@@ -14,6 +14,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"path/filepath"
+	"runtime"
 	"strconv"
 	"strings"
 	"testing"
@@ -29,6 +30,7 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/configauth"
 	"go.opentelemetry.io/collector/config/configcompression"
+	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtelemetry"
 	"go.opentelemetry.io/collector/config/configtls"
@@ -516,6 +518,104 @@ func TestHTTPServerSettingsError(t *testing.T) {
 	}
 }
 
+func TestServerValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings ServerConfig
+		err      string
+	}{
+		{
+			name:     "default",
+			settings: NewDefaultServerConfig(),
+		},
+		{
+			name: "tcp4",
+			settings: ServerConfig{
+				Endpoint:  "127.0.0.1:0",
+				Transport: confignet.TransportTypeTCP4,
+			},
+		},
+		{
+			name: "unix",
+			settings: ServerConfig{
+				Endpoint:   "/run/otelcol/otlp.sock",
+				Transport:  confignet.TransportTypeUnix,
+				UnixSocket: UnixSocketConfig{Mode: "0660", User: "otelcol", Group: "otelcol"},
+			},
+		},
+		{
+			name: "socket_activation",
+			settings: ServerConfig{
+				Transport:        confignet.TransportTypeUnix,
+				SocketActivation: &SocketActivationConfig{Name: "otlp-http"},
+			},
+		},
+		{
+			name: "unsupported_transport",
+			settings: ServerConfig{
+				Endpoint:  "localhost:0",
+				Transport: confignet.TransportTypeUDP,
+			},
+			err: `unsupported transport "udp", must be one of "tcp", "tcp4", "tcp6" or "unix"`,
+		},
+		{
+			name: "unix_socket_with_tcp",
+			settings: ServerConfig{
+				Endpoint:   "localhost:0",
+				UnixSocket: UnixSocketConfig{Mode: "0660"},
+			},
+			err: `'unix_socket' can only be set for the "unix" transport`,
+		},
+		{
+			name: "unix_without_endpoint",
+			settings: ServerConfig{
+				Transport: confignet.TransportTypeUnix,
+			},
+			err: `'endpoint' must be set to the path of the socket for the "unix" transport`,
+		},
+		{
+			name: "invalid_mode",
+			settings: ServerConfig{
+				Endpoint:   "/run/otelcol/otlp.sock",
+				Transport:  confignet.TransportTypeUnix,
+				UnixSocket: UnixSocketConfig{Mode: "0999"},
+			},
+			err: `invalid unix socket mode "0999", must be an octal number between 0 and 0777`,
+		},
+		{
+			name: "mode_too_large",
+			settings: ServerConfig{
+				Endpoint:   "/run/otelcol/otlp.sock",
+				Transport:  confignet.TransportTypeUnix,
+				UnixSocket: UnixSocketConfig{Mode: "4755"},
+			},
+			err: `invalid unix socket mode "4755", must be an octal number between 0 and 0777`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestServerValidateAbstractSocket(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("abstract unix sockets are only supported on Linux")
+	}
+	settings := ServerConfig{
+		Endpoint:   "@otelcol",
+		Transport:  confignet.TransportTypeUnix,
+		UnixSocket: UnixSocketConfig{Mode: "0660"},
+	}
+	assert.EqualError(t, settings.Validate(), "'unix_socket' cannot be set for an abstract unix socket, which has no file")
+}
+
 func TestHTTPServerWarning(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -534,6 +634,13 @@ func TestHTTPServerWarning(t *testing.T) {
 			},
 			len: 0,
 		},
+		{
+			settings: ServerConfig{
+				Endpoint:         "0.0.0.0:0",
+				SocketActivation: &SocketActivationConfig{},
+			},
+			len: 0,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
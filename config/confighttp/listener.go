@@ -0,0 +1,261 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package confighttp // import "go.opentelemetry.io/collector/config/confighttp"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"os/user"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/collector/config/confignet"
+)
+
+// UnixSocketConfig configures the socket file created by a server listening on a Unix domain socket.
+type UnixSocketConfig struct {
+	// Mode is the file mode of the socket, as an octal number, e.g. "0660".
+	// By default, the mode results from the umask of the process.
+	Mode string `mapstructure:"mode"`
+
+	// User is the name or ID of the user owning the socket. Default: the user of the process.
+	User string `mapstructure:"user"`
+
+	// Group is the name or ID of the group owning the socket. Default: the group of the process.
+	Group string `mapstructure:"group"`
+}
+
+// SocketActivationConfig configures a server to listen on a socket passed by systemd,
+// following the sd_listen_fds(3) protocol, instead of opening one.
+type SocketActivationConfig struct {
+	// Name is the name of the socket, as set by FileDescriptorName= in its systemd socket unit.
+	// When empty, the process must have been passed exactly one socket.
+	Name string `mapstructure:"name"`
+}
+
+// isAbstractSocket returns whether the endpoint of a Unix domain socket is in the Linux abstract
+// namespace, which has no file in the file system.
+func isAbstractSocket(endpoint string) bool {
+	return runtime.GOOS == "linux" && strings.HasPrefix(endpoint, "@")
+}
+
+func (us *UnixSocketConfig) validate() error {
+	if us.Mode != "" {
+		if _, err := parseFileMode(us.Mode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseFileMode(mode string) (fs.FileMode, error) {
+	m, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil || m > uint64(fs.ModePerm) {
+		return 0, fmt.Errorf("invalid unix socket mode %q, must be an octal number between 0 and 0777", mode)
+	}
+	return fs.FileMode(m), nil
+}
+
+// listen creates the listener of the server, without TLS.
+func (hss *ServerConfig) listen(ctx context.Context) (net.Listener, error) {
+	if hss.SocketActivation != nil {
+		return activatedListener(hss.SocketActivation.Name)
+	}
+	if hss.Transport != confignet.TransportTypeUnix {
+		transport := hss.Transport
+		if transport == "" {
+			transport = confignet.TransportTypeTCP
+		}
+		lc := net.ListenConfig{}
+		return lc.Listen(ctx, string(transport), hss.Endpoint)
+	}
+	if isAbstractSocket(hss.Endpoint) {
+		lc := net.ListenConfig{}
+		return lc.Listen(ctx, string(confignet.TransportTypeUnix), hss.Endpoint)
+	}
+	return listenUnixSocket(ctx, hss.Endpoint, hss.UnixSocket)
+}
+
+// listenUnixSocket listens on the Unix domain socket at path, and sets the mode and ownership
+// of its file. The file is removed when the listener is closed.
+func listenUnixSocket(ctx context.Context, path string, cfg UnixSocketConfig) (net.Listener, error) {
+	if err := removeStaleSocket(ctx, path); err != nil {
+		return nil, err
+	}
+	lc := net.ListenConfig{}
+	listener, err := lc.Listen(ctx, string(confignet.TransportTypeUnix), path)
+	if err != nil {
+		return nil, err
+	}
+	if err = setSocketFileOptions(path, cfg); err != nil {
+		return nil, errors.Join(err, listener.Close())
+	}
+	return listener, nil
+}
+
+// removeStaleSocket removes the socket file left at path by a process which did not close its
+// listener, e.g. because it crashed. Sockets still accepting connections are left untouched.
+func removeStaleSocket(ctx context.Context, path string) error {
+	info, err := os.Lstat(path)
+	if err != nil || info.Mode().Type() != fs.ModeSocket {
+		// Listening fails with a clear error if the path exists and is not a socket.
+		return nil
+	}
+	d := net.Dialer{}
+	conn, err := d.DialContext(ctx, string(confignet.TransportTypeUnix), path)
+	if err == nil {
+		_ = conn.Close()
+		return fmt.Errorf("unix socket %q is already in use", path)
+	}
+	if err = os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func setSocketFileOptions(path string, cfg UnixSocketConfig) error {
+	if cfg.Mode != "" {
+		mode, err := parseFileMode(cfg.Mode)
+		if err != nil {
+			return err
+		}
+		if err = os.Chmod(path, mode); err != nil {
+			return err
+		}
+	}
+	if cfg.User == "" && cfg.Group == "" {
+		return nil
+	}
+	uid, gid := -1, -1
+	if cfg.User != "" {
+		u, err := lookupUser(cfg.User)
+		if err != nil {
+			return err
+		}
+		if uid, err = strconv.Atoi(u.Uid); err != nil {
+			return fmt.Errorf("user %q has a non numeric ID %q", cfg.User, u.Uid)
+		}
+	}
+	if cfg.Group != "" {
+		g, err := lookupGroup(cfg.Group)
+		if err != nil {
+			return err
+		}
+		if gid, err = strconv.Atoi(g.Gid); err != nil {
+			return fmt.Errorf("group %q has a non numeric ID %q", cfg.Group, g.Gid)
+		}
+	}
+	return os.Lchown(path, uid, gid)
+}
+
+func lookupUser(nameOrID string) (*user.User, error) {
+	if u, err := user.Lookup(nameOrID); err == nil {
+		return u, nil
+	}
+	return user.LookupId(nameOrID)
+}
+
+func lookupGroup(nameOrID string) (*user.Group, error) {
+	if g, err := user.LookupGroup(nameOrID); err == nil {
+		return g, nil
+	}
+	return user.LookupGroupId(nameOrID)
+}
+
+// listenFdsStart is the first file descriptor passed by systemd, see sd_listen_fds(3).
+const listenFdsStart = 3
+
+// listenFd is a socket passed by systemd.
+type listenFd struct {
+	fd   int
+	name string
+}
+
+var (
+	activatedFdsOnce sync.Once
+	activatedFds     []listenFd
+	errActivatedFds  error
+
+	activatedFilesMu sync.Mutex
+	// activatedFiles are the files of the sockets listened on, by file descriptor.
+	activatedFiles = map[int]*os.File{}
+)
+
+// activatedListener returns a listener for the socket with the given name passed by systemd.
+// The passed sockets are kept open for the lifetime of the process, so that servers can
+// listen on them again after being restarted.
+func activatedListener(name string) (net.Listener, error) {
+	activatedFdsOnce.Do(func() {
+		activatedFds, errActivatedFds = listenFds(os.Getenv, os.Getpid())
+	})
+	if errActivatedFds != nil {
+		return nil, errActivatedFds
+	}
+	lfd, err := selectListenFd(activatedFds, name)
+	if err != nil {
+		return nil, err
+	}
+
+	activatedFilesMu.Lock()
+	defer activatedFilesMu.Unlock()
+	f, ok := activatedFiles[lfd.fd]
+	if !ok {
+		f = os.NewFile(uintptr(lfd.fd), lfd.name) //nolint:gosec // file descriptors are positive
+		activatedFiles[lfd.fd] = f
+	}
+	// The listener uses a duplicate of the file descriptor, and f is left open.
+	return net.FileListener(f)
+}
+
+// listenFds returns the sockets passed by systemd, named after LISTEN_FDNAMES.
+func listenFds(getenv func(string) string, pid int) ([]listenFd, error) {
+	if getenv("LISTEN_PID") == "" || getenv("LISTEN_FDS") == "" {
+		return nil, errors.New("no socket passed by systemd, LISTEN_PID and LISTEN_FDS are not set")
+	}
+	listenPid, err := strconv.Atoi(getenv("LISTEN_PID"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid LISTEN_PID %q", getenv("LISTEN_PID"))
+	}
+	if listenPid != pid {
+		return nil, fmt.Errorf("the sockets passed by systemd are meant for process %d", listenPid)
+	}
+	count, err := strconv.Atoi(getenv("LISTEN_FDS"))
+	if err != nil || count < 1 {
+		return nil, fmt.Errorf("invalid LISTEN_FDS %q", getenv("LISTEN_FDS"))
+	}
+	var names []string
+	if fdNames := getenv("LISTEN_FDNAMES"); fdNames != "" {
+		names = strings.Split(fdNames, ":")
+	}
+	fds := make([]listenFd, count)
+	for i := range fds {
+		fds[i] = listenFd{fd: listenFdsStart + i, name: "LISTEN_FD_" + strconv.Itoa(listenFdsStart+i)}
+		if i < len(names) {
+			fds[i].name = names[i]
+		}
+	}
+	return fds, nil
+}
+
+// selectListenFd returns the socket with the given name, or the only socket if name is empty.
+func selectListenFd(fds []listenFd, name string) (listenFd, error) {
+	if name == "" {
+		if len(fds) != 1 {
+			return listenFd{}, fmt.Errorf("systemd passed %d sockets, the name of the socket to listen on must be set", len(fds))
+		}
+		return fds[0], nil
+	}
+	for _, lfd := range fds {
+		if lfd.name == name {
+			return lfd, nil
+		}
+	}
+	return listenFd{}, fmt.Errorf("systemd did not pass a socket named %q", name)
+}
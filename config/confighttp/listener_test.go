@@ -0,0 +1,221 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package confighttp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confignet"
+)
+
+func TestServerUnixSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "otlp.sock")
+	hss := &ServerConfig{
+		Endpoint:  path,
+		Transport: confignet.TransportTypeUnix,
+	}
+	if runtime.GOOS != "windows" {
+		hss.UnixSocket = UnixSocketConfig{
+			Mode:  "0620",
+			Group: strconv.Itoa(os.Getgid()),
+		}
+	}
+	require.NoError(t, hss.Validate())
+
+	ln, err := hss.ToListener(context.Background())
+	require.NoError(t, err)
+	if runtime.GOOS != "windows" {
+		info, errStat := os.Stat(path)
+		require.NoError(t, errStat)
+		assert.Equal(t, fs.FileMode(0o620), info.Mode().Perm())
+	}
+
+	assert.Equal(t, "unix", get(t, hss, ln))
+
+	_, err = os.Stat(path)
+	assert.ErrorIs(t, err, fs.ErrNotExist, "the socket file is removed when the server is closed")
+}
+
+func TestServerAbstractUnixSocket(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("abstract unix sockets are only supported on Linux")
+	}
+	hss := &ServerConfig{
+		Endpoint:  fmt.Sprintf("@otelcol-test-%d", os.Getpid()),
+		Transport: confignet.TransportTypeUnix,
+	}
+	require.NoError(t, hss.Validate())
+	ln, err := hss.ToListener(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "unix", get(t, hss, ln))
+}
+
+func TestServerUnixSocketStale(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "otlp.sock")
+	stale, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	require.NoError(t, err)
+	stale.SetUnlinkOnClose(false)
+	require.NoError(t, stale.Close())
+	_, err = os.Stat(path)
+	require.NoError(t, err)
+
+	hss := &ServerConfig{
+		Endpoint:  path,
+		Transport: confignet.TransportTypeUnix,
+	}
+	ln, err := hss.ToListener(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, ln.Close())
+}
+
+func TestServerUnixSocketInUse(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "otlp.sock")
+	hss := &ServerConfig{
+		Endpoint:  path,
+		Transport: confignet.TransportTypeUnix,
+	}
+	ln, err := hss.ToListener(context.Background())
+	require.NoError(t, err)
+	defer ln.Close()
+
+	_, err = hss.ToListener(context.Background())
+	require.EqualError(t, err, fmt.Sprintf("unix socket %q is already in use", path))
+}
+
+func TestServerUnixSocketNotASocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "otlp.sock")
+	require.NoError(t, os.WriteFile(path, nil, 0o600))
+	hss := &ServerConfig{
+		Endpoint:  path,
+		Transport: confignet.TransportTypeUnix,
+	}
+	_, err := hss.ToListener(context.Background())
+	require.Error(t, err)
+	_, err = os.Stat(path)
+	require.NoError(t, err, "files other than sockets are not removed")
+}
+
+func TestServerUnixSocketUnknownUser(t *testing.T) {
+	hss := &ServerConfig{
+		Endpoint:   filepath.Join(t.TempDir(), "otlp.sock"),
+		Transport:  confignet.TransportTypeUnix,
+		UnixSocket: UnixSocketConfig{User: "otelcol-unknown-user"},
+	}
+	_, err := hss.ToListener(context.Background())
+	require.Error(t, err)
+	_, err = os.Stat(hss.Endpoint)
+	assert.ErrorIs(t, err, fs.ErrNotExist, "the listener is closed on error")
+}
+
+func TestListenFds(t *testing.T) {
+	pid := os.Getpid()
+	tests := []struct {
+		name    string
+		env     map[string]string
+		want    []listenFd
+		wantErr string
+	}{
+		{
+			name:    "not_activated",
+			wantErr: "no socket passed by systemd, LISTEN_PID and LISTEN_FDS are not set",
+		},
+		{
+			name:    "other_process",
+			env:     map[string]string{"LISTEN_PID": strconv.Itoa(pid + 1), "LISTEN_FDS": "1"},
+			wantErr: fmt.Sprintf("the sockets passed by systemd are meant for process %d", pid+1),
+		},
+		{
+			name:    "invalid_pid",
+			env:     map[string]string{"LISTEN_PID": "self", "LISTEN_FDS": "1"},
+			wantErr: `invalid LISTEN_PID "self"`,
+		},
+		{
+			name:    "invalid_fds",
+			env:     map[string]string{"LISTEN_PID": strconv.Itoa(pid), "LISTEN_FDS": "0"},
+			wantErr: `invalid LISTEN_FDS "0"`,
+		},
+		{
+			name: "unnamed",
+			env:  map[string]string{"LISTEN_PID": strconv.Itoa(pid), "LISTEN_FDS": "2"},
+			want: []listenFd{{fd: 3, name: "LISTEN_FD_3"}, {fd: 4, name: "LISTEN_FD_4"}},
+		},
+		{
+			name: "named",
+			env:  map[string]string{"LISTEN_PID": strconv.Itoa(pid), "LISTEN_FDS": "2", "LISTEN_FDNAMES": "otlp-http:otlp-grpc"},
+			want: []listenFd{{fd: 3, name: "otlp-http"}, {fd: 4, name: "otlp-grpc"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fds, err := listenFds(func(key string) string { return tt.env[key] }, pid)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, fds)
+		})
+	}
+}
+
+func TestSelectListenFd(t *testing.T) {
+	httpFd := listenFd{fd: 3, name: "otlp-http"}
+	grpcFd := listenFd{fd: 4, name: "otlp-grpc"}
+
+	lfd, err := selectListenFd([]listenFd{httpFd}, "")
+	require.NoError(t, err)
+	assert.Equal(t, httpFd, lfd)
+
+	lfd, err = selectListenFd([]listenFd{httpFd, grpcFd}, "otlp-grpc")
+	require.NoError(t, err)
+	assert.Equal(t, grpcFd, lfd)
+
+	_, err = selectListenFd([]listenFd{httpFd, grpcFd}, "")
+	require.EqualError(t, err, "systemd passed 2 sockets, the name of the socket to listen on must be set")
+
+	_, err = selectListenFd([]listenFd{httpFd, grpcFd}, "zpages")
+	require.EqualError(t, err, `systemd did not pass a socket named "zpages"`)
+}
+
+// get serves HTTP on ln with the server configured by hss, and returns the network of the
+// address a request was received from.
+func get(t *testing.T, hss *ServerConfig, ln net.Listener) string {
+	srv, err := hss.ToServer(context.Background(), componenttest.NewNopHost(), componenttest.NewNopTelemetrySettings(),
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			addr, _ := r.Context().Value(http.LocalAddrContextKey).(net.Addr)
+			_, _ = io.WriteString(w, addr.Network())
+		}))
+	require.NoError(t, err)
+	go func() {
+		_ = srv.Serve(ln)
+	}()
+	defer func() { require.NoError(t, srv.Close()) }()
+
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			d := net.Dialer{}
+			return d.DialContext(ctx, "unix", hss.Endpoint)
+		},
+	}}
+	resp, err := client.Get("http://localhost/")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body)
+}
@@ -28,6 +28,7 @@ require (
 	go.opentelemetry.io/collector/component v0.115.0 // indirect
 	go.opentelemetry.io/collector/config/configauth v0.115.0 // indirect
 	go.opentelemetry.io/collector/config/configcompression v1.21.0 // indirect
+	go.opentelemetry.io/collector/config/confignet v1.21.0 // indirect
 	go.opentelemetry.io/collector/config/configopaque v1.21.0 // indirect
 	go.opentelemetry.io/collector/config/configtelemetry v0.115.0 // indirect
 	go.opentelemetry.io/collector/config/configtls v1.21.0 // indirect
@@ -78,3 +79,5 @@ replace go.opentelemetry.io/collector/extension => ../../../extension
 replace go.opentelemetry.io/collector/config/configtls => ../../configtls
 
 replace go.opentelemetry.io/collector/config/configcompression => ../../configcompression
+
+replace go.opentelemetry.io/collector/config/confignet => ../../confignet
@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connectortest // import "go.opentelemetry.io/collector/connector/connectortest"
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/internal/consumecontract"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+type CheckConsumeContractParams struct {
+	T *testing.T
+	// Factory that allows to create a connector.
+	Factory connector.Factory
+	// Signal is both the signal consumed and the signal emitted by the connector.
+	Signal pipeline.Signal
+	// Config of the connector to use.
+	Config component.Config
+	// NumberOfTestElements specifies the number of uniquely identifiable data elements
+	// (spans, log records or metric data points) to send to the connector for each test scenario.
+	NumberOfTestElements int
+}
+
+// CheckConsumeContract checks the contract between the connector, its caller and its next consumer.
+// Every element sent to the connector must either be delivered exactly once to the next consumer,
+// or be dropped because of a permanent error, which must be returned to the caller. Non-permanent
+// errors are retried by the checker the same way a receiver would do it. If the connector reports
+// that it does not mutate data, the checker also verifies that the data sent to it is left untouched.
+//
+// Elements are tracked by an attribute carried from the input to the output, so the checker only
+// applies to connectors emitting the signal they consume, and forwarding every element they receive.
+func CheckConsumeContract(params CheckConsumeContractParams) {
+	consumecontract.CheckForwarding(params.T, consumecontract.ForwardingParams{
+		Signal:               params.Signal,
+		NumberOfTestElements: params.NumberOfTestElements,
+		Create: func(next *consumecontract.MockConsumer) (component.Component, error) {
+			ctx := context.Background()
+			switch params.Signal {
+			case pipeline.SignalTraces:
+				return params.Factory.CreateTracesToTraces(ctx, NewNopSettings(), params.Config, next)
+			case pipeline.SignalMetrics:
+				return params.Factory.CreateMetricsToMetrics(ctx, NewNopSettings(), params.Config, next)
+			case pipeline.SignalLogs:
+				return params.Factory.CreateLogsToLogs(ctx, NewNopSettings(), params.Config, next)
+			}
+			return nil, pipeline.ErrSignalNotSupported
+		},
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package connectortest
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+// This file is an example that demonstrates how to use the CheckConsumeContract() function.
+// We declare a trivial example connector forwarding data as is, and then use it in TestConsumeContract().
+
+type exampleConnectorConfig struct{}
+
+type exampleTracesConnector struct {
+	component.StartFunc
+	component.ShutdownFunc
+	consumer.Traces
+}
+
+type exampleMetricsConnector struct {
+	component.StartFunc
+	component.ShutdownFunc
+	consumer.Metrics
+}
+
+type exampleLogsConnector struct {
+	component.StartFunc
+	component.ShutdownFunc
+	consumer.Logs
+}
+
+func newExampleFactory() connector.Factory {
+	return connector.NewFactory(
+		component.MustNewType("example_connector"),
+		func() component.Config {
+			return &exampleConnectorConfig{}
+		},
+		connector.WithTracesToTraces(createExampleTracesToTraces, component.StabilityLevelBeta),
+		connector.WithMetricsToMetrics(createExampleMetricsToMetrics, component.StabilityLevelBeta),
+		connector.WithLogsToLogs(createExampleLogsToLogs, component.StabilityLevelBeta),
+	)
+}
+
+func createExampleTracesToTraces(_ context.Context, _ connector.Settings, _ component.Config, next consumer.Traces) (connector.Traces, error) {
+	tc, err := consumer.NewTraces(next.ConsumeTraces)
+	return &exampleTracesConnector{Traces: tc}, err
+}
+
+func createExampleMetricsToMetrics(_ context.Context, _ connector.Settings, _ component.Config, next consumer.Metrics) (connector.Metrics, error) {
+	mc, err := consumer.NewMetrics(next.ConsumeMetrics)
+	return &exampleMetricsConnector{Metrics: mc}, err
+}
+
+func createExampleLogsToLogs(_ context.Context, _ connector.Settings, _ component.Config, next consumer.Logs) (connector.Logs, error) {
+	lc, err := consumer.NewLogs(next.ConsumeLogs)
+	return &exampleLogsConnector{Logs: lc}, err
+}
+
+func TestConsumeContract(t *testing.T) {
+	for _, signal := range []pipeline.Signal{pipeline.SignalTraces, pipeline.SignalMetrics, pipeline.SignalLogs} {
+		t.Run(signal.String(), func(t *testing.T) {
+			// Run the contract checker. This will trigger test failures if any problems are found.
+			CheckConsumeContract(CheckConsumeContractParams{
+				T:                    t,
+				Factory:              newExampleFactory(),
+				Signal:               signal,
+				Config:               &exampleConnectorConfig{},
+				NumberOfTestElements: 100,
+			})
+		})
+	}
+}
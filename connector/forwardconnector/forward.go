@@ -72,5 +72,5 @@ type forward struct {
 }
 
 func (c *forward) Capabilities() consumer.Capabilities {
-	return consumer.Capabilities{MutatesData: false}
+	return consumer.Capabilities{MutatesData: false, DoesNotRetainData: true}
 }
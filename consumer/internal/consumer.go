@@ -11,6 +11,16 @@ type Capabilities struct {
 	// does not modify the data it MUST set this flag to false. If the processor creates
 	// a copy of the data before modifying then this flag can be safely set to false.
 	MutatesData bool
+
+	// DoesNotRetainData is set to true if the component does not keep any reference to the input
+	// Traces, Logs or Metrics argument after the Consume* function returns, for example because it
+	// neither batches nor queues the data. Components MUST NOT set this flag to true if they retain
+	// the input data, unless they copy it before retaining it. Components which do not declare
+	// this flag are assumed to retain the data.
+	//
+	// Receivers may release and reuse the memory of the data once Consume* returns
+	// only if this flag is true.
+	DoesNotRetainData bool
 }
 
 type BaseConsumer interface {
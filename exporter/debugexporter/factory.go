@@ -56,7 +56,7 @@ func createTraces(ctx context.Context, set exporter.Settings, config component.C
 	debug := newDebugExporter(exporterLogger, cfg.Verbosity)
 	return exporterhelper.NewTraces(ctx, set, config,
 		debug.pushTraces,
-		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false, DoesNotRetainData: true}),
 		exporterhelper.WithTimeout(exporterhelper.TimeoutConfig{Timeout: 0}),
 		exporterhelper.WithShutdown(otlptext.LoggerSync(exporterLogger)),
 	)
@@ -68,7 +68,7 @@ func createMetrics(ctx context.Context, set exporter.Settings, config component.
 	debug := newDebugExporter(exporterLogger, cfg.Verbosity)
 	return exporterhelper.NewMetrics(ctx, set, config,
 		debug.pushMetrics,
-		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false, DoesNotRetainData: true}),
 		exporterhelper.WithTimeout(exporterhelper.TimeoutConfig{Timeout: 0}),
 		exporterhelper.WithShutdown(otlptext.LoggerSync(exporterLogger)),
 	)
@@ -80,7 +80,7 @@ func createLogs(ctx context.Context, set exporter.Settings, config component.Con
 	debug := newDebugExporter(exporterLogger, cfg.Verbosity)
 	return exporterhelper.NewLogs(ctx, set, config,
 		debug.pushLogs,
-		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false, DoesNotRetainData: true}),
 		exporterhelper.WithTimeout(exporterhelper.TimeoutConfig{Timeout: 0}),
 		exporterhelper.WithShutdown(otlptext.LoggerSync(exporterLogger)),
 	)
@@ -92,7 +92,7 @@ func createProfiles(ctx context.Context, set exporter.Settings, config component
 	debug := newDebugExporter(exporterLogger, cfg.Verbosity)
 	return exporterhelperprofiles.NewProfilesExporter(ctx, set, config,
 		debug.pushProfiles,
-		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false, DoesNotRetainData: true}),
 		exporterhelper.WithTimeout(exporterhelper.TimeoutConfig{Timeout: 0}),
 		exporterhelper.WithShutdown(otlptext.LoggerSync(exporterLogger)),
 	)
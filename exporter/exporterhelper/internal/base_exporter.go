@@ -19,18 +19,13 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterbatcher"
+	"go.opentelemetry.io/collector/exporter/exporterhelper/internal/metadata"
 	"go.opentelemetry.io/collector/exporter/exporterqueue" // BaseExporter contains common fields between different exporter types.
 	"go.opentelemetry.io/collector/exporter/internal"
-	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/pipeline"
 )
 
-var usePullingBasedExporterQueueBatcher = featuregate.GlobalRegistry().MustRegister(
-	"exporter.UsePullingBasedExporterQueueBatcher",
-	featuregate.StageAlpha,
-	featuregate.WithRegisterFromVersion("v0.115.0"),
-	featuregate.WithRegisterDescription("if set to true, turns on the pulling-based exporter queue bathcer"),
-)
+var usePullingBasedExporterQueueBatcher = metadata.ExporterUsePullingBasedExporterQueueBatcherFeatureGate
 
 type ObsrepSenderFactory = func(obsrep *ObsReport) RequestSender
 
@@ -62,6 +57,7 @@ type BaseExporter struct {
 	TimeoutSender *TimeoutSender // TimeoutSender is always initialized.
 
 	ConsumerOptions []consumer.Option
+	capabilities    consumer.Capabilities
 
 	queueCfg     exporterqueue.Config
 	queueFactory exporterqueue.Factory[internal.Request]
@@ -125,9 +121,15 @@ func NewBaseExporter(set exporter.Settings, signal pipeline.Signal, osf ObsrepSe
 		if qs, ok := be.QueueSender.(*QueueSender); ok {
 			bs.concurrencyLimit = int64(qs.numConsumers)
 		}
-		// Batcher sender mutates the data.
-		be.ConsumerOptions = append(be.ConsumerOptions, consumer.WithCapabilities(consumer.Capabilities{MutatesData: true}))
+		// Batcher sender mutates and keeps the data.
+		be.capabilities.MutatesData = true
+		be.capabilities.DoesNotRetainData = false
+	}
+	if be.queueCfg.Enabled {
+		// Queue sender keeps the data after the Consume* function returns.
+		be.capabilities.DoesNotRetainData = false
 	}
+	be.ConsumerOptions = append(be.ConsumerOptions, consumer.WithCapabilities(be.capabilities))
 
 	return be, nil
 }
@@ -266,7 +268,7 @@ func WithRequestQueue(cfg exporterqueue.Config, queueFactory exporterqueue.Facto
 // TODO: Verify if we can change the default to be mutable as we do for processors.
 func WithCapabilities(capabilities consumer.Capabilities) Option {
 	return func(o *BaseExporter) error {
-		o.ConsumerOptions = append(o.ConsumerOptions, consumer.WithCapabilities(capabilities))
+		o.capabilities = capabilities
 		return nil
 	}
 }
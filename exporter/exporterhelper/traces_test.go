@@ -112,6 +112,16 @@ func TestTraces_WithCapabilities(t *testing.T) {
 	assert.Equal(t, capabilities, te.Capabilities())
 }
 
+func TestTraces_WithQueueRetainsData(t *testing.T) {
+	capabilities := consumer.Capabilities{MutatesData: true, DoesNotRetainData: true}
+	te, err := NewTraces(context.Background(), exportertest.NewNopSettings(), &fakeTracesConfig, newTraceDataPusher(nil),
+		WithCapabilities(capabilities), WithQueue(NewDefaultQueueConfig()))
+	assert.NotNil(t, te)
+	require.NoError(t, err)
+
+	assert.Equal(t, consumer.Capabilities{MutatesData: true}, te.Capabilities())
+}
+
 func TestTracesRequest_WithCapabilities(t *testing.T) {
 	capabilities := consumer.Capabilities{MutatesData: true}
 	te, err := NewTracesRequest(context.Background(), exportertest.NewNopSettings(),
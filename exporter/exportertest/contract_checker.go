@@ -16,15 +16,19 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterprofiles"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pipeline"
+	"go.opentelemetry.io/collector/pipeline/pipelineprofiles"
 	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverprofiles"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 )
 
-// uniqueIDAttrName is the attribute name that is used in log records/spans/datapoints as the unique identifier.
+// uniqueIDAttrName is the attribute name that is used in log records/spans/datapoints/profiles as the unique identifier.
 const uniqueIDAttrName = "test_id"
 
 // uniqueIDAttrVal is the value type of the uniqueIDAttrName.
@@ -34,10 +38,12 @@ type CheckConsumeContractParams struct {
 	T                    *testing.T
 	NumberOfTestElements int
 	Signal               pipeline.Signal
-	// ExporterFactory to create an exporter to be tested.
+	// ExporterFactory to create an exporter to be tested. It must implement
+	// exporterprofiles.Factory to test the profiles signal.
 	ExporterFactory exporter.Factory
 	ExporterConfig  component.Config
-	// ReceiverFactory to create a mock receiver.
+	// ReceiverFactory to create a mock receiver. It must implement
+	// receiverprofiles.Factory to test the profiles signal.
 	ReceiverFactory receiver.Factory
 	ReceiverConfig  component.Config
 }
@@ -100,6 +106,13 @@ func checkConsumeContractScenario(t *testing.T, params CheckConsumeContractParam
 		require.NoError(t, err)
 		require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
 		checkMetrics(t, params, r, &mockConsumerInstance, checkIfTestPassed)
+	case pipelineprofiles.SignalProfiles:
+		rf, ok := params.ReceiverFactory.(receiverprofiles.Factory)
+		require.True(t, ok, "receiver factory must implement receiverprofiles.Factory to test profiles")
+		r, err := rf.CreateProfiles(context.Background(), receivertest.NewNopSettings(), params.ReceiverConfig, &mockConsumerInstance)
+		require.NoError(t, err)
+		require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+		checkProfiles(t, params, r, &mockConsumerInstance, checkIfTestPassed)
 	default:
 		require.FailNow(t, "must specify a valid DataType to test for")
 	}
@@ -225,6 +238,45 @@ func checkLogs(t *testing.T, params CheckConsumeContractParams, mockReceiver com
 	}, 2*time.Second, 100*time.Millisecond)
 }
 
+func checkProfiles(t *testing.T, params CheckConsumeContractParams, mockReceiver component.Component, mockConsumer *mockConsumer, checkIfTestPassed func(*testing.T, int, requestCounter)) {
+	ctx := context.Background()
+	ef, ok := params.ExporterFactory.(exporterprofiles.Factory)
+	require.True(t, ok, "exporter factory must implement exporterprofiles.Factory to test profiles")
+	exp, err := ef.CreateProfiles(ctx, NewNopSettings(), params.ExporterConfig)
+	require.NoError(t, err)
+	require.NotNil(t, exp)
+
+	err = exp.Start(ctx, componenttest.NewNopHost())
+	require.NoError(t, err)
+
+	defer func(exp exporterprofiles.Profiles, ctx context.Context) {
+		err = exp.Shutdown(ctx)
+		require.NoError(t, err)
+		err = mockReceiver.Shutdown(ctx)
+		require.NoError(t, err)
+		mockConsumer.clear()
+	}(exp, ctx)
+
+	for i := 0; i < params.NumberOfTestElements; i++ {
+		id := uniqueIDAttrVal(strconv.Itoa(i))
+		data := createOneProfileWithID(id)
+
+		err = exp.ConsumeProfiles(ctx, data)
+	}
+	reqCounter := mockConsumer.getRequestCounter()
+	// The overall number of requests sent by exporter
+	fmt.Printf("Number of export tries: %d\n", reqCounter.total)
+	// Successfully delivered items
+	fmt.Printf("Total items received successfully: %d\n", reqCounter.success)
+	// Number of errors that happened
+	fmt.Printf("Number of permanent errors: %d\n", reqCounter.error.permanent)
+	fmt.Printf("Number of non-permanent errors: %d\n", reqCounter.error.nonpermanent)
+
+	assert.EventuallyWithT(t, func(*assert.CollectT) {
+		checkIfTestPassed(t, params.NumberOfTestElements, *reqCounter)
+	}, 2*time.Second, 100*time.Millisecond)
+}
+
 // Test is successful if all the elements were received successfully and no error was returned
 func alwaysSucceedsPassed(t *testing.T, allRecordsNumber int, reqCounter requestCounter) {
 	require.Equal(t, allRecordsNumber, reqCounter.success)
@@ -278,3 +330,12 @@ func createOneMetricWithID(id uniqueIDAttrVal) pmetric.Metrics {
 		DataPoints().AppendEmpty().Attributes().PutStr(uniqueIDAttrName, string(id))
 	return data
 }
+
+func createOneProfileWithID(id uniqueIDAttrVal) pprofile.Profiles {
+	data := pprofile.NewProfiles()
+	data.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty().Attributes().PutStr(
+		uniqueIDAttrName,
+		string(id),
+	)
+	return data
+}
@@ -11,10 +11,15 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configretry"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumerprofiles"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/exporter/exporterhelper/exporterhelperprofiles"
+	"go.opentelemetry.io/collector/exporter/exporterprofiles"
 	"go.opentelemetry.io/collector/pipeline"
+	"go.opentelemetry.io/collector/pipeline/pipelineprofiles"
 	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverprofiles"
 )
 
 // retryConfig is a configuration to quickly retry failed exports.
@@ -31,6 +36,7 @@ type mockReceiver struct {
 	consumer.Traces
 	consumer.Metrics
 	consumer.Logs
+	consumerprofiles.Profiles
 }
 
 // mockFactory is a factory to create exporters sending data to the mockReceiver.
@@ -76,32 +82,49 @@ func (mef *mockFactory) createMockLogs(
 	)
 }
 
+func (mef *mockFactory) createMockProfiles(
+	ctx context.Context,
+	set exporter.Settings,
+	cfg component.Config,
+) (exporterprofiles.Profiles, error) {
+	return exporterhelperprofiles.NewProfilesExporter(ctx, set, cfg,
+		mef.mr.Profiles.ConsumeProfiles,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithRetry(retryConfig),
+	)
+}
+
 func newMockFactory(mr *mockReceiver) exporter.Factory {
 	mef := &mockFactory{mr: mr}
-	return exporter.NewFactory(
+	return exporterprofiles.NewFactory(
 		component.MustNewType("pass_through_exporter"),
 		func() component.Config { return &nopConfig{} },
-		exporter.WithTraces(mef.createMockTraces, component.StabilityLevelBeta),
-		exporter.WithMetrics(mef.createMockMetrics, component.StabilityLevelBeta),
-		exporter.WithLogs(mef.createMockLogs, component.StabilityLevelBeta),
+		exporterprofiles.WithTraces(mef.createMockTraces, component.StabilityLevelBeta),
+		exporterprofiles.WithMetrics(mef.createMockMetrics, component.StabilityLevelBeta),
+		exporterprofiles.WithLogs(mef.createMockLogs, component.StabilityLevelBeta),
+		exporterprofiles.WithProfiles(mef.createMockProfiles, component.StabilityLevelAlpha),
 	)
 }
 
 func newMockReceiverFactory(mr *mockReceiver) receiver.Factory {
-	return receiver.NewFactory(component.MustNewType("pass_through_receiver"),
+	return receiverprofiles.NewFactory(component.MustNewType("pass_through_receiver"),
 		func() component.Config { return &nopConfig{} },
-		receiver.WithTraces(func(_ context.Context, _ receiver.Settings, _ component.Config, c consumer.Traces) (receiver.Traces, error) {
+		receiverprofiles.WithTraces(func(_ context.Context, _ receiver.Settings, _ component.Config, c consumer.Traces) (receiver.Traces, error) {
 			mr.Traces = c
 			return mr, nil
 		}, component.StabilityLevelStable),
-		receiver.WithMetrics(func(_ context.Context, _ receiver.Settings, _ component.Config, c consumer.Metrics) (receiver.Metrics, error) {
+		receiverprofiles.WithMetrics(func(_ context.Context, _ receiver.Settings, _ component.Config, c consumer.Metrics) (receiver.Metrics, error) {
 			mr.Metrics = c
 			return mr, nil
 		}, component.StabilityLevelStable),
-		receiver.WithLogs(func(_ context.Context, _ receiver.Settings, _ component.Config, c consumer.Logs) (receiver.Logs, error) {
+		receiverprofiles.WithLogs(func(_ context.Context, _ receiver.Settings, _ component.Config, c consumer.Logs) (receiver.Logs, error) {
 			mr.Logs = c
 			return mr, nil
 		}, component.StabilityLevelStable),
+		receiverprofiles.WithProfiles(func(_ context.Context, _ receiver.Settings, _ component.Config, c consumerprofiles.Profiles) (receiverprofiles.Profiles, error) {
+			mr.Profiles = c
+			return mr, nil
+		}, component.StabilityLevelAlpha),
 	)
 }
 
@@ -142,3 +165,15 @@ func TestCheckConsumeContractTraces(t *testing.T) {
 		ReceiverFactory:      newMockReceiverFactory(mr),
 	})
 }
+
+func TestCheckConsumeContractProfiles(t *testing.T) {
+	mr := &mockReceiver{}
+	CheckConsumeContract(CheckConsumeContractParams{
+		T:                    t,
+		ExporterFactory:      newMockFactory(mr),
+		Signal:               pipelineprofiles.SignalProfiles,
+		ExporterConfig:       nopConfig{},
+		NumberOfTestElements: 10,
+		ReceiverFactory:      newMockReceiverFactory(mr),
+	})
+}
@@ -13,9 +13,11 @@ import (
 
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumerprofiles"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 )
 
@@ -59,12 +61,14 @@ type mockConsumer struct {
 	consumer.Traces
 	consumer.Logs
 	consumer.Metrics
+	consumerprofiles.Profiles
 	reqCounter          *requestCounter
 	mux                 sync.Mutex
 	exportErrorFunction func() error
 	receivedTraces      []ptrace.Traces
 	receivedMetrics     []pmetric.Metrics
 	receivedLogs        []plog.Logs
+	receivedProfiles    []pprofile.Profiles
 }
 
 func newMockConsumer(decisionFunc func() error) mockConsumer {
@@ -75,6 +79,7 @@ func newMockConsumer(decisionFunc func() error) mockConsumer {
 		receivedTraces:      nil,
 		receivedMetrics:     nil,
 		receivedLogs:        nil,
+		receivedProfiles:    nil,
 	}
 }
 
@@ -120,6 +125,20 @@ func (r *mockConsumer) ConsumeMetrics(_ context.Context, md pmetric.Metrics) err
 	return nil
 }
 
+func (r *mockConsumer) ConsumeProfiles(_ context.Context, pd pprofile.Profiles) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.reqCounter.total++
+	generatedError := r.exportErrorFunction()
+	if generatedError != nil {
+		r.processError(generatedError)
+		return generatedError
+	}
+	r.reqCounter.success++
+	r.receivedProfiles = append(r.receivedProfiles, pd)
+	return nil
+}
+
 func (r *mockConsumer) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{}
 }
@@ -210,3 +229,17 @@ func idFromMetrics(data pmetric.Metrics) (string, error) {
 	metricID = key.Str()
 	return metricID, nil
 }
+
+func idFromProfiles(data pprofile.Profiles) (string, error) {
+	var profileID string
+	rss := data.ResourceProfiles()
+	key, exists := rss.At(0).ScopeProfiles().At(0).Profiles().At(0).Attributes().Get(uniqueIDAttrName)
+	if !exists {
+		return "", fmt.Errorf("invalid data element, attribute %q is missing", uniqueIDAttrName)
+	}
+	if key.Type() != pcommon.ValueTypeStr {
+		return "", fmt.Errorf("invalid data element, attribute %q is wrong type %v", uniqueIDAttrName, key.Type())
+	}
+	profileID = key.Str()
+	return profileID, nil
+}
@@ -12,8 +12,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 )
 
@@ -41,6 +43,15 @@ func createMetric(id string) pmetric.Metrics {
 	return validData
 }
 
+func createProfile(id string) pprofile.Profiles {
+	validData := pprofile.NewProfiles()
+	validData.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty().Attributes().PutStr(
+		uniqueIDAttrName,
+		id,
+	)
+	return validData
+}
+
 func TestIDFromMetrics(t *testing.T) {
 	// Test case 1: Valid data
 	id := "metric_id"
@@ -110,6 +121,29 @@ func TestIDFromLogs(t *testing.T) {
 	assert.EqualError(t, err, fmt.Sprintf("invalid data element, attribute %q is wrong type Int", uniqueIDAttrName))
 }
 
+func TestIDFromProfiles(t *testing.T) {
+	// Test case 1: Valid data
+	id := "profile_id"
+	validData := createProfile(id)
+	profileID, err := idFromProfiles(validData)
+	assert.Equal(t, profileID, id)
+	require.NoError(t, err)
+
+	// Test case 2: Missing uniqueIDAttrName attribute
+	invalidData := pprofile.NewProfiles()
+	invalidData.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty()
+	_, err = idFromProfiles(invalidData)
+	require.EqualError(t, err, fmt.Sprintf("invalid data element, attribute %q is missing", uniqueIDAttrName))
+
+	// Test case 3: Wrong attribute type
+	var intID int64 = 12
+	wrongAttribute := pprofile.NewProfiles()
+	wrongAttribute.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty().Attributes().
+		PutInt(uniqueIDAttrName, intID)
+	_, err = idFromProfiles(wrongAttribute)
+	assert.EqualError(t, err, fmt.Sprintf("invalid data element, attribute %q is wrong type Int", uniqueIDAttrName))
+}
+
 func returnNonPermanentError() error {
 	return errNonPermanent
 }
@@ -234,6 +268,40 @@ func TestConsumeMetricsSuccess(t *testing.T) {
 	assert.Equal(t, 1, mc.reqCounter.total)
 }
 
+func TestConsumeProfilesNonPermanent(t *testing.T) {
+	mc := newMockConsumer(returnNonPermanentError)
+	validData := createProfile("profileId")
+	err := mc.ConsumeProfiles(context.Background(), validData)
+	require.Error(t, err)
+	assert.Equal(t, 1, mc.reqCounter.error.nonpermanent)
+	assert.Equal(t, 0, mc.reqCounter.error.permanent)
+	assert.Equal(t, 0, mc.reqCounter.success)
+	assert.Equal(t, 1, mc.reqCounter.total)
+}
+
+func TestConsumeProfilesPermanent(t *testing.T) {
+	mc := newMockConsumer(func() error { return consumererror.NewPermanent(errPermanent) })
+	validData := createProfile("profileId")
+	err := mc.ConsumeProfiles(context.Background(), validData)
+	require.Error(t, err)
+	assert.Equal(t, 0, mc.reqCounter.error.nonpermanent)
+	assert.Equal(t, 1, mc.reqCounter.error.permanent)
+	assert.Equal(t, 0, mc.reqCounter.success)
+	assert.Equal(t, 1, mc.reqCounter.total)
+}
+
+func TestConsumeProfilesSuccess(t *testing.T) {
+	mc := newMockConsumer(func() error { return nil })
+	validData := createProfile("profileId")
+	err := mc.ConsumeProfiles(context.Background(), validData)
+	require.NoError(t, err)
+	assert.Equal(t, 0, mc.reqCounter.error.nonpermanent)
+	assert.Equal(t, 0, mc.reqCounter.error.permanent)
+	assert.Equal(t, 1, mc.reqCounter.success)
+	assert.Equal(t, 1, mc.reqCounter.total)
+	assert.Len(t, mc.receivedProfiles, 1)
+}
+
 func TestCapabilities(t *testing.T) {
 	mc := newMockConsumer(func() error { return nil })
 	assert.Equal(t, consumer.Capabilities{}, mc.Capabilities())
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package faultexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configretry"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/internal/fault"
+)
+
+func TestUnmarshalDefaultConfig(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	require.NoError(t, confmap.New().Unmarshal(&cfg))
+	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
+}
+
+func TestUnmarshalConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	cfg := NewFactory().CreateDefaultConfig()
+	require.NoError(t, cm.Unmarshal(&cfg))
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	queueCfg := exporterhelper.NewDefaultQueueConfig()
+	queueCfg.Enabled = false
+	retryCfg := configretry.NewDefaultBackOffConfig()
+	retryCfg.Enabled = false
+	assert.Equal(t, &Config{
+		Config: fault.Config{
+			ErrorRate: 0.1,
+			Error: fault.ErrorConfig{
+				GRPCCode: "Unavailable",
+				Message:  "backend unavailable",
+			},
+			ThrottleRate: 0.2,
+			RetryAfter:   10 * time.Second,
+			Latency: fault.LatencyConfig{
+				Distribution: fault.DistributionUniform,
+				Min:          10 * time.Millisecond,
+				Max:          100 * time.Millisecond,
+			},
+			HangRate:     0.01,
+			HangDuration: time.Minute,
+		},
+		TimeoutConfig: exporterhelper.TimeoutConfig{Timeout: 20 * time.Second},
+		QueueConfig:   queueCfg,
+		RetryConfig:   retryCfg,
+	}, cfg)
+}
+
+func TestValidateConfig(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.ErrorRate = 2
+	assert.EqualError(t, component.ValidateConfig(cfg), "'error_rate' must be between 0 and 1, got 2\n'error_rate' and 'throttle_rate' must not add up to more than 1")
+}
@@ -0,0 +1,9 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package faultexporter drops the data it receives after injecting configurable faults,
+// such as errors, throttling, latency and hangs. It is meant to test how pipelines behave
+// when an exporter misbehaves.
+package faultexporter // import "go.opentelemetry.io/collector/exporter/faultexporter"
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package faultexporter // import "go.opentelemetry.io/collector/exporter/faultexporter"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/internal/fault"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// faultExporter drops the data pushed to it, after injecting the configured faults.
+type faultExporter struct {
+	injector *fault.Injector
+}
+
+func newFaultExporter(cfg *Config) *faultExporter {
+	return &faultExporter{injector: fault.NewInjector(cfg.Config)}
+}
+
+func (e *faultExporter) pushTraces(ctx context.Context, _ ptrace.Traces) error {
+	return e.inject(ctx)
+}
+
+func (e *faultExporter) pushMetrics(ctx context.Context, _ pmetric.Metrics) error {
+	return e.inject(ctx)
+}
+
+func (e *faultExporter) pushLogs(ctx context.Context, _ plog.Logs) error {
+	return e.inject(ctx)
+}
+
+func (e *faultExporter) inject(ctx context.Context) error {
+	err := e.injector.Inject(ctx)
+	if delay, ok := fault.RetryDelay(err); ok {
+		// Let the retry sender honor the delay, as it would for a throttling backend.
+		return exporterhelper.NewThrottleRetry(err, delay)
+	}
+	return err
+}
+
+// The exporters below release the hanging requests before shutting down the exporter helper,
+// which otherwise waits for them while draining the queue.
+
+type tracesExporter struct {
+	exporter.Traces
+	*faultExporter
+}
+
+func (e *tracesExporter) Shutdown(ctx context.Context) error {
+	e.injector.Shutdown()
+	return e.Traces.Shutdown(ctx)
+}
+
+type metricsExporter struct {
+	exporter.Metrics
+	*faultExporter
+}
+
+func (e *metricsExporter) Shutdown(ctx context.Context) error {
+	e.injector.Shutdown()
+	return e.Metrics.Shutdown(ctx)
+}
+
+type logsExporter struct {
+	exporter.Logs
+	*faultExporter
+}
+
+func (e *logsExporter) Shutdown(ctx context.Context) error {
+	e.injector.Shutdown()
+	return e.Logs.Shutdown(ctx)
+}
@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package faultexporter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// newSyncConfig returns a configuration returning the injected errors to the caller.
+func newSyncConfig() *Config {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.QueueConfig.Enabled = false
+	cfg.RetryConfig.Enabled = false
+	return cfg
+}
+
+func TestExportNoFault(t *testing.T) {
+	factory := NewFactory()
+	cfg := newSyncConfig()
+	set := exportertest.NewNopSettings()
+
+	te, err := factory.CreateTraces(context.Background(), set, cfg)
+	require.NoError(t, err)
+	require.NoError(t, te.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, te.ConsumeTraces(context.Background(), ptrace.NewTraces()))
+	assert.NoError(t, te.Shutdown(context.Background()))
+
+	me, err := factory.CreateMetrics(context.Background(), set, cfg)
+	require.NoError(t, err)
+	require.NoError(t, me.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, me.ConsumeMetrics(context.Background(), pmetric.NewMetrics()))
+	assert.NoError(t, me.Shutdown(context.Background()))
+
+	le, err := factory.CreateLogs(context.Background(), set, cfg)
+	require.NoError(t, err)
+	require.NoError(t, le.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, le.ConsumeLogs(context.Background(), plog.NewLogs()))
+	assert.NoError(t, le.Shutdown(context.Background()))
+}
+
+func TestExportPermanentError(t *testing.T) {
+	cfg := newSyncConfig()
+	cfg.ErrorRate = 1
+	cfg.Error.GRPCCode = "InvalidArgument"
+	// Retries are enabled, but must not happen for permanent errors.
+	cfg.RetryConfig.Enabled = true
+
+	le, err := NewFactory().CreateLogs(context.Background(), exportertest.NewNopSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, le.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, le.Shutdown(context.Background())) }()
+
+	err = le.ConsumeLogs(context.Background(), plog.NewLogs())
+	require.Error(t, err)
+	assert.True(t, consumererror.IsPermanent(err))
+}
+
+func TestExportThrottled(t *testing.T) {
+	cfg := newSyncConfig()
+	cfg.ThrottleRate = 1
+	cfg.RetryAfter = 100 * time.Millisecond
+	cfg.RetryConfig.Enabled = true
+	cfg.RetryConfig.InitialInterval = time.Millisecond
+	cfg.RetryConfig.MaxElapsedTime = 150 * time.Millisecond
+
+	me, err := NewFactory().CreateMetrics(context.Background(), exportertest.NewNopSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, me.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, me.Shutdown(context.Background())) }()
+
+	// Attempts are spaced by the requested delay rather than the initial interval.
+	start := time.Now()
+	err = me.ConsumeMetrics(context.Background(), pmetric.NewMetrics())
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestShutdownReleasesHangingRequests(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.HangRate = 1
+	cfg.TimeoutConfig.Timeout = 0
+
+	te, err := NewFactory().CreateTraces(context.Background(), exportertest.NewNopSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, te.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, te.ConsumeTraces(context.Background(), ptrace.NewTraces()))
+
+	done := make(chan struct{})
+	go func() {
+		assert.NoError(t, te.Shutdown(context.Background()))
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("shutdown blocked by a hanging request")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configgrpc"
@@ -29,10 +30,68 @@ type Config struct {
 	BatcherConfig exporterbatcher.Config `mapstructure:"batcher"`
 
 	configgrpc.ClientConfig `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
+
+	// Experimental: LoadBalancing configures additional endpoints to send data to when the main endpoint fails,
+	// or to spread the load across all the endpoints.
+	LoadBalancing LoadBalancingConfig `mapstructure:"load_balancing"`
+}
+
+// LoadBalancingPolicy defines how the exporter chooses the endpoint to send data to.
+type LoadBalancingPolicy string
+
+const (
+	// LoadBalancingPolicyPriority sends all data to the first healthy endpoint, in the order of the configuration,
+	// starting with the main endpoint.
+	LoadBalancingPolicyPriority LoadBalancingPolicy = "priority"
+	// LoadBalancingPolicyRoundRobin spreads the data across all healthy endpoints.
+	LoadBalancingPolicyRoundRobin LoadBalancingPolicy = "round_robin"
+)
+
+// LoadBalancingConfig defines the configuration for sending data to multiple endpoints.
+type LoadBalancingConfig struct {
+	// Endpoints is the list of additional endpoints to send data to. They share all the other
+	// client settings, like TLS and headers, with the main endpoint.
+	Endpoints []string `mapstructure:"endpoints"`
+
+	// Policy is the policy used to choose the endpoint for each request, one of "priority" or "round_robin".
+	Policy LoadBalancingPolicy `mapstructure:"policy"`
+
+	// RetryBudget is the number of consecutive requests which may fail on an endpoint before
+	// it is marked as unhealthy. Each failed request fails over to the next endpoint right away.
+	RetryBudget int `mapstructure:"retry_budget"`
+
+	// UnhealthyDuration is the time an endpoint is skipped after it exhausted its retry budget.
+	// Unhealthy endpoints are still used when no healthy endpoint is left.
+	UnhealthyDuration time.Duration `mapstructure:"unhealthy_duration"`
 }
 
 func (c *Config) Validate() error {
-	endpoint := c.sanitizedEndpoint()
+	if err := validateEndpoint(c.Endpoint); err != nil {
+		return err
+	}
+	for _, endpoint := range c.LoadBalancing.Endpoints {
+		if err := validateEndpoint(endpoint); err != nil {
+			return fmt.Errorf("load_balancing: %w", err)
+		}
+	}
+
+	switch c.LoadBalancing.Policy {
+	case LoadBalancingPolicyPriority, LoadBalancingPolicyRoundRobin:
+	default:
+		return fmt.Errorf(`load_balancing: invalid policy "%s"`, c.LoadBalancing.Policy)
+	}
+	if c.LoadBalancing.RetryBudget < 1 {
+		return errors.New(`load_balancing: "retry_budget" must be positive`)
+	}
+	if c.LoadBalancing.UnhealthyDuration < 0 {
+		return errors.New(`load_balancing: "unhealthy_duration" must be non-negative`)
+	}
+
+	return nil
+}
+
+func validateEndpoint(endpoint string) error {
+	endpoint = sanitizeEndpoint(endpoint)
 	if endpoint == "" {
 		return errors.New(`requires a non-empty "endpoint"`)
 	}
@@ -50,16 +109,20 @@ func (c *Config) Validate() error {
 }
 
 func (c *Config) sanitizedEndpoint() string {
+	return sanitizeEndpoint(c.Endpoint)
+}
+
+func sanitizeEndpoint(endpoint string) string {
 	switch {
-	case strings.HasPrefix(c.Endpoint, "http://"):
-		return strings.TrimPrefix(c.Endpoint, "http://")
-	case strings.HasPrefix(c.Endpoint, "https://"):
-		return strings.TrimPrefix(c.Endpoint, "https://")
-	case strings.HasPrefix(c.Endpoint, "dns://"):
+	case strings.HasPrefix(endpoint, "http://"):
+		return strings.TrimPrefix(endpoint, "http://")
+	case strings.HasPrefix(endpoint, "https://"):
+		return strings.TrimPrefix(endpoint, "https://")
+	case strings.HasPrefix(endpoint, "dns://"):
 		r := regexp.MustCompile("^dns://[/]?")
-		return r.ReplaceAllString(c.Endpoint, "")
+		return r.ReplaceAllString(endpoint, "")
 	default:
-		return c.Endpoint
+		return endpoint
 	}
 }
 
@@ -87,6 +87,12 @@ func TestUnmarshalConfig(t *testing.T) {
 				BalancerName:    "round_robin",
 				Auth:            &configauth.Authentication{AuthenticatorID: component.MustNewID("nop")},
 			},
+			LoadBalancing: LoadBalancingConfig{
+				Endpoints:         []string{"1.2.3.5:1234", "dns:///backup.example.com:4317"},
+				Policy:            LoadBalancingPolicyRoundRobin,
+				RetryBudget:       2,
+				UnhealthyDuration: time.Minute,
+			},
 		}, cfg)
 }
 
@@ -130,6 +136,22 @@ func TestUnmarshalInvalidConfig(t *testing.T) {
 			name:     "invalid_port",
 			errorMsg: `invalid port "port"`,
 		},
+		{
+			name:     "invalid_load_balancing_endpoint",
+			errorMsg: `load_balancing: address backup.example.com: missing port in address`,
+		},
+		{
+			name:     "invalid_load_balancing_policy",
+			errorMsg: `load_balancing: invalid policy "random"`,
+		},
+		{
+			name:     "invalid_load_balancing_retry_budget",
+			errorMsg: `load_balancing: "retry_budget" must be positive`,
+		},
+		{
+			name:     "invalid_load_balancing_unhealthy_duration",
+			errorMsg: `load_balancing: "unhealthy_duration" must be non-negative`,
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			cfg := factory.CreateDefaultConfig()
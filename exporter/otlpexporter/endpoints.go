@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpexporter // import "go.opentelemetry.io/collector/exporter/otlpexporter"
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/pprofile/pprofileotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+)
+
+// endpointClient holds the gRPC connection and clients for a single endpoint.
+type endpointClient struct {
+	endpoint string
+
+	traceExporter   ptraceotlp.GRPCClient
+	metricExporter  pmetricotlp.GRPCClient
+	logExporter     plogotlp.GRPCClient
+	profileExporter pprofileotlp.GRPCClient
+	clientConn      *grpc.ClientConn
+
+	// unhealthyUntil is the unix nano time until which the endpoint is skipped, if possible.
+	unhealthyUntil atomic.Int64
+	// failures is the number of consecutive requests which failed on the endpoint.
+	failures atomic.Int64
+}
+
+func newEndpointClient(endpoint string, clientConn *grpc.ClientConn) *endpointClient {
+	return &endpointClient{
+		endpoint:        endpoint,
+		traceExporter:   ptraceotlp.NewGRPCClient(clientConn),
+		metricExporter:  pmetricotlp.NewGRPCClient(clientConn),
+		logExporter:     plogotlp.NewGRPCClient(clientConn),
+		profileExporter: pprofileotlp.NewGRPCClient(clientConn),
+		clientConn:      clientConn,
+	}
+}
+
+func (c *endpointClient) isHealthy(now time.Time) bool {
+	return c.unhealthyUntil.Load() <= now.UnixNano()
+}
+
+// skipUntil marks the endpoint as unhealthy until t, unless it is already marked as unhealthy for longer.
+func (c *endpointClient) skipUntil(t time.Time) {
+	until := t.UnixNano()
+	for {
+		current := c.unhealthyUntil.Load()
+		if current >= until || c.unhealthyUntil.CompareAndSwap(current, until) {
+			return
+		}
+	}
+}
+
+// endpointSelector chooses the order in which the endpoints are tried for each request.
+type endpointSelector struct {
+	clients           []*endpointClient
+	policy            LoadBalancingPolicy
+	retryBudget       int
+	unhealthyDuration time.Duration
+	logger            *zap.Logger
+
+	// next is the index of the endpoint to start from for the round-robin policy.
+	next atomic.Uint64
+}
+
+// order returns the endpoints to try for a request: healthy endpoints first, in the order
+// defined by the policy, followed by the unhealthy ones as a last resort.
+func (s *endpointSelector) order(now time.Time) []*endpointClient {
+	if len(s.clients) == 1 {
+		return s.clients
+	}
+	start := 0
+	if s.policy == LoadBalancingPolicyRoundRobin {
+		start = int((s.next.Add(1) - 1) % uint64(len(s.clients)))
+	}
+	healthy := make([]*endpointClient, 0, len(s.clients))
+	var unhealthy []*endpointClient
+	for i := range s.clients {
+		c := s.clients[(start+i)%len(s.clients)]
+		if c.isHealthy(now) {
+			healthy = append(healthy, c)
+		} else {
+			unhealthy = append(unhealthy, c)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+// export calls fn with the endpoints in the order chosen by the policy, until fn succeeds or fails with
+// a permanent error. A request failing on an endpoint fails over to the next endpoint right away: retrying
+// an endpoint after a delay is left to the retry settings of the exporter, which apply to the error of
+// the last attempt when all endpoints fail.
+//
+// Endpoints failing the number of consecutive requests of the retry budget are marked as unhealthy,
+// and endpoints throttling a request are skipped for the delay requested by the server.
+func (s *endpointSelector) export(ctx context.Context, fn func(*endpointClient) error) error {
+	var lastErr error
+	for _, c := range s.order(time.Now()) {
+		err := fn(c)
+		if err == nil {
+			c.failures.Store(0)
+			c.unhealthyUntil.Store(0)
+			return nil
+		}
+		if consumererror.IsPermanent(err) || ctx.Err() != nil {
+			return err
+		}
+		lastErr = err
+		if len(s.clients) > 1 {
+			s.recordFailure(c, err)
+		}
+	}
+	return lastErr
+}
+
+func (s *endpointSelector) recordFailure(c *endpointClient, err error) {
+	now := time.Now()
+	if delay := throttleDelay(err); delay > 0 {
+		c.skipUntil(now.Add(delay))
+	}
+	if c.failures.Add(1) < int64(s.retryBudget) {
+		return
+	}
+	c.failures.Store(0)
+	c.skipUntil(now.Add(s.unhealthyDuration))
+	s.logger.Warn("Endpoint exhausted its retry budget, marking it as unhealthy",
+		zap.String("endpoint", c.endpoint),
+		zap.Duration("unhealthy_duration", s.unhealthyDuration),
+		zap.Error(err),
+	)
+}
+
+// throttleDelay returns the delay requested by the server in the RetryInfo details of err, if any.
+func throttleDelay(err error) time.Duration {
+	return getThrottleDuration(getRetryInfo(status.Convert(err)))
+}
+
+func (s *endpointSelector) shutdown() error {
+	var errs error
+	for _, c := range s.clients {
+		errs = multierr.Append(errs, c.clientConn.Close())
+	}
+	return errs
+}
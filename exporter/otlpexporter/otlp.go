@@ -9,6 +9,7 @@ import (
 	"runtime"
 	"time"
 
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
@@ -35,14 +36,10 @@ type baseExporter struct {
 	// Input configuration.
 	config *Config
 
-	// gRPC clients and connection.
-	traceExporter   ptraceotlp.GRPCClient
-	metricExporter  pmetricotlp.GRPCClient
-	logExporter     plogotlp.GRPCClient
-	profileExporter pprofileotlp.GRPCClient
-	clientConn      *grpc.ClientConn
-	metadata        metadata.MD
-	callOptions     []grpc.CallOption
+	// gRPC clients and connections, one per endpoint.
+	endpoints   *endpointSelector
+	metadata    metadata.MD
+	callOptions []grpc.CallOption
 
 	settings component.TelemetrySettings
 
@@ -63,13 +60,21 @@ func newExporter(cfg component.Config, set exporter.Settings) *baseExporter {
 // is the only place we get hold of Extensions which are required to construct auth round tripper.
 func (e *baseExporter) start(ctx context.Context, host component.Host) (err error) {
 	agentOpt := configgrpc.WithGrpcDialOption(grpc.WithUserAgent(e.userAgent))
-	if e.clientConn, err = e.config.ClientConfig.ToClientConn(ctx, host, e.settings, agentOpt); err != nil {
-		return err
+	e.endpoints = &endpointSelector{
+		policy:            e.config.LoadBalancing.Policy,
+		retryBudget:       e.config.LoadBalancing.RetryBudget,
+		unhealthyDuration: e.config.LoadBalancing.UnhealthyDuration,
+		logger:            e.settings.Logger,
+	}
+	for _, endpoint := range append([]string{e.config.Endpoint}, e.config.LoadBalancing.Endpoints...) {
+		clientCfg := e.config.ClientConfig
+		clientCfg.Endpoint = endpoint
+		clientConn, connErr := clientCfg.ToClientConn(ctx, host, e.settings, agentOpt)
+		if connErr != nil {
+			return multierr.Append(connErr, e.endpoints.shutdown())
+		}
+		e.endpoints.clients = append(e.endpoints.clients, newEndpointClient(endpoint, clientConn))
 	}
-	e.traceExporter = ptraceotlp.NewGRPCClient(e.clientConn)
-	e.metricExporter = pmetricotlp.NewGRPCClient(e.clientConn)
-	e.logExporter = plogotlp.NewGRPCClient(e.clientConn)
-	e.profileExporter = pprofileotlp.NewGRPCClient(e.clientConn)
 	headers := map[string]string{}
 	for k, v := range e.config.ClientConfig.Headers {
 		headers[k] = string(v)
@@ -83,16 +88,20 @@ func (e *baseExporter) start(ctx context.Context, host component.Host) (err erro
 }
 
 func (e *baseExporter) shutdown(context.Context) error {
-	if e.clientConn != nil {
-		return e.clientConn.Close()
+	if e.endpoints != nil {
+		return e.endpoints.shutdown()
 	}
 	return nil
 }
 
 func (e *baseExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
 	req := ptraceotlp.NewExportRequestFromTraces(td)
-	resp, respErr := e.traceExporter.Export(e.enhanceContext(ctx), req, e.callOptions...)
-	if err := processError(respErr); err != nil {
+	var resp ptraceotlp.ExportResponse
+	if err := e.endpoints.export(ctx, func(c *endpointClient) error {
+		var respErr error
+		resp, respErr = c.traceExporter.Export(e.enhanceContext(ctx), req, e.callOptions...)
+		return processError(respErr)
+	}); err != nil {
 		return err
 	}
 	partialSuccess := resp.PartialSuccess()
@@ -107,8 +116,12 @@ func (e *baseExporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
 
 func (e *baseExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) error {
 	req := pmetricotlp.NewExportRequestFromMetrics(md)
-	resp, respErr := e.metricExporter.Export(e.enhanceContext(ctx), req, e.callOptions...)
-	if err := processError(respErr); err != nil {
+	var resp pmetricotlp.ExportResponse
+	if err := e.endpoints.export(ctx, func(c *endpointClient) error {
+		var respErr error
+		resp, respErr = c.metricExporter.Export(e.enhanceContext(ctx), req, e.callOptions...)
+		return processError(respErr)
+	}); err != nil {
 		return err
 	}
 	partialSuccess := resp.PartialSuccess()
@@ -123,8 +136,12 @@ func (e *baseExporter) pushMetrics(ctx context.Context, md pmetric.Metrics) erro
 
 func (e *baseExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
 	req := plogotlp.NewExportRequestFromLogs(ld)
-	resp, respErr := e.logExporter.Export(e.enhanceContext(ctx), req, e.callOptions...)
-	if err := processError(respErr); err != nil {
+	var resp plogotlp.ExportResponse
+	if err := e.endpoints.export(ctx, func(c *endpointClient) error {
+		var respErr error
+		resp, respErr = c.logExporter.Export(e.enhanceContext(ctx), req, e.callOptions...)
+		return processError(respErr)
+	}); err != nil {
 		return err
 	}
 	partialSuccess := resp.PartialSuccess()
@@ -139,8 +156,12 @@ func (e *baseExporter) pushLogs(ctx context.Context, ld plog.Logs) error {
 
 func (e *baseExporter) pushProfiles(ctx context.Context, td pprofile.Profiles) error {
 	req := pprofileotlp.NewExportRequestFromProfiles(td)
-	resp, respErr := e.profileExporter.Export(e.enhanceContext(ctx), req, e.callOptions...)
-	if err := processError(respErr); err != nil {
+	var resp pprofileotlp.ExportResponse
+	if err := e.endpoints.export(ctx, func(c *endpointClient) error {
+		var respErr error
+		resp, respErr = c.profileExporter.Export(e.enhanceContext(ctx), req, e.callOptions...)
+		return processError(respErr)
+	}); err != nil {
 		return err
 	}
 	partialSuccess := resp.PartialSuccess()
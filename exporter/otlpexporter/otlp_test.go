@@ -29,6 +29,7 @@ import (
 	"go.opentelemetry.io/collector/config/configgrpc"
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/exporter/exporterprofiles"
 	"go.opentelemetry.io/collector/exporter/exportertest"
@@ -389,6 +390,146 @@ func TestSendTraces(t *testing.T) {
 	assert.Contains(t, observed.FilterLevelExact(zap.WarnLevel).All()[0].Message, "Partial success")
 }
 
+func TestSendTracesLoadBalancing(t *testing.T) {
+	primaryLn, err := net.Listen("tcp", "localhost:")
+	require.NoError(t, err)
+	primary, _ := otlpTracesReceiverOnGRPCServer(primaryLn, false)
+	defer primary.srv.GracefulStop()
+	secondaryLn, err := net.Listen("tcp", "localhost:")
+	require.NoError(t, err)
+	secondary, _ := otlpTracesReceiverOnGRPCServer(secondaryLn, false)
+	defer secondary.srv.GracefulStop()
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.QueueConfig.Enabled = false
+	cfg.RetryConfig.Enabled = false
+	cfg.ClientConfig = configgrpc.ClientConfig{
+		Endpoint: primaryLn.Addr().String(),
+		TLSSetting: configtls.ClientConfig{
+			Insecure: true,
+		},
+	}
+	cfg.LoadBalancing.Endpoints = []string{secondaryLn.Addr().String()}
+	cfg.LoadBalancing.RetryBudget = 2
+	exp, err := factory.CreateTraces(context.Background(), exportertest.NewNopSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, exp.Shutdown(context.Background()))
+	}()
+
+	// All data goes to the primary endpoint while it is healthy.
+	require.NoError(t, exp.ConsumeTraces(context.Background(), testdata.GenerateTraces(1)))
+	assert.EqualValues(t, 1, primary.requestCount.Load())
+	assert.EqualValues(t, 0, secondary.requestCount.Load())
+
+	// Requests failing on the primary endpoint fail over to the secondary right away.
+	primary.setExportError(status.Error(codes.Unavailable, "unavailable"))
+	require.NoError(t, exp.ConsumeTraces(context.Background(), testdata.GenerateTraces(1)))
+	assert.EqualValues(t, 2, primary.requestCount.Load())
+	assert.EqualValues(t, 1, secondary.requestCount.Load())
+
+	// The primary endpoint is still tried first until it exhausts its retry budget.
+	require.NoError(t, exp.ConsumeTraces(context.Background(), testdata.GenerateTraces(1)))
+	assert.EqualValues(t, 3, primary.requestCount.Load())
+	assert.EqualValues(t, 2, secondary.requestCount.Load())
+
+	// The primary endpoint is now unhealthy and skipped.
+	require.NoError(t, exp.ConsumeTraces(context.Background(), testdata.GenerateTraces(1)))
+	assert.EqualValues(t, 3, primary.requestCount.Load())
+	assert.EqualValues(t, 3, secondary.requestCount.Load())
+
+	// Permanent errors are not retried on other endpoints.
+	secondary.setExportError(status.Error(codes.InvalidArgument, "invalid"))
+	err = exp.ConsumeTraces(context.Background(), testdata.GenerateTraces(1))
+	require.Error(t, err)
+	assert.True(t, consumererror.IsPermanent(err))
+	assert.EqualValues(t, 3, primary.requestCount.Load())
+	assert.EqualValues(t, 4, secondary.requestCount.Load())
+}
+
+func TestSendTracesLoadBalancingThrottled(t *testing.T) {
+	primaryLn, err := net.Listen("tcp", "localhost:")
+	require.NoError(t, err)
+	primary, _ := otlpTracesReceiverOnGRPCServer(primaryLn, false)
+	defer primary.srv.GracefulStop()
+	secondaryLn, err := net.Listen("tcp", "localhost:")
+	require.NoError(t, err)
+	secondary, _ := otlpTracesReceiverOnGRPCServer(secondaryLn, false)
+	defer secondary.srv.GracefulStop()
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.QueueConfig.Enabled = false
+	cfg.RetryConfig.Enabled = false
+	cfg.ClientConfig = configgrpc.ClientConfig{
+		Endpoint: primaryLn.Addr().String(),
+		TLSSetting: configtls.ClientConfig{
+			Insecure: true,
+		},
+	}
+	cfg.LoadBalancing.Endpoints = []string{secondaryLn.Addr().String()}
+	cfg.LoadBalancing.RetryBudget = 10
+	exp, err := factory.CreateTraces(context.Background(), exportertest.NewNopSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, exp.Shutdown(context.Background()))
+	}()
+
+	st := status.New(codes.ResourceExhausted, "resource exhausted")
+	st, err = st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Hour)})
+	require.NoError(t, err)
+	primary.setExportError(st.Err())
+	require.NoError(t, exp.ConsumeTraces(context.Background(), testdata.GenerateTraces(1)))
+	assert.EqualValues(t, 1, primary.requestCount.Load())
+	assert.EqualValues(t, 1, secondary.requestCount.Load())
+
+	// The throttled endpoint is skipped for the requested delay, within its retry budget.
+	require.NoError(t, exp.ConsumeTraces(context.Background(), testdata.GenerateTraces(1)))
+	assert.EqualValues(t, 1, primary.requestCount.Load())
+	assert.EqualValues(t, 2, secondary.requestCount.Load())
+}
+
+func TestSendTracesRoundRobin(t *testing.T) {
+	var rcvs []*mockTracesReceiver
+	var endpoints []string
+	for i := 0; i < 3; i++ {
+		ln, err := net.Listen("tcp", "localhost:")
+		require.NoError(t, err)
+		rcv, _ := otlpTracesReceiverOnGRPCServer(ln, false)
+		defer rcv.srv.GracefulStop()
+		rcvs = append(rcvs, rcv)
+		endpoints = append(endpoints, ln.Addr().String())
+	}
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.QueueConfig.Enabled = false
+	cfg.ClientConfig = configgrpc.ClientConfig{
+		Endpoint: endpoints[0],
+		TLSSetting: configtls.ClientConfig{
+			Insecure: true,
+		},
+	}
+	cfg.LoadBalancing.Endpoints = endpoints[1:]
+	cfg.LoadBalancing.Policy = LoadBalancingPolicyRoundRobin
+	exp, err := factory.CreateTraces(context.Background(), exportertest.NewNopSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		assert.NoError(t, exp.Shutdown(context.Background()))
+	}()
+
+	for i := 0; i < 6; i++ {
+		require.NoError(t, exp.ConsumeTraces(context.Background(), testdata.GenerateTraces(1)))
+	}
+	for _, rcv := range rcvs {
+		assert.EqualValues(t, 2, rcv.requestCount.Load())
+	}
+}
+
 func TestSendTracesWhenEndpointHasHttpScheme(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfileexporter // import "go.opentelemetry.io/collector/exporter/otlpfileexporter"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configcompression"
+)
+
+const (
+	// FormatJSON writes one OTLP JSON encoded request per line.
+	FormatJSON = "json"
+	// FormatProto writes OTLP protobuf encoded requests, each prefixed with its size
+	// as a 4 bytes big endian unsigned integer.
+	FormatProto = "proto"
+)
+
+// groupByPlaceholder is the part of the path replaced with the value of the resource attribute.
+const groupByPlaceholder = "*"
+
+// Config defines configuration for the file exporter.
+type Config struct {
+	// Path is the path of the file data is written to.
+	Path string `mapstructure:"path"`
+
+	// Format is the format of the written data: json or proto.
+	Format string `mapstructure:"format"`
+
+	// Compression is the algorithm the file is compressed with: gzip or zstd.
+	// The file is not compressed by default.
+	Compression configcompression.Type `mapstructure:"compression"`
+
+	// FlushInterval is the interval at which written data is flushed to the file.
+	// Zero flushes data after every write.
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+
+	// Rotation configures when the file is rotated.
+	Rotation RotationConfig `mapstructure:"rotation"`
+
+	// GroupBy configures writing data to a different file per resource attribute value.
+	GroupBy GroupByConfig `mapstructure:"group_by"`
+}
+
+// RotationConfig configures the rotation of the files. The file is not rotated by default.
+// Rotated files are renamed by inserting the rotation time before the extension, e.g.
+// "traces-2006-01-02T15-04-05.000.jsonl" for "traces.jsonl".
+type RotationConfig struct {
+	// MaxMegabytes is the size, in megabytes, at which the file is rotated. Zero disables
+	// rotation on size.
+	MaxMegabytes int `mapstructure:"max_megabytes"`
+
+	// Interval is the time after which the file is rotated. Zero disables rotation on time.
+	Interval time.Duration `mapstructure:"interval"`
+
+	// MaxBackups is the number of rotated files kept. Zero keeps all the rotated files.
+	MaxBackups int `mapstructure:"max_backups"`
+}
+
+// GroupByConfig configures writing data to a different file per resource attribute value.
+type GroupByConfig struct {
+	// ResourceAttribute is the resource attribute whose value replaces the "*" of the path.
+	// Resources without this attribute are dropped. Disabled by default.
+	ResourceAttribute string `mapstructure:"resource_attribute"`
+
+	// MaxOpenFiles is the maximum number of files kept open. The least recently written
+	// file is closed when the limit is reached.
+	MaxOpenFiles int `mapstructure:"max_open_files"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the exporter configuration is valid.
+func (cfg *Config) Validate() error {
+	var errs error
+	if cfg.Path == "" {
+		errs = errors.Join(errs, errors.New("'path' must be set"))
+	}
+	if cfg.Format != FormatJSON && cfg.Format != FormatProto {
+		errs = errors.Join(errs, fmt.Errorf("unsupported 'format' %q, must be %q or %q", cfg.Format, FormatJSON, FormatProto))
+	}
+	if cfg.Compression.IsCompressed() && cfg.Compression != configcompression.TypeGzip && cfg.Compression != configcompression.TypeZstd {
+		errs = errors.Join(errs, fmt.Errorf("unsupported 'compression' %q, must be %q or %q", cfg.Compression, configcompression.TypeGzip, configcompression.TypeZstd))
+	}
+	if cfg.FlushInterval < 0 {
+		errs = errors.Join(errs, errors.New("'flush_interval' must not be negative"))
+	}
+	if cfg.Rotation.MaxMegabytes < 0 {
+		errs = errors.Join(errs, errors.New("'rotation::max_megabytes' must not be negative"))
+	}
+	if cfg.Rotation.Interval < 0 {
+		errs = errors.Join(errs, errors.New("'rotation::interval' must not be negative"))
+	}
+	if cfg.Rotation.MaxBackups < 0 {
+		errs = errors.Join(errs, errors.New("'rotation::max_backups' must not be negative"))
+	}
+	if cfg.GroupBy.enabled() {
+		if strings.Count(cfg.Path, groupByPlaceholder) != 1 {
+			errs = errors.Join(errs, errors.New("'path' must contain exactly one \"*\" when 'group_by::resource_attribute' is set"))
+		}
+		if cfg.GroupBy.MaxOpenFiles <= 0 {
+			errs = errors.Join(errs, errors.New("'group_by::max_open_files' must be positive"))
+		}
+	}
+	return errs
+}
+
+func (cfg *GroupByConfig) enabled() bool {
+	return cfg.ResourceAttribute != ""
+}
@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfileexporter
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configcompression"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestUnmarshalDefaultConfig(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	require.NoError(t, confmap.New().Unmarshal(&cfg))
+	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
+}
+
+func TestUnmarshalConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	cfg := NewFactory().CreateDefaultConfig()
+	require.NoError(t, cm.Unmarshal(&cfg))
+	require.NoError(t, component.ValidateConfig(cfg))
+	assert.Equal(t, &Config{
+		Path:          "./data/*/traces.jsonl",
+		Format:        FormatProto,
+		Compression:   configcompression.TypeZstd,
+		FlushInterval: 5 * time.Second,
+		Rotation: RotationConfig{
+			MaxMegabytes: 100,
+			Interval:     24 * time.Hour,
+			MaxBackups:   7,
+		},
+		GroupBy: GroupByConfig{
+			ResourceAttribute: "service.name",
+			MaxOpenFiles:      10,
+		},
+	}, cfg)
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		errs   []string
+	}{
+		{
+			name:   "valid",
+			modify: func(*Config) {},
+		},
+		{
+			name:   "no path",
+			modify: func(cfg *Config) { cfg.Path = "" },
+			errs:   []string{"'path' must be set"},
+		},
+		{
+			name:   "unsupported format",
+			modify: func(cfg *Config) { cfg.Format = "text" },
+			errs:   []string{`unsupported 'format' "text", must be "json" or "proto"`},
+		},
+		{
+			name:   "unsupported compression",
+			modify: func(cfg *Config) { cfg.Compression = configcompression.TypeSnappy },
+			errs:   []string{`unsupported 'compression' "snappy", must be "gzip" or "zstd"`},
+		},
+		{
+			name:   "no compression",
+			modify: func(cfg *Config) { cfg.Compression = "none" },
+		},
+		{
+			name: "negative durations and sizes",
+			modify: func(cfg *Config) {
+				cfg.FlushInterval = -1
+				cfg.Rotation = RotationConfig{MaxMegabytes: -1, Interval: -1, MaxBackups: -1}
+			},
+			errs: []string{
+				"'flush_interval' must not be negative",
+				"'rotation::max_megabytes' must not be negative",
+				"'rotation::interval' must not be negative",
+				"'rotation::max_backups' must not be negative",
+			},
+		},
+		{
+			name: "group by",
+			modify: func(cfg *Config) {
+				cfg.Path = "data/*.jsonl"
+				cfg.GroupBy.ResourceAttribute = "service.name"
+			},
+		},
+		{
+			name: "group by without placeholder",
+			modify: func(cfg *Config) {
+				cfg.GroupBy.ResourceAttribute = "service.name"
+				cfg.GroupBy.MaxOpenFiles = 0
+			},
+			errs: []string{
+				`'path' must contain exactly one "*" when 'group_by::resource_attribute' is set`,
+				"'group_by::max_open_files' must be positive",
+			},
+		},
+		{
+			name: "group by with several placeholders",
+			modify: func(cfg *Config) {
+				cfg.Path = "data/*/*.jsonl"
+				cfg.GroupBy.ResourceAttribute = "service.name"
+			},
+			errs: []string{`'path' must contain exactly one "*" when 'group_by::resource_attribute' is set`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig().(*Config)
+			cfg.Path = "data.jsonl"
+			tt.modify(cfg)
+			err := component.ValidateConfig(cfg)
+			if len(tt.errs) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, e := range tt.errs {
+				assert.ErrorContains(t, err, e)
+			}
+		})
+	}
+}
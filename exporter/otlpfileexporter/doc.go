@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package otlpfileexporter exports data to files in the OTLP JSON or protobuf format.
+package otlpfileexporter // import "go.opentelemetry.io/collector/exporter/otlpfileexporter"
@@ -0,0 +1,327 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfileexporter // import "go.opentelemetry.io/collector/exporter/otlpfileexporter"
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// fileExporter writes the data of all the signals to the configured files.
+type fileExporter struct {
+	cfg    *Config
+	logger *zap.Logger
+
+	tracesMarshaler   ptrace.Marshaler
+	metricsMarshaler  pmetric.Marshaler
+	logsMarshaler     plog.Marshaler
+	profilesMarshaler pprofile.Marshaler
+
+	mu sync.Mutex
+	// files are the open files, by path.
+	files map[string]*rotatingFile
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+func newFileExporter(cfg *Config, logger *zap.Logger) *fileExporter {
+	e := &fileExporter{
+		cfg:    cfg,
+		logger: logger,
+		files:  map[string]*rotatingFile{},
+		stopCh: make(chan struct{}),
+	}
+	if cfg.Format == FormatProto {
+		e.tracesMarshaler = &ptrace.ProtoMarshaler{}
+		e.metricsMarshaler = &pmetric.ProtoMarshaler{}
+		e.logsMarshaler = &plog.ProtoMarshaler{}
+		e.profilesMarshaler = &pprofile.ProtoMarshaler{}
+	} else {
+		e.tracesMarshaler = &ptrace.JSONMarshaler{}
+		e.metricsMarshaler = &pmetric.JSONMarshaler{}
+		e.logsMarshaler = &plog.JSONMarshaler{}
+		e.profilesMarshaler = &pprofile.JSONMarshaler{}
+	}
+	return e
+}
+
+func (e *fileExporter) Start(context.Context, component.Host) error {
+	// The file of each group is opened when data is first written to it.
+	if !e.cfg.GroupBy.enabled() {
+		e.mu.Lock()
+		_, err := e.openFile(e.cfg.Path)
+		e.mu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	if e.cfg.FlushInterval > 0 {
+		e.wg.Add(1)
+		go e.flushPeriodically()
+	}
+	return nil
+}
+
+func (e *fileExporter) Shutdown(context.Context) error {
+	close(e.stopCh)
+	e.wg.Wait()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var errs error
+	for path, f := range e.files {
+		errs = errors.Join(errs, f.close())
+		delete(e.files, path)
+	}
+	return errs
+}
+
+func (e *fileExporter) flushPeriodically() {
+	defer e.wg.Done()
+	ticker := time.NewTicker(e.cfg.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-e.stopCh:
+			return
+		case <-ticker.C:
+			e.mu.Lock()
+			for path, f := range e.files {
+				if err := f.flush(); err != nil {
+					e.logger.Error("Failed to flush the file", zap.String("path", path), zap.Error(err))
+				}
+			}
+			e.mu.Unlock()
+		}
+	}
+}
+
+func (e *fileExporter) consumeTraces(_ context.Context, td ptrace.Traces) error {
+	if !e.cfg.GroupBy.enabled() {
+		return e.writeTraces(e.cfg.Path, td)
+	}
+	groups := map[string]ptrace.Traces{}
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		path, ok := e.groupPath(rs.Resource())
+		if !ok {
+			continue
+		}
+		group, ok := groups[path]
+		if !ok {
+			group = ptrace.NewTraces()
+			groups[path] = group
+		}
+		rs.CopyTo(group.ResourceSpans().AppendEmpty())
+	}
+	var errs error
+	for path, group := range groups {
+		errs = errors.Join(errs, e.writeTraces(path, group))
+	}
+	return errs
+}
+
+func (e *fileExporter) writeTraces(path string, td ptrace.Traces) error {
+	buf, err := e.tracesMarshaler.MarshalTraces(td)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	return e.write(path, buf)
+}
+
+func (e *fileExporter) consumeMetrics(_ context.Context, md pmetric.Metrics) error {
+	if !e.cfg.GroupBy.enabled() {
+		return e.writeMetrics(e.cfg.Path, md)
+	}
+	groups := map[string]pmetric.Metrics{}
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		path, ok := e.groupPath(rm.Resource())
+		if !ok {
+			continue
+		}
+		group, ok := groups[path]
+		if !ok {
+			group = pmetric.NewMetrics()
+			groups[path] = group
+		}
+		rm.CopyTo(group.ResourceMetrics().AppendEmpty())
+	}
+	var errs error
+	for path, group := range groups {
+		errs = errors.Join(errs, e.writeMetrics(path, group))
+	}
+	return errs
+}
+
+func (e *fileExporter) writeMetrics(path string, md pmetric.Metrics) error {
+	buf, err := e.metricsMarshaler.MarshalMetrics(md)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	return e.write(path, buf)
+}
+
+func (e *fileExporter) consumeLogs(_ context.Context, ld plog.Logs) error {
+	if !e.cfg.GroupBy.enabled() {
+		return e.writeLogs(e.cfg.Path, ld)
+	}
+	groups := map[string]plog.Logs{}
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		path, ok := e.groupPath(rl.Resource())
+		if !ok {
+			continue
+		}
+		group, ok := groups[path]
+		if !ok {
+			group = plog.NewLogs()
+			groups[path] = group
+		}
+		rl.CopyTo(group.ResourceLogs().AppendEmpty())
+	}
+	var errs error
+	for path, group := range groups {
+		errs = errors.Join(errs, e.writeLogs(path, group))
+	}
+	return errs
+}
+
+func (e *fileExporter) writeLogs(path string, ld plog.Logs) error {
+	buf, err := e.logsMarshaler.MarshalLogs(ld)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	return e.write(path, buf)
+}
+
+func (e *fileExporter) consumeProfiles(_ context.Context, pd pprofile.Profiles) error {
+	if !e.cfg.GroupBy.enabled() {
+		return e.writeProfiles(e.cfg.Path, pd)
+	}
+	groups := map[string]pprofile.Profiles{}
+	for i := 0; i < pd.ResourceProfiles().Len(); i++ {
+		rp := pd.ResourceProfiles().At(i)
+		path, ok := e.groupPath(rp.Resource())
+		if !ok {
+			continue
+		}
+		group, ok := groups[path]
+		if !ok {
+			group = pprofile.NewProfiles()
+			groups[path] = group
+		}
+		rp.CopyTo(group.ResourceProfiles().AppendEmpty())
+	}
+	var errs error
+	for path, group := range groups {
+		errs = errors.Join(errs, e.writeProfiles(path, group))
+	}
+	return errs
+}
+
+func (e *fileExporter) writeProfiles(path string, pd pprofile.Profiles) error {
+	buf, err := e.profilesMarshaler.MarshalProfiles(pd)
+	if err != nil {
+		return consumererror.NewPermanent(err)
+	}
+	return e.write(path, buf)
+}
+
+// groupPath returns the path of the file the data of the resource is written to, or false if
+// the resource does not have a value usable in a path for the configured attribute.
+func (e *fileExporter) groupPath(res pcommon.Resource) (string, bool) {
+	v, ok := res.Attributes().Get(e.cfg.GroupBy.ResourceAttribute)
+	if !ok {
+		e.logger.Debug("Dropping resource without the group by attribute",
+			zap.String("attribute", e.cfg.GroupBy.ResourceAttribute))
+		return "", false
+	}
+	value := v.AsString()
+	// Prevent writing outside of the directory of the configured path.
+	if value == "" || value == "." || value == ".." || strings.ContainsAny(value, `/\`) {
+		e.logger.Debug("Dropping resource with a group by attribute value unusable in a path",
+			zap.String("attribute", e.cfg.GroupBy.ResourceAttribute), zap.String("value", value))
+		return "", false
+	}
+	return strings.Replace(e.cfg.Path, groupByPlaceholder, value, 1), true
+}
+
+// write writes the marshaled request to the file at path, framed according to the format.
+func (e *fileExporter) write(path string, buf []byte) error {
+	var frame []byte
+	if e.cfg.Format == FormatProto {
+		if uint64(len(buf)) > math.MaxUint32 {
+			return consumererror.NewPermanent(errors.New("request too large to be written"))
+		}
+		frame = binary.BigEndian.AppendUint32(make([]byte, 0, 4+len(buf)), uint32(len(buf))) //nolint:gosec // checked above
+		frame = append(frame, buf...)
+	} else {
+		frame = append(buf, '\n')
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	f, err := e.openFile(path)
+	if err != nil {
+		return err
+	}
+	if err = f.write(frame); err != nil {
+		return err
+	}
+	if e.cfg.FlushInterval == 0 {
+		return f.flush()
+	}
+	return nil
+}
+
+// openFile returns the open file at path, opening it if needed. It must be called with mu held.
+func (e *fileExporter) openFile(path string) (*rotatingFile, error) {
+	if f, ok := e.files[path]; ok {
+		return f, nil
+	}
+	if e.cfg.GroupBy.enabled() && len(e.files) >= e.cfg.GroupBy.MaxOpenFiles {
+		e.closeLeastRecentlyWritten()
+	}
+	f, err := openRotatingFile(path, e.cfg.Compression, e.cfg.Rotation)
+	if err != nil {
+		return nil, err
+	}
+	e.files[path] = f
+	return f, nil
+}
+
+// closeLeastRecentlyWritten closes the file written to least recently. It must be called with mu held.
+func (e *fileExporter) closeLeastRecentlyWritten() {
+	var oldestPath string
+	var oldest *rotatingFile
+	for path, f := range e.files {
+		if oldest == nil || f.lastWrite.Before(oldest.lastWrite) {
+			oldestPath, oldest = path, f
+		}
+	}
+	if oldest == nil {
+		return
+	}
+	if err := oldest.close(); err != nil {
+		e.logger.Error("Failed to close the file", zap.String("path", oldestPath), zap.Error(err))
+	}
+	delete(e.files, oldestPath)
+}
@@ -0,0 +1,199 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfileexporter
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/configcompression"
+	"go.opentelemetry.io/collector/exporter/exporterprofiles"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/testdata"
+)
+
+func TestExportAllSignals(t *testing.T) {
+	for _, format := range []string{FormatJSON, FormatProto} {
+		for _, compression := range []configcompression.Type{"", configcompression.TypeGzip, configcompression.TypeZstd} {
+			t.Run(format+"-"+string(compression), func(t *testing.T) {
+				cfg := NewFactory().CreateDefaultConfig().(*Config)
+				cfg.Path = filepath.Join(t.TempDir(), "data")
+				cfg.Format = format
+				cfg.Compression = compression
+
+				td := testdata.GenerateTraces(2)
+				md := testdata.GenerateMetrics(2)
+				ld := testdata.GenerateLogs(2)
+				pd := testdata.GenerateProfiles(2)
+				exportAll(t, cfg, td, md, ld, pd)
+
+				// The exporters of all the signals share the same file.
+				records := readRecords(t, cfg.Path, format, compression)
+				require.Len(t, records, 4)
+				if format == FormatJSON {
+					assertRecords(t, records, &ptrace.JSONUnmarshaler{}, &pmetric.JSONUnmarshaler{}, &plog.JSONUnmarshaler{}, &pprofile.JSONUnmarshaler{}, td, md, ld, pd)
+				} else {
+					assertRecords(t, records, &ptrace.ProtoUnmarshaler{}, &pmetric.ProtoUnmarshaler{}, &plog.ProtoUnmarshaler{}, &pprofile.ProtoUnmarshaler{}, td, md, ld, pd)
+				}
+			})
+		}
+	}
+}
+
+func TestExportGroupBy(t *testing.T) {
+	dir := t.TempDir()
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Path = filepath.Join(dir, "*", "logs.jsonl")
+	cfg.GroupBy.ResourceAttribute = "service.name"
+	// Files are closed and opened again when written to alternately.
+	cfg.GroupBy.MaxOpenFiles = 1
+
+	ld := plog.NewLogs()
+	for _, service := range []string{"a", "b", "a", "", "../escape"} {
+		rl := ld.ResourceLogs().AppendEmpty()
+		if service != "" {
+			rl.Resource().Attributes().PutStr("service.name", service)
+		}
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(service)
+	}
+	ld.ResourceLogs().AppendEmpty().Resource().Attributes().PutInt("service.name", 1)
+
+	exp, err := NewFactory().CreateLogs(context.Background(), exportertest.NewNopSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, exp.ConsumeLogs(context.Background(), ld))
+	require.NoError(t, exp.ConsumeLogs(context.Background(), ld))
+	require.NoError(t, exp.Shutdown(context.Background()))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	assert.ElementsMatch(t, []string{"1", "a", "b"}, names)
+
+	records := readRecords(t, filepath.Join(dir, "a", "logs.jsonl"), FormatJSON, "")
+	require.Len(t, records, 2)
+	for _, record := range records {
+		got, err := (&plog.JSONUnmarshaler{}).UnmarshalLogs(record)
+		require.NoError(t, err)
+		assert.Equal(t, 2, got.ResourceLogs().Len())
+		assert.Equal(t, 2, got.LogRecordCount())
+	}
+	assert.Len(t, readRecords(t, filepath.Join(dir, "b", "logs.jsonl"), FormatJSON, ""), 2)
+}
+
+func TestExportFlushInterval(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Path = filepath.Join(t.TempDir(), "traces.jsonl")
+	cfg.FlushInterval = 10 * time.Millisecond
+
+	exp, err := NewFactory().CreateTraces(context.Background(), exportertest.NewNopSettings(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, exp.ConsumeTraces(context.Background(), testdata.GenerateTraces(1)))
+	assert.Eventually(t, func() bool {
+		data, err := os.ReadFile(cfg.Path)
+		return err == nil && len(data) > 0
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, exp.Shutdown(context.Background()))
+}
+
+func TestExportStartError(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "file"), nil, 0o600))
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Path = filepath.Join(dir, "file", "traces.jsonl")
+
+	exp, err := NewFactory().CreateTraces(context.Background(), exportertest.NewNopSettings(), cfg)
+	require.NoError(t, err)
+	require.Error(t, exp.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, exp.Shutdown(context.Background()))
+}
+
+func exportAll(t *testing.T, cfg *Config, td ptrace.Traces, md pmetric.Metrics, ld plog.Logs, pd pprofile.Profiles) {
+	ctx := context.Background()
+	factory := NewFactory().(exporterprofiles.Factory)
+	set := exportertest.NewNopSettings()
+	te, err := factory.CreateTraces(ctx, set, cfg)
+	require.NoError(t, err)
+	me, err := factory.CreateMetrics(ctx, set, cfg)
+	require.NoError(t, err)
+	le, err := factory.CreateLogs(ctx, set, cfg)
+	require.NoError(t, err)
+	pe, err := factory.CreateProfiles(ctx, set, cfg)
+	require.NoError(t, err)
+
+	host := componenttest.NewNopHost()
+	require.NoError(t, te.Start(ctx, host))
+	require.NoError(t, me.Start(ctx, host))
+	require.NoError(t, le.Start(ctx, host))
+	require.NoError(t, pe.Start(ctx, host))
+	require.NoError(t, te.ConsumeTraces(ctx, td))
+	require.NoError(t, me.ConsumeMetrics(ctx, md))
+	require.NoError(t, le.ConsumeLogs(ctx, ld))
+	require.NoError(t, pe.ConsumeProfiles(ctx, pd))
+	require.NoError(t, te.Shutdown(ctx))
+	require.NoError(t, me.Shutdown(ctx))
+	require.NoError(t, le.Shutdown(ctx))
+	require.NoError(t, pe.Shutdown(ctx))
+}
+
+func assertRecords(t *testing.T, records [][]byte,
+	tu ptrace.Unmarshaler, mu pmetric.Unmarshaler, lu plog.Unmarshaler, pu pprofile.Unmarshaler,
+	td ptrace.Traces, md pmetric.Metrics, ld plog.Logs, pd pprofile.Profiles,
+) {
+	gotTraces, err := tu.UnmarshalTraces(records[0])
+	require.NoError(t, err)
+	assert.Equal(t, td, gotTraces)
+	gotMetrics, err := mu.UnmarshalMetrics(records[1])
+	require.NoError(t, err)
+	assert.Equal(t, md, gotMetrics)
+	gotLogs, err := lu.UnmarshalLogs(records[2])
+	require.NoError(t, err)
+	assert.Equal(t, ld, gotLogs)
+	gotProfiles, err := pu.UnmarshalProfiles(records[3])
+	require.NoError(t, err)
+	assert.Equal(t, pd, gotProfiles)
+}
+
+// readRecords returns the requests written to the file at path.
+func readRecords(t *testing.T, path string, format string, compression configcompression.Type) [][]byte {
+	r := bufio.NewReader(strings.NewReader(readFile(t, path, compression)))
+	var records [][]byte
+	for {
+		var record []byte
+		var err error
+		if format == FormatJSON {
+			record, err = r.ReadBytes('\n')
+		} else {
+			var size uint32
+			if err = binary.Read(r, binary.BigEndian, &size); err == nil {
+				record = make([]byte, size)
+				_, err = io.ReadFull(r, record)
+			}
+		}
+		if err == io.EOF {
+			return records
+		}
+		require.NoError(t, err)
+		records = append(records, record)
+	}
+}
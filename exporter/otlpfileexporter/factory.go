@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfileexporter // import "go.opentelemetry.io/collector/exporter/otlpfileexporter"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/exporter/exporterhelper"
+	"go.opentelemetry.io/collector/exporter/exporterhelper/exporterhelperprofiles"
+	"go.opentelemetry.io/collector/exporter/exporterprofiles"
+	"go.opentelemetry.io/collector/exporter/otlpfileexporter/internal/metadata"
+	"go.opentelemetry.io/collector/internal/sharedcomponent"
+)
+
+const (
+	defaultFlushInterval = time.Second
+	defaultMaxOpenFiles  = 100
+)
+
+// NewFactory creates a factory for the file exporter.
+func NewFactory() exporter.Factory {
+	return exporterprofiles.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		exporterprofiles.WithTraces(createTraces, metadata.TracesStability),
+		exporterprofiles.WithMetrics(createMetrics, metadata.MetricsStability),
+		exporterprofiles.WithLogs(createLogs, metadata.LogsStability),
+		exporterprofiles.WithProfiles(createProfiles, metadata.ProfilesStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Format:        FormatJSON,
+		FlushInterval: defaultFlushInterval,
+		GroupBy: GroupByConfig{
+			MaxOpenFiles: defaultMaxOpenFiles,
+		},
+	}
+}
+
+func createTraces(ctx context.Context, set exporter.Settings, cfg component.Config) (exporter.Traces, error) {
+	fe := loadOrStore(cfg.(*Config), set)
+	return exporterhelper.NewTraces(ctx, set, cfg,
+		fe.Unwrap().consumeTraces,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithTimeout(exporterhelper.TimeoutConfig{Timeout: 0}),
+		exporterhelper.WithStart(fe.Start),
+		exporterhelper.WithShutdown(fe.Shutdown),
+	)
+}
+
+func createMetrics(ctx context.Context, set exporter.Settings, cfg component.Config) (exporter.Metrics, error) {
+	fe := loadOrStore(cfg.(*Config), set)
+	return exporterhelper.NewMetrics(ctx, set, cfg,
+		fe.Unwrap().consumeMetrics,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithTimeout(exporterhelper.TimeoutConfig{Timeout: 0}),
+		exporterhelper.WithStart(fe.Start),
+		exporterhelper.WithShutdown(fe.Shutdown),
+	)
+}
+
+func createLogs(ctx context.Context, set exporter.Settings, cfg component.Config) (exporter.Logs, error) {
+	fe := loadOrStore(cfg.(*Config), set)
+	return exporterhelper.NewLogs(ctx, set, cfg,
+		fe.Unwrap().consumeLogs,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithTimeout(exporterhelper.TimeoutConfig{Timeout: 0}),
+		exporterhelper.WithStart(fe.Start),
+		exporterhelper.WithShutdown(fe.Shutdown),
+	)
+}
+
+func createProfiles(ctx context.Context, set exporter.Settings, cfg component.Config) (exporterprofiles.Profiles, error) {
+	fe := loadOrStore(cfg.(*Config), set)
+	return exporterhelperprofiles.NewProfilesExporter(ctx, set, cfg,
+		fe.Unwrap().consumeProfiles,
+		exporterhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}),
+		exporterhelper.WithTimeout(exporterhelper.TimeoutConfig{Timeout: 0}),
+		exporterhelper.WithStart(fe.Start),
+		exporterhelper.WithShutdown(fe.Shutdown),
+	)
+}
+
+// loadOrStore returns the exporter of the configuration, shared by the pipelines of all the
+// signals so that they write to the same files.
+func loadOrStore(cfg *Config, set exporter.Settings) *sharedcomponent.Component[*fileExporter] {
+	fe, _ := exporters.LoadOrStore(cfg, func() (*fileExporter, error) {
+		return newFileExporter(cfg, set.Logger), nil
+	})
+	return fe
+}
+
+// exporters holds the file exporters by configuration. This is needed because the exporter
+// helper creates an exporter per signal, while the files must only be opened once.
+var exporters = sharedcomponent.NewMap[*Config, *fileExporter]()
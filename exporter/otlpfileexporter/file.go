@@ -0,0 +1,203 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfileexporter // import "go.opentelemetry.io/collector/exporter/otlpfileexporter"
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+
+	"go.opentelemetry.io/collector/config/configcompression"
+)
+
+// backupTimeFormat is the format of the rotation time inserted in the name of rotated files.
+const backupTimeFormat = "2006-01-02T15-04-05.000"
+
+// rotatingFile writes data to a file, optionally compressed, and rotates it according to the
+// rotation configuration. It is not safe for concurrent use.
+type rotatingFile struct {
+	path        string
+	compression configcompression.Type
+	rotation    RotationConfig
+
+	file *os.File
+	buf  *bufio.Writer
+	// w writes data to buf, counting its size.
+	w io.Writer
+	// compressor compresses the data written to w, nil if the file is not compressed.
+	compressor io.WriteCloser
+	// size is the size of the file, including the data not flushed yet.
+	size     int64
+	openedAt time.Time
+	// lastWrite is the time of the last write, used to close the least recently written files.
+	lastWrite time.Time
+}
+
+func openRotatingFile(path string, compression configcompression.Type, rotation RotationConfig) (*rotatingFile, error) {
+	f := &rotatingFile{
+		path:        path,
+		compression: compression,
+		rotation:    rotation,
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *rotatingFile) open() error {
+	if err := os.MkdirAll(filepath.Dir(f.path), 0o755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return errors.Join(err, file.Close())
+	}
+
+	f.file = file
+	f.buf = bufio.NewWriter(file)
+	f.w = &countingWriter{w: f.buf, n: &f.size}
+	f.size = info.Size()
+	f.openedAt = time.Now()
+	// Appending to an existing compressed file starts a new gzip member or zstd frame,
+	// which decompressors read as a continuation of the previous ones.
+	switch f.compression {
+	case configcompression.TypeGzip:
+		f.compressor = gzip.NewWriter(f.w)
+	case configcompression.TypeZstd:
+		if f.compressor, err = zstd.NewWriter(f.w, zstd.WithEncoderConcurrency(1)); err != nil {
+			return errors.Join(err, file.Close())
+		}
+	}
+	return nil
+}
+
+// write writes data to the file, after rotating it if needed.
+func (f *rotatingFile) write(data []byte) error {
+	if f.shouldRotate(len(data)) {
+		if err := f.rotate(); err != nil {
+			return err
+		}
+	}
+	f.lastWrite = time.Now()
+	var err error
+	if f.compressor != nil {
+		_, err = f.compressor.Write(data)
+	} else {
+		_, err = f.w.Write(data)
+	}
+	return err
+}
+
+// shouldRotate returns whether the file must be rotated before writing n more bytes. An empty
+// file is never rotated, so that data larger than the maximum size is still written.
+func (f *rotatingFile) shouldRotate(n int) bool {
+	if f.size == 0 {
+		return false
+	}
+	// The size of the compressed data is not known before it is written, and the uncompressed
+	// size is used instead: compressed files are rotated a little early rather than late.
+	if f.rotation.MaxMegabytes > 0 && f.size+int64(n) > int64(f.rotation.MaxMegabytes)*1024*1024 {
+		return true
+	}
+	return f.rotation.Interval > 0 && time.Since(f.openedAt) >= f.rotation.Interval
+}
+
+// rotate closes the file, renames it with the current time and opens a new file.
+func (f *rotatingFile) rotate() error {
+	if err := f.close(); err != nil {
+		return err
+	}
+	if err := os.Rename(f.path, f.backupPath(time.Now())); err != nil {
+		return err
+	}
+	if err := f.removeOldBackups(); err != nil {
+		return err
+	}
+	return f.open()
+}
+
+// backupPath returns the path the file is renamed to when rotated at the given time.
+func (f *rotatingFile) backupPath(t time.Time) string {
+	ext := filepath.Ext(f.path)
+	return strings.TrimSuffix(f.path, ext) + "-" + t.UTC().Format(backupTimeFormat) + ext
+}
+
+// removeOldBackups removes the oldest rotated files to keep at most the configured number.
+func (f *rotatingFile) removeOldBackups() error {
+	if f.rotation.MaxBackups == 0 {
+		return nil
+	}
+	dir := filepath.Dir(f.path)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	ext := filepath.Ext(f.path)
+	prefix := strings.TrimSuffix(filepath.Base(f.path), ext) + "-"
+	// Only consider the files named like rotated files, whose names sort by rotation time.
+	var backups []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, ext) {
+			continue
+		}
+		if _, err = time.Parse(backupTimeFormat, strings.TrimSuffix(strings.TrimPrefix(name, prefix), ext)); err == nil {
+			backups = append(backups, name)
+		}
+	}
+	if len(backups) <= f.rotation.MaxBackups {
+		return nil
+	}
+	slices.Sort(backups)
+	var errs error
+	for _, name := range backups[:len(backups)-f.rotation.MaxBackups] {
+		errs = errors.Join(errs, os.Remove(filepath.Join(dir, name)))
+	}
+	return errs
+}
+
+// flush writes the buffered data to the file.
+func (f *rotatingFile) flush() error {
+	if flusher, ok := f.compressor.(interface{ Flush() error }); ok {
+		if err := flusher.Flush(); err != nil {
+			return err
+		}
+	}
+	return f.buf.Flush()
+}
+
+// close flushes the buffered data, ends the compressed stream and closes the file.
+func (f *rotatingFile) close() error {
+	var errs error
+	if f.compressor != nil {
+		errs = errors.Join(errs, f.compressor.Close())
+	}
+	errs = errors.Join(errs, f.buf.Flush())
+	return errors.Join(errs, f.file.Close())
+}
+
+// countingWriter counts the bytes written to the underlying writer.
+type countingWriter struct {
+	w io.Writer
+	n *int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	*cw.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfileexporter
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/config/configcompression"
+)
+
+func TestRotatingFileWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dir", "data.jsonl")
+	f, err := openRotatingFile(path, "", RotationConfig{})
+	require.NoError(t, err)
+	require.NoError(t, f.write([]byte("first\n")))
+	assert.Equal(t, "", readFile(t, path, ""), "data is buffered")
+	require.NoError(t, f.flush())
+	assert.Equal(t, "first\n", readFile(t, path, ""))
+	require.NoError(t, f.write([]byte("second\n")))
+	require.NoError(t, f.close())
+	assert.Equal(t, "first\nsecond\n", readFile(t, path, ""))
+
+	// Data is appended to existing files.
+	f, err = openRotatingFile(path, "", RotationConfig{})
+	require.NoError(t, err)
+	assert.EqualValues(t, 13, f.size)
+	require.NoError(t, f.write([]byte("third\n")))
+	require.NoError(t, f.close())
+	assert.Equal(t, "first\nsecond\nthird\n", readFile(t, path, ""))
+}
+
+func TestRotatingFileCompression(t *testing.T) {
+	for _, compression := range []configcompression.Type{configcompression.TypeGzip, configcompression.TypeZstd} {
+		t.Run(string(compression), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "data.jsonl")
+			for _, data := range []string{"first\n", "second\n"} {
+				f, err := openRotatingFile(path, compression, RotationConfig{})
+				require.NoError(t, err)
+				require.NoError(t, f.write([]byte(data)))
+				require.NoError(t, f.flush())
+				require.NoError(t, f.close())
+			}
+			// Appending to the file starts a new compressed stream.
+			assert.Equal(t, "first\nsecond\n", readFile(t, path, compression))
+		})
+	}
+}
+
+func TestRotatingFileRotateOnSize(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data.jsonl")
+	f, err := openRotatingFile(path, "", RotationConfig{MaxMegabytes: 1})
+	require.NoError(t, err)
+	line := strings.Repeat("x", 400*1024-1) + "\n"
+	for i := 0; i < 3; i++ {
+		require.NoError(t, f.write([]byte(line)))
+	}
+	require.NoError(t, f.close())
+
+	backups := listBackups(t, dir)
+	require.Len(t, backups, 1)
+	assert.Equal(t, strings.Repeat(line, 2), readFile(t, filepath.Join(dir, backups[0]), ""))
+	assert.Equal(t, line, readFile(t, path, ""))
+}
+
+func TestRotatingFileRotateLargeWrite(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data.jsonl")
+	f, err := openRotatingFile(path, "", RotationConfig{MaxMegabytes: 1})
+	require.NoError(t, err)
+	// Data larger than the maximum size is written to an empty file rather than rotated forever.
+	line := strings.Repeat("x", 2*1024*1024)
+	require.NoError(t, f.write([]byte(line)))
+	require.NoError(t, f.close())
+	assert.Empty(t, listBackups(t, dir))
+	assert.Equal(t, line, readFile(t, path, ""))
+}
+
+func TestRotatingFileRotateOnInterval(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data.jsonl")
+	f, err := openRotatingFile(path, configcompression.TypeGzip, RotationConfig{Interval: time.Hour})
+	require.NoError(t, err)
+	require.NoError(t, f.write([]byte("first\n")))
+	require.NoError(t, f.write([]byte("second\n")))
+	assert.Empty(t, listBackups(t, dir))
+
+	f.openedAt = time.Now().Add(-time.Hour)
+	require.NoError(t, f.write([]byte("third\n")))
+	require.NoError(t, f.close())
+
+	backups := listBackups(t, dir)
+	require.Len(t, backups, 1)
+	assert.Equal(t, "first\nsecond\n", readFile(t, filepath.Join(dir, backups[0]), configcompression.TypeGzip))
+	assert.Equal(t, "third\n", readFile(t, path, configcompression.TypeGzip))
+}
+
+func TestRotatingFileMaxBackups(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data.jsonl")
+	// Files named like rotated files are removed with them, the others are kept.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "data-other.jsonl"), nil, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "data-2006-01-02T15-04-05.000.jsonl"), nil, 0o600))
+
+	f, err := openRotatingFile(path, "", RotationConfig{Interval: time.Hour, MaxBackups: 2})
+	require.NoError(t, err)
+	for i := 0; i < 4; i++ {
+		f.openedAt = time.Now().Add(-time.Hour)
+		require.NoError(t, f.write([]byte{'0' + byte(i)}))
+		// Rotated files are named with millisecond precision.
+		time.Sleep(2 * time.Millisecond)
+	}
+	require.NoError(t, f.close())
+
+	backups := listBackups(t, dir)
+	require.Len(t, backups, 2)
+	assert.Equal(t, "1", readFile(t, filepath.Join(dir, backups[0]), ""))
+	assert.Equal(t, "2", readFile(t, filepath.Join(dir, backups[1]), ""))
+	assert.Equal(t, "3", readFile(t, path, ""))
+	assert.NoFileExists(t, filepath.Join(dir, "data-2006-01-02T15-04-05.000.jsonl"))
+	assert.FileExists(t, filepath.Join(dir, "data-other.jsonl"))
+}
+
+func TestRotatingFileBackupPath(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 6e6, time.UTC)
+	f := &rotatingFile{path: filepath.Join("dir", "data.jsonl")}
+	assert.Equal(t, filepath.Join("dir", "data-2024-01-02T03-04-05.006.jsonl"), f.backupPath(ts))
+	f = &rotatingFile{path: filepath.Join("dir", "data")}
+	assert.Equal(t, filepath.Join("dir", "data-2024-01-02T03-04-05.006"), f.backupPath(ts))
+}
+
+func TestRotatingFileOpenError(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "file"), nil, 0o600))
+	_, err := openRotatingFile(filepath.Join(dir, "file", "data.jsonl"), "", RotationConfig{})
+	require.Error(t, err)
+}
+
+// listBackups returns the names of the rotated files in dir, oldest first.
+func listBackups(t *testing.T, dir string) []string {
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var backups []string
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), "data-2") && !strings.HasPrefix(entry.Name(), "data-2006") {
+			backups = append(backups, entry.Name())
+		}
+	}
+	return backups
+}
+
+func readFile(t *testing.T, path string, compression configcompression.Type) string {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	var r io.Reader = file
+	switch compression {
+	case configcompression.TypeGzip:
+		gr, err := gzip.NewReader(file)
+		require.NoError(t, err)
+		r = gr
+	case configcompression.TypeZstd:
+		zr, err := zstd.NewReader(file)
+		require.NoError(t, err)
+		defer zr.Close()
+		r = zr
+	}
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return string(data)
+}
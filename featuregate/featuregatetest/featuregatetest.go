@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package featuregatetest provides helpers to test the lifecycle of feature gates.
+package featuregatetest // import "go.opentelemetry.io/collector/featuregate/featuregatetest"
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+// AssertNotExpired fails the test if any of the given gates is past its "ToVersion" for the given
+// Collector version, meaning it should have been removed. It returns true if no gate is expired.
+func AssertNotExpired(tb testing.TB, currentVersion string, gates ...*featuregate.Gate) bool {
+	tb.Helper()
+	ok := true
+	for _, g := range gates {
+		expired, err := g.IsExpired(currentVersion)
+		if err != nil {
+			tb.Errorf("feature gate %q: %v", g.ID(), err)
+			ok = false
+			continue
+		}
+		if expired {
+			tb.Errorf("feature gate %q should have been removed: version %s is past its removal version %s", g.ID(), currentVersion, g.ToVersion())
+			ok = false
+		}
+	}
+	return ok
+}
+
+// AssertNoExpiredGates fails the test if any of the gates registered in the Registry is past its
+// "ToVersion" for the given Collector version. It returns true if no gate is expired.
+func AssertNoExpiredGates(tb testing.TB, reg *featuregate.Registry, currentVersion string) bool {
+	tb.Helper()
+	var gates []*featuregate.Gate
+	reg.VisitAll(func(g *featuregate.Gate) {
+		gates = append(gates, g)
+	})
+	return AssertNotExpired(tb, currentVersion, gates...)
+}
@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package featuregatetest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+// recordingTB records the errors reported by the helpers instead of failing the test.
+type recordingTB struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertNoExpiredGates(t *testing.T) {
+	reg := featuregate.NewRegistry()
+	reg.MustRegister("alpha", featuregate.StageAlpha)
+	stable := reg.MustRegister("stable", featuregate.StageStable, featuregate.WithRegisterToVersion("v0.115.0"))
+
+	tb := &recordingTB{TB: t}
+	assert.True(t, AssertNoExpiredGates(tb, reg, "v0.115.0"))
+	assert.Empty(t, tb.errors)
+
+	assert.False(t, AssertNoExpiredGates(tb, reg, "v0.116.0"))
+	assert.Equal(t, []string{`feature gate "stable" should have been removed: version v0.116.0 is past its removal version v0.115.0`}, tb.errors)
+
+	tb = &recordingTB{TB: t}
+	assert.False(t, AssertNotExpired(tb, "latest", stable))
+	assert.Len(t, tb.errors, 1)
+}
@@ -16,6 +16,7 @@ type Gate struct {
 	id           string
 	description  string
 	referenceURL string
+	component    string
 	fromVersion  *version.Version
 	toVersion    *version.Version
 	stage        Stage
@@ -47,6 +48,12 @@ func (g *Gate) ReferenceURL() string {
 	return g.referenceURL
 }
 
+// Component returns the component owning the Gate, in the "<kind>/<type>" format, e.g. "receiver/otlp".
+// It is empty if the Gate is not owned by a component.
+func (g *Gate) Component() string {
+	return g.component
+}
+
 // FromVersion returns the version information when the Gate's was added.
 func (g *Gate) FromVersion() string {
 	return fmt.Sprintf("v%s", g.fromVersion)
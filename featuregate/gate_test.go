@@ -26,6 +26,7 @@ func TestGate(t *testing.T) {
 		enabled:      enabled,
 		stage:        StageAlpha,
 		referenceURL: "http://example.com",
+		component:    "receiver/otlp",
 		fromVersion:  from,
 		toVersion:    to,
 	}
@@ -35,6 +36,7 @@ func TestGate(t *testing.T) {
 	assert.True(t, g.IsEnabled())
 	assert.Equal(t, StageAlpha, g.Stage())
 	assert.Equal(t, "http://example.com", g.ReferenceURL())
+	assert.Equal(t, "receiver/otlp", g.Component())
 	assert.Equal(t, "v0.61.0", g.FromVersion())
 	assert.Equal(t, "v0.64.0", g.ToVersion())
 }
@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package featuregate // import "go.opentelemetry.io/collector/featuregate"
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-version"
+)
+
+// IsExpired returns true if the given Collector version is past the "ToVersion" of the Gate,
+// meaning the Gate should have been removed. Only major and minor versions are compared, so patch
+// and pre-releases of the "ToVersion" release are not past it. Gates without "ToVersion" never expire.
+// currentVersion must be a valid version string, see WithRegisterToVersion.
+func (g *Gate) IsExpired(currentVersion string) (bool, error) {
+	current, err := version.NewVersion(currentVersion)
+	if err != nil {
+		return false, fmt.Errorf("invalid version %q: %w", currentVersion, err)
+	}
+	return g.isExpired(current), nil
+}
+
+func (g *Gate) isExpired(current *version.Version) bool {
+	if g.toVersion == nil {
+		return false
+	}
+	to, cur := g.toVersion.Segments(), current.Segments()
+	return cur[0] > to[0] || (cur[0] == to[0] && cur[1] > to[1])
+}
+
+// age returns the number of minor releases between the "FromVersion" of the Gate and the given version.
+// It returns false if the "FromVersion" is not set or if the major versions are different.
+func (g *Gate) age(current *version.Version) (int, bool) {
+	if g.fromVersion == nil {
+		return 0, false
+	}
+	from, cur := g.fromVersion.Segments(), current.Segments()
+	if from[0] != cur[0] || cur[1] < from[1] {
+		return 0, false
+	}
+	return cur[1] - from[1], true
+}
+
+// ExpiredGates returns the gates past their "ToVersion" for the given Collector version, in lexicographical order.
+// currentVersion must be a valid version string, see WithRegisterToVersion.
+func (r *Registry) ExpiredGates(currentVersion string) ([]*Gate, error) {
+	current, err := version.NewVersion(currentVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %q: %w", currentVersion, err)
+	}
+	var expired []*Gate
+	r.VisitAll(func(g *Gate) {
+		if g.isExpired(current) {
+			expired = append(expired, g)
+		}
+	})
+	return expired, nil
+}
+
+// GateReport describes the state and the lifecycle of a Gate for a Collector version.
+type GateReport struct {
+	ID           string `json:"id"`
+	Stage        string `json:"stage"`
+	Enabled      bool   `json:"enabled"`
+	Description  string `json:"description,omitempty"`
+	Component    string `json:"component,omitempty"`
+	ReferenceURL string `json:"reference_url,omitempty"`
+	FromVersion  string `json:"from_version,omitempty"`
+	ToVersion    string `json:"to_version,omitempty"`
+	// Age is the number of minor releases since the Gate was added, if known.
+	Age *int `json:"age,omitempty"`
+	// Expired is true if the Collector version is past the "ToVersion" of the Gate.
+	Expired bool `json:"expired"`
+}
+
+// Report returns a report of all the gates for the given Collector version, in lexicographical order.
+// If currentVersion is not a valid version string, e.g. for development builds, the age and
+// expiration of the gates are not reported.
+func (r *Registry) Report(currentVersion string) []GateReport {
+	current, _ := version.NewVersion(currentVersion)
+	var reports []GateReport
+	r.VisitAll(func(g *Gate) {
+		report := GateReport{
+			ID:           g.id,
+			Stage:        g.stage.String(),
+			Enabled:      g.IsEnabled(),
+			Description:  g.description,
+			Component:    g.component,
+			ReferenceURL: g.referenceURL,
+		}
+		if g.fromVersion != nil {
+			report.FromVersion = g.FromVersion()
+		}
+		if g.toVersion != nil {
+			report.ToVersion = g.ToVersion()
+		}
+		if current != nil {
+			if age, ok := g.age(current); ok {
+				report.Age = &age
+			}
+			report.Expired = g.isExpired(current)
+		}
+		reports = append(reports, report)
+	})
+	return reports
+}
@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package featuregate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLifecycleRegistry() *Registry {
+	r := NewRegistry()
+	r.MustRegister("alpha", StageAlpha, WithRegisterFromVersion("v0.110.0"))
+	r.MustRegister("stable", StageStable, WithRegisterFromVersion("v0.100.0"), WithRegisterToVersion("v0.115.0"),
+		WithRegisterComponent("receiver/otlp"))
+	r.MustRegister("deprecated", StageDeprecated, WithRegisterToVersion("v0.120.0"))
+	return r
+}
+
+func TestGateIsExpired(t *testing.T) {
+	r := newLifecycleRegistry()
+	var gates []*Gate
+	r.VisitAll(func(g *Gate) { gates = append(gates, g) })
+	alpha, deprecated, stable := gates[0], gates[1], gates[2]
+
+	for _, tc := range []struct {
+		version string
+		gate    *Gate
+		expired bool
+	}{
+		{version: "v0.200.0", gate: alpha, expired: false},
+		{version: "v0.115.0", gate: stable, expired: false},
+		{version: "v0.115.1", gate: stable, expired: false},
+		{version: "0.116.0-dev", gate: stable, expired: true},
+		{version: "v0.116.0", gate: stable, expired: true},
+		{version: "v1.0.0", gate: deprecated, expired: true},
+	} {
+		t.Run(tc.gate.ID()+"/"+tc.version, func(t *testing.T) {
+			expired, err := tc.gate.IsExpired(tc.version)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expired, expired)
+		})
+	}
+
+	_, err := alpha.IsExpired("latest")
+	assert.Error(t, err)
+}
+
+func TestRegistryExpiredGates(t *testing.T) {
+	r := newLifecycleRegistry()
+
+	expired, err := r.ExpiredGates("v0.110.0")
+	require.NoError(t, err)
+	assert.Empty(t, expired)
+
+	expired, err = r.ExpiredGates("v0.121.0")
+	require.NoError(t, err)
+	require.Len(t, expired, 2)
+	assert.Equal(t, "deprecated", expired[0].ID())
+	assert.Equal(t, "stable", expired[1].ID())
+
+	_, err = r.ExpiredGates("invalid")
+	assert.Error(t, err)
+}
+
+func TestRegistryReport(t *testing.T) {
+	r := newLifecycleRegistry()
+	age := func(i int) *int { return &i }
+
+	assert.Equal(t, []GateReport{
+		{ID: "alpha", Stage: "Alpha", FromVersion: "v0.110.0", Age: age(8)},
+		{ID: "deprecated", Stage: "Deprecated", ToVersion: "v0.120.0"},
+		{
+			ID: "stable", Stage: "Stable", Enabled: true, Component: "receiver/otlp",
+			FromVersion: "v0.100.0", ToVersion: "v0.115.0", Age: age(18), Expired: true,
+		},
+	}, r.Report("v0.118.0"))
+
+	// Ages and expiration are not known for development versions.
+	reports := r.Report("latest")
+	require.Len(t, reports, 3)
+	for _, report := range reports {
+		assert.Nil(t, report.Age)
+		assert.False(t, report.Expired)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"regexp"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 
@@ -74,6 +75,18 @@ func WithRegisterReferenceURL(referenceURL string) RegisterOption {
 	})
 }
 
+// WithRegisterComponent sets the component owning the Gate, used to report the gates of each component.
+// component must be in the "<kind>/<type>" format, e.g. "receiver/otlp".
+func WithRegisterComponent(component string) RegisterOption {
+	return registerOptionFunc(func(g *Gate) error {
+		if kind, typ, ok := strings.Cut(component, "/"); !ok || kind == "" || typ == "" {
+			return fmt.Errorf("WithRegisterComponent: invalid component %q, expected \"<kind>/<type>\"", component)
+		}
+		g.component = component
+		return nil
+	})
+}
+
 // WithRegisterFromVersion is used to set the Gate "FromVersion".
 // The "FromVersion" contains the Collector release when a feature is introduced.
 // fromVersion must be a valid version string: it may start with 'v' and must be in the format Major.Minor.Patch[-PreRelease].
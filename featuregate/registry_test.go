@@ -90,6 +90,7 @@ func TestRegisterGateLifecycle(t *testing.T) {
 			opts: []RegisterOption{
 				WithRegisterDescription("test.gate"),
 				WithRegisterReferenceURL("http://example.com/issue/1"),
+				WithRegisterComponent("receiver/otlp"),
 				WithRegisterToVersion("v0.88.0"),
 			},
 			enabled:   false,
@@ -179,6 +180,13 @@ func TestRegisterGateLifecycle(t *testing.T) {
 			opts:      []RegisterOption{WithRegisterReferenceURL(":invalid-url")},
 			shouldErr: true,
 		},
+		{
+			name:      "Invalid gate component",
+			id:        "invalid.gate.component",
+			stage:     StageAlpha,
+			opts:      []RegisterOption{WithRegisterComponent("otlp")},
+			shouldErr: true,
+		},
 		{
 			name:  "Empty version range",
 			id:    "invalid.gate.version.range",
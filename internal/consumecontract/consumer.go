@@ -0,0 +1,199 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package consumecontract // import "go.opentelemetry.io/collector/internal/consumecontract"
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"sync"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// T is the subset of *testing.T used by the checks, so that their own tests can
+// observe the failures they report.
+type T interface {
+	require.TestingT
+	Helper()
+	Logf(format string, args ...any)
+}
+
+// DecisionFunc decides whether the consumer accepts the elements with the given ids,
+// or returns an error to the caller.
+type DecisionFunc func(ids IDSet) error
+
+// Scenario is a test scenario, defined by the decisions of the consumer.
+type Scenario struct {
+	Name     string
+	Decision DecisionFunc
+}
+
+// Scenarios are the scenarios the contract is checked against: on success, on permanent
+// and non-permanent errors and mix of error types.
+var Scenarios = []Scenario{
+	{
+		Name: "always_succeed",
+		// Always succeed. We expect all data to be delivered as is.
+		Decision: func(IDSet) error { return nil },
+	},
+	{
+		Name:     "random_non_permanent_error",
+		Decision: randomNonPermanentErrorConsumeDecision,
+	},
+	{
+		Name:     "random_permanent_error",
+		Decision: randomPermanentErrorConsumeDecision,
+	},
+	{
+		Name:     "random_error",
+		Decision: randomErrorsConsumeDecision,
+	},
+}
+
+var errNonPermanent = errors.New("non permanent error")
+var errPermanent = errors.New("permanent error")
+
+// randomNonPermanentErrorConsumeDecision is a decision function that succeeds approximately
+// half of the time and fails with a non-permanent error the rest of the time.
+func randomNonPermanentErrorConsumeDecision(IDSet) error {
+	if rand.Float32() < 0.5 {
+		return errNonPermanent
+	}
+	return nil
+}
+
+// randomPermanentErrorConsumeDecision is a decision function that succeeds approximately
+// half of the time and fails with a permanent error the rest of the time.
+func randomPermanentErrorConsumeDecision(IDSet) error {
+	if rand.Float32() < 0.5 {
+		return consumererror.NewPermanent(errPermanent)
+	}
+	return nil
+}
+
+// randomErrorsConsumeDecision is a decision function that succeeds approximately
+// a third of the time, fails with a permanent error the third of the time and fails with
+// a non-permanent error the rest of the time.
+func randomErrorsConsumeDecision(IDSet) error {
+	r := rand.Float64()
+	third := 1.0 / 3.0
+	if r < third {
+		return consumererror.NewPermanent(errPermanent)
+	}
+	if r < 2*third {
+		return errNonPermanent
+	}
+	return nil
+}
+
+// MockConsumer accepts or drops the data from the component under test based on the decision
+// made by its DecisionFunc and remembers the accepted and dropped data sets for later checks.
+// MockConsumer implements all 4 consume functions: ConsumeLogs/ConsumeTraces/ConsumeMetrics/ConsumeProfiles
+// and can be used for testing any of the 4 signals.
+type MockConsumer struct {
+	t                    T
+	decision             DecisionFunc
+	mux                  sync.Mutex
+	acceptedIDs          IDSet
+	droppedIDs           IDSet
+	nonPermanentFailures int
+}
+
+// NewMockConsumer creates a MockConsumer taking the given decisions, and reporting
+// the elements received twice as failures of t.
+func NewMockConsumer(t T, decision DecisionFunc) *MockConsumer {
+	return &MockConsumer{t: t, decision: decision, acceptedIDs: IDSet{}, droppedIDs: IDSet{}}
+}
+
+func (m *MockConsumer) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{}
+}
+
+func (m *MockConsumer) ConsumeTraces(_ context.Context, data ptrace.Traces) error {
+	ids, err := IDSetFromTraces(data)
+	require.NoError(m.t, err)
+	return m.consume(ids)
+}
+
+func (m *MockConsumer) ConsumeMetrics(_ context.Context, data pmetric.Metrics) error {
+	ids, err := IDSetFromMetrics(data)
+	require.NoError(m.t, err)
+	return m.consume(ids)
+}
+
+func (m *MockConsumer) ConsumeLogs(_ context.Context, data plog.Logs) error {
+	ids, err := IDSetFromLogs(data)
+	require.NoError(m.t, err)
+	return m.consume(ids)
+}
+
+func (m *MockConsumer) ConsumeProfiles(_ context.Context, data pprofile.Profiles) error {
+	ids, err := IDSetFromProfiles(data)
+	require.NoError(m.t, err)
+	return m.consume(ids)
+}
+
+// consume the elements with the specified ids, regardless of the element data type.
+func (m *MockConsumer) consume(ids IDSet) error {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	// Consult with the decision function to decide what to do with the data.
+	if err := m.decision(ids); err != nil {
+		if consumererror.IsPermanent(err) {
+			// It is a permanent error, which means the data is dropped.
+			duplicates := m.droppedIDs.Merge(ids)
+			assert.Empty(m.t, duplicates, "elements that were dropped previously were sent again")
+		} else {
+			// It is a non-permanent error. Don't add it to the drop list. Remember the number of
+			// failures to print at the end of the test.
+			m.nonPermanentFailures++
+		}
+		return err
+	}
+
+	duplicates := m.acceptedIDs.Merge(ids)
+	assert.Empty(m.t, duplicates, "elements that were accepted previously were sent again")
+	return nil
+}
+
+// AcceptedAndDropped calculates the union of accepted and dropped ids.
+// Returns the union and the list of duplicates between the two sets (if any).
+func (m *MockConsumer) AcceptedAndDropped() (acceptedAndDropped IDSet, duplicates []string) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	return m.acceptedIDs.Union(m.droppedIDs)
+}
+
+// Accepted returns a copy of the accepted ids.
+func (m *MockConsumer) Accepted() IDSet {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	ids, _ := m.acceptedIDs.Union(nil)
+	return ids
+}
+
+// Dropped returns a copy of the ids dropped with a permanent error.
+func (m *MockConsumer) Dropped() IDSet {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	ids, _ := m.droppedIDs.Union(nil)
+	return ids
+}
+
+// NonPermanentFailures returns the number of non-permanent errors returned by the consumer.
+func (m *MockConsumer) NonPermanentFailures() int {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	return m.nonPermanentFailures
+}
@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package consumecontract // import "go.opentelemetry.io/collector/internal/consumecontract"
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+// ForwardingParams are the parameters of CheckForwarding.
+type ForwardingParams struct {
+	Signal pipeline.Signal
+	// NumberOfTestElements specifies the number of uniquely identifiable data elements
+	// (spans, log records or metric data points) to send to the component for each test scenario.
+	NumberOfTestElements int
+	// Create creates the component under test, passing the data it consumes to next. The component
+	// must implement the consumer interface of Signal, e.g. consumer.Traces for SignalTraces.
+	Create func(next *MockConsumer) (component.Component, error)
+}
+
+// CheckForwarding checks the contract between a component forwarding the data it consumes,
+// e.g. a processor or a connector, its caller and its next consumer. Every element sent to the
+// component must either be delivered exactly once to the next consumer, or be dropped because of
+// a permanent error, which must be returned to the caller. Non-permanent errors are retried the
+// same way a receiver would do it. If the component reports that it does not mutate data, the
+// data sent to it must be left untouched.
+func CheckForwarding(t *testing.T, params ForwardingParams) {
+	for _, scenario := range Scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			checkForwardingScenario(t, params, scenario.Decision)
+		})
+	}
+}
+
+func checkForwardingScenario(t T, params ForwardingParams, decision DecisionFunc) {
+	next := NewMockConsumer(t, decision)
+	ctx := context.Background()
+	comp, err := params.Create(next)
+	require.NoError(t, err)
+
+	// A function sending one newly created element with the given id to the component.
+	// It returns the sent data and an untouched copy of it.
+	var send func(id string) (sent any, original any, err error)
+	var mutatesData bool
+	switch params.Signal {
+	case pipeline.SignalTraces:
+		c, ok := comp.(consumer.Traces)
+		require.True(t, ok, "the component must consume traces")
+		mutatesData = c.Capabilities().MutatesData
+		send = func(id string) (any, any, error) {
+			data, original := CreateOneSpanWithID(id), ptrace.NewTraces()
+			data.CopyTo(original)
+			return data, original, c.ConsumeTraces(ctx, data)
+		}
+	case pipeline.SignalMetrics:
+		c, ok := comp.(consumer.Metrics)
+		require.True(t, ok, "the component must consume metrics")
+		mutatesData = c.Capabilities().MutatesData
+		send = func(id string) (any, any, error) {
+			data, original := CreateOneSumWithID(id), pmetric.NewMetrics()
+			data.CopyTo(original)
+			return data, original, c.ConsumeMetrics(ctx, data)
+		}
+	case pipeline.SignalLogs:
+		c, ok := comp.(consumer.Logs)
+		require.True(t, ok, "the component must consume logs")
+		mutatesData = c.Capabilities().MutatesData
+		send = func(id string) (any, any, error) {
+			data, original := CreateOneLogWithID(id), plog.NewLogs()
+			data.CopyTo(original)
+			return data, original, c.ConsumeLogs(ctx, data)
+		}
+	default:
+		require.FailNow(t, "must specify a valid DataType to test for")
+	}
+
+	require.NoError(t, comp.Start(ctx, componenttest.NewNopHost()))
+	res := sendAll(params.NumberOfTestElements, mutatesData, send)
+	// Shutdown must flush everything the component still holds to the next consumer.
+	require.NoError(t, comp.Shutdown(ctx))
+
+	checkDelivery(t, next, res)
+	checkNotMutated(t, res)
+}
+
+// sendResult holds the bookkeeping of the data sent to the component under test.
+type sendResult struct {
+	generatedIDs IDSet
+	// rejectedIDs contains the ids for which a permanent error was returned to the caller.
+	rejectedIDs IDSet
+	// retries is the number of non-permanent errors returned to the caller.
+	retries int
+	// sent and originals contain the data passed to the component and an untouched copy of it,
+	// if the component declared that it does not mutate data.
+	sent      []any
+	originals []any
+}
+
+// sendAll sends count elements using the send function. Elements are resent on non-permanent
+// errors, the way a receiver is expected to do it.
+func sendAll(count int, mutatesData bool, send func(id string) (any, any, error)) sendResult {
+	res := sendResult{generatedIDs: IDSet{}, rejectedIDs: IDSet{}}
+	for i := 0; i < count; i++ {
+		id := strconv.Itoa(i)
+		res.generatedIDs[id] = true
+		for {
+			sent, original, err := send(id)
+			if !mutatesData {
+				res.sent = append(res.sent, sent)
+				res.originals = append(res.originals, original)
+			}
+			if err == nil {
+				break
+			}
+			if consumererror.IsPermanent(err) {
+				res.rejectedIDs[id] = true
+				break
+			}
+			res.retries++
+		}
+	}
+	return res
+}
+
+// checkDelivery verifies that every generated element was either accepted by the next consumer
+// or dropped with a permanent error, and that no element was delivered twice.
+func checkDelivery(t T, next *MockConsumer, res sendResult) {
+	acceptedAndDropped, duplicates := next.AcceptedAndDropped()
+	if len(duplicates) != 0 {
+		assert.Failf(t, "found duplicate elements in accepted and dropped data", "keys=%v", duplicates)
+	}
+
+	// Permanent errors returned to the caller may or may not originate from the next consumer.
+	delivered, _ := acceptedAndDropped.Union(res.rejectedIDs)
+	missingInOther, onlyInOther := res.generatedIDs.Compare(delivered)
+	if len(missingInOther) != 0 {
+		assert.Failf(t, "found elements sent that were not delivered", "keys=%v", missingInOther)
+	}
+	if len(onlyInOther) != 0 {
+		assert.Failf(t, "found elements in accepted and dropped data that was never sent", "keys=%v", onlyInOther)
+	}
+
+	// Elements dropped by the next consumer must have been reported as failed to the caller.
+	for id := range next.Dropped() {
+		if !res.rejectedIDs[id] {
+			assert.Failf(t, "permanent error was not returned to the caller", "key=%v", id)
+		}
+	}
+
+	t.Logf(
+		"Sent %d, accepted=%d, rejected=%d, non-permanent errors retried=%d",
+		len(res.generatedIDs),
+		len(next.Accepted()),
+		len(res.rejectedIDs),
+		res.retries,
+	)
+}
+
+// checkNotMutated verifies that the data sent to a component not declaring to mutate data is unchanged.
+func checkNotMutated(t T, res sendResult) {
+	for i := range res.sent {
+		if !assert.Equal(t, res.originals[i], res.sent[i], "data was mutated, but Capabilities().MutatesData is false") {
+			return
+		}
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package consumecontract
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+// recordingT records the failures reported by the checks instead of failing the test.
+type recordingT struct {
+	mu       sync.Mutex
+	failures []string
+}
+
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingT) FailNow() {
+	runtime.Goexit()
+}
+
+func (r *recordingT) Helper() {}
+
+func (r *recordingT) Logf(string, ...any) {}
+
+// failures runs the check of the given scenario and returns the failures it reported.
+func failures(params ForwardingParams, decision DecisionFunc) string {
+	rt := &recordingT{}
+	done := make(chan struct{})
+	go func() {
+		// FailNow stops this goroutine.
+		defer close(done)
+		checkForwardingScenario(rt, params, decision)
+	}()
+	<-done
+	return strings.Join(rt.failures, "\n")
+}
+
+// forwarder is a component passing the traces, metrics and logs it consumes to the next consumer,
+// after transforming the ids of the consumed elements with process.
+type forwarder struct {
+	component.StartFunc
+	component.ShutdownFunc
+	next *MockConsumer
+	// mutate changes the consumed data, and mutatesData is the capability reported by the component.
+	mutate      bool
+	mutatesData bool
+	// process transforms the ids of the consumed elements into the ids of the elements to forward.
+	process func(id string) []string
+	// swallowErrors ignores the errors of the next consumer.
+	swallowErrors bool
+}
+
+func (f *forwarder) Capabilities() consumer.Capabilities {
+	return consumer.Capabilities{MutatesData: f.mutatesData}
+}
+
+func (f *forwarder) forward(ids IDSet, consume func(id string) error) error {
+	for id := range ids {
+		for _, out := range f.process(id) {
+			if err := consume(out); err != nil && !f.swallowErrors {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (f *forwarder) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	ids, err := IDSetFromTraces(td)
+	if err != nil {
+		return err
+	}
+	if f.mutate {
+		td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).SetName("mutated")
+	}
+	return f.forward(ids, func(id string) error { return f.next.ConsumeTraces(ctx, CreateOneSpanWithID(id)) })
+}
+
+func (f *forwarder) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	ids, err := IDSetFromMetrics(md)
+	if err != nil {
+		return err
+	}
+	return f.forward(ids, func(id string) error { return f.next.ConsumeMetrics(ctx, CreateOneSumWithID(id)) })
+}
+
+func (f *forwarder) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	ids, err := IDSetFromLogs(ld)
+	if err != nil {
+		return err
+	}
+	return f.forward(ids, func(id string) error { return f.next.ConsumeLogs(ctx, CreateOneLogWithID(id)) })
+}
+
+func identity(id string) []string {
+	return []string{id}
+}
+
+func TestCheckForwarding(t *testing.T) {
+	for _, signal := range []pipeline.Signal{pipeline.SignalTraces, pipeline.SignalMetrics, pipeline.SignalLogs} {
+		t.Run(signal.String(), func(t *testing.T) {
+			CheckForwarding(t, ForwardingParams{
+				Signal:               signal,
+				NumberOfTestElements: 10,
+				Create: func(next *MockConsumer) (component.Component, error) {
+					return &forwarder{next: next, process: identity}, nil
+				},
+			})
+		})
+	}
+}
+
+func TestCheckForwardingMutatingComponent(t *testing.T) {
+	CheckForwarding(t, ForwardingParams{
+		Signal:               pipeline.SignalTraces,
+		NumberOfTestElements: 10,
+		Create: func(next *MockConsumer) (component.Component, error) {
+			return &forwarder{next: next, mutate: true, mutatesData: true, process: identity}, nil
+		},
+	})
+}
+
+func TestCheckForwardingFailures(t *testing.T) {
+	alwaysSucceed := func(IDSet) error { return nil }
+	alwaysPermanentError := func(IDSet) error { return consumererror.NewPermanent(errPermanent) }
+
+	tests := []struct {
+		name     string
+		signal   pipeline.Signal
+		create   func(next *MockConsumer) *forwarder
+		decision DecisionFunc
+		expected string
+	}{
+		{
+			name:   "drop",
+			signal: pipeline.SignalLogs,
+			create: func(next *MockConsumer) *forwarder {
+				return &forwarder{next: next, process: func(id string) []string {
+					if id == "3" {
+						return nil
+					}
+					return []string{id}
+				}}
+			},
+			decision: alwaysSucceed,
+			expected: "found elements sent that were not delivered",
+		},
+		{
+			name:   "duplicate",
+			signal: pipeline.SignalMetrics,
+			create: func(next *MockConsumer) *forwarder {
+				return &forwarder{next: next, process: func(id string) []string {
+					return []string{id, id}
+				}}
+			},
+			decision: alwaysSucceed,
+			expected: "elements that were accepted previously were sent again",
+		},
+		{
+			name:   "unknown_element",
+			signal: pipeline.SignalLogs,
+			create: func(next *MockConsumer) *forwarder {
+				return &forwarder{next: next, process: func(id string) []string {
+					return []string{"unknown-" + id}
+				}}
+			},
+			decision: alwaysSucceed,
+			expected: "found elements in accepted and dropped data that was never sent",
+		},
+		{
+			name:   "mutation",
+			signal: pipeline.SignalTraces,
+			create: func(next *MockConsumer) *forwarder {
+				// The data is mutated while Capabilities().MutatesData is false.
+				return &forwarder{next: next, mutate: true, process: identity}
+			},
+			decision: alwaysSucceed,
+			expected: "data was mutated, but Capabilities().MutatesData is false",
+		},
+		{
+			name:   "swallowed_permanent_error",
+			signal: pipeline.SignalLogs,
+			create: func(next *MockConsumer) *forwarder {
+				return &forwarder{next: next, process: identity, swallowErrors: true}
+			},
+			decision: alwaysPermanentError,
+			expected: "permanent error was not returned to the caller",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := failures(ForwardingParams{
+				Signal:               tt.signal,
+				NumberOfTestElements: 10,
+				Create: func(next *MockConsumer) (component.Component, error) {
+					return tt.create(next), nil
+				},
+			}, tt.decision)
+			assert.Contains(t, got, tt.expected)
+		})
+	}
+}
+
+func TestCheckForwardingNoFailures(t *testing.T) {
+	got := failures(ForwardingParams{
+		Signal:               pipeline.SignalTraces,
+		NumberOfTestElements: 10,
+		Create: func(next *MockConsumer) (component.Component, error) {
+			return &forwarder{next: next, process: identity}, nil
+		},
+	}, randomErrorsConsumeDecision)
+	require.Empty(t, got)
+}
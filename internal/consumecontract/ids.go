@@ -0,0 +1,205 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package consumecontract holds the machinery shared by the consume contract checkers of
+// receivertest, processortest and connectortest: the data elements are identified by a unique
+// attribute, and a mock consumer accepts or rejects them to check that none is lost or duplicated.
+package consumecontract // import "go.opentelemetry.io/collector/internal/consumecontract"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// UniqueIDAttrName is the attribute name that is used in log records/spans/datapoints/profiles as the unique identifier.
+const UniqueIDAttrName = "test_id"
+
+// IDSet is a set of unique ids of data elements used in the test (logs, spans, metric data points or profiles).
+type IDSet map[string]bool
+
+// Compare to another set and calculate the differences from this set.
+func (ds IDSet) Compare(other IDSet) (missingInOther, onlyInOther []string) {
+	for k := range ds {
+		if _, ok := other[k]; !ok {
+			missingInOther = append(missingInOther, k)
+		}
+	}
+	for k := range other {
+		if _, ok := ds[k]; !ok {
+			onlyInOther = append(onlyInOther, k)
+		}
+	}
+	return
+}
+
+// Merge another set into this one and return a list of duplicate ids.
+func (ds IDSet) Merge(other IDSet) (duplicates []string) {
+	for k, v := range other {
+		if _, ok := ds[k]; ok {
+			duplicates = append(duplicates, k)
+		} else {
+			ds[k] = v
+		}
+	}
+	return
+}
+
+// Union computes the union of this and another sets. A new set if created to return the result.
+// Also returns a list of any duplicate ids found.
+func (ds IDSet) Union(other IDSet) (union IDSet, duplicates []string) {
+	union = make(IDSet, len(ds)+len(other))
+	for k, v := range ds {
+		union[k] = v
+	}
+	for k, v := range other {
+		if _, ok := union[k]; ok {
+			duplicates = append(duplicates, k)
+		} else {
+			union[k] = v
+		}
+	}
+	return
+}
+
+// IDSetFromTraces computes an IDSet from given ptrace.Traces. The IDSet will contain ids of all spans.
+func IDSetFromTraces(data ptrace.Traces) (IDSet, error) {
+	ds := IDSet{}
+	rss := data.ResourceSpans()
+	for i := 0; i < rss.Len(); i++ {
+		sss := rss.At(i).ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			spans := sss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				if err := idSetFromAttributes(ds, spans.At(k).Attributes()); err != nil {
+					return ds, err
+				}
+			}
+		}
+	}
+	return ds, nil
+}
+
+// IDSetFromLogs computes an IDSet from given plog.Logs. The IDSet will contain ids of all log records.
+func IDSetFromLogs(data plog.Logs) (IDSet, error) {
+	ds := IDSet{}
+	rls := data.ResourceLogs()
+	for i := 0; i < rls.Len(); i++ {
+		sls := rls.At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				if err := idSetFromAttributes(ds, lrs.At(k).Attributes()); err != nil {
+					return ds, err
+				}
+			}
+		}
+	}
+	return ds, nil
+}
+
+// IDSetFromMetrics computes an IDSet from given pmetric.Metrics. The IDSet will contain ids of all metric data points.
+func IDSetFromMetrics(data pmetric.Metrics) (IDSet, error) {
+	ds := IDSet{}
+	rms := data.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		sms := rms.At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				if err := idSetFromMetric(ds, ms.At(k)); err != nil {
+					return ds, err
+				}
+			}
+		}
+	}
+	return ds, nil
+}
+
+func idSetFromMetric(ds IDSet, m pmetric.Metric) error {
+	var attrs []pcommon.Map
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		for i := 0; i < m.Gauge().DataPoints().Len(); i++ {
+			attrs = append(attrs, m.Gauge().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeSum:
+		for i := 0; i < m.Sum().DataPoints().Len(); i++ {
+			attrs = append(attrs, m.Sum().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeSummary:
+		for i := 0; i < m.Summary().DataPoints().Len(); i++ {
+			attrs = append(attrs, m.Summary().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeHistogram:
+		for i := 0; i < m.Histogram().DataPoints().Len(); i++ {
+			attrs = append(attrs, m.Histogram().DataPoints().At(i).Attributes())
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		for i := 0; i < m.ExponentialHistogram().DataPoints().Len(); i++ {
+			attrs = append(attrs, m.ExponentialHistogram().DataPoints().At(i).Attributes())
+		}
+	}
+	for _, a := range attrs {
+		if err := idSetFromAttributes(ds, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IDSetFromProfiles computes an IDSet from given pprofile.Profiles. The IDSet will contain ids of all profiles.
+func IDSetFromProfiles(data pprofile.Profiles) (IDSet, error) {
+	ds := IDSet{}
+	rps := data.ResourceProfiles()
+	for i := 0; i < rps.Len(); i++ {
+		sps := rps.At(i).ScopeProfiles()
+		for j := 0; j < sps.Len(); j++ {
+			ps := sps.At(j).Profiles()
+			for k := 0; k < ps.Len(); k++ {
+				if err := idSetFromAttributes(ds, ps.At(k).Attributes()); err != nil {
+					return ds, err
+				}
+			}
+		}
+	}
+	return ds, nil
+}
+
+func idSetFromAttributes(ds IDSet, attributes pcommon.Map) error {
+	key, exists := attributes.Get(UniqueIDAttrName)
+	if !exists {
+		return fmt.Errorf("invalid data element, attribute %q is missing", UniqueIDAttrName)
+	}
+	if key.Type() != pcommon.ValueTypeStr {
+		return fmt.Errorf("invalid data element, attribute %q is wrong type %v", UniqueIDAttrName, key.Type())
+	}
+	ds[key.Str()] = true
+	return nil
+}
+
+// CreateOneSpanWithID creates traces with a single span identified by the given id.
+func CreateOneSpanWithID(id string) ptrace.Traces {
+	data := ptrace.NewTraces()
+	data.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().Attributes().PutStr(UniqueIDAttrName, id)
+	return data
+}
+
+// CreateOneSumWithID creates metrics with a single sum data point identified by the given id.
+func CreateOneSumWithID(id string) pmetric.Metrics {
+	data := pmetric.NewMetrics()
+	data.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptySum().
+		DataPoints().AppendEmpty().Attributes().PutStr(UniqueIDAttrName, id)
+	return data
+}
+
+// CreateOneLogWithID creates logs with a single log record identified by the given id.
+func CreateOneLogWithID(id string) plog.Logs {
+	data := plog.NewLogs()
+	data.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Attributes().PutStr(UniqueIDAttrName, id)
+	return data
+}
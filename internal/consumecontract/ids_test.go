@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package consumecontract
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+func TestIDSet(t *testing.T) {
+	ds := IDSet{"a": true, "b": true}
+	missing, only := ds.Compare(IDSet{"b": true, "c": true})
+	assert.Equal(t, []string{"a"}, missing)
+	assert.Equal(t, []string{"c"}, only)
+
+	union, duplicates := ds.Union(IDSet{"b": true, "c": true})
+	assert.Equal(t, IDSet{"a": true, "b": true, "c": true}, union)
+	assert.Equal(t, []string{"b"}, duplicates)
+	assert.Len(t, ds, 2, "the union is a new set")
+
+	duplicates = ds.Merge(IDSet{"a": true, "d": true})
+	assert.Equal(t, []string{"a"}, duplicates)
+	assert.Equal(t, IDSet{"a": true, "b": true, "d": true}, ds)
+}
+
+func TestIDSetFromAttributes(t *testing.T) {
+	require.Error(t, idSetFromAttributes(IDSet{}, pcommon.NewMap()))
+	m := pcommon.NewMap()
+	m.PutStr("foo", "bar")
+	require.Error(t, idSetFromAttributes(IDSet{}, m))
+	m.PutInt(UniqueIDAttrName, 64)
+	require.Error(t, idSetFromAttributes(IDSet{}, m))
+	m.PutStr(UniqueIDAttrName, "myid")
+	result := IDSet{}
+	require.NoError(t, idSetFromAttributes(result, m))
+	require.True(t, result["myid"])
+}
+
+func TestIDSetFromSignals(t *testing.T) {
+	ids, err := IDSetFromTraces(CreateOneSpanWithID("span"))
+	require.NoError(t, err)
+	assert.Equal(t, IDSet{"span": true}, ids)
+
+	ids, err = IDSetFromLogs(CreateOneLogWithID("log"))
+	require.NoError(t, err)
+	assert.Equal(t, IDSet{"log": true}, ids)
+
+	ids, err = IDSetFromMetrics(CreateOneSumWithID("sum"))
+	require.NoError(t, err)
+	assert.Equal(t, IDSet{"sum": true}, ids)
+}
+
+func TestIDSetFromMetrics(t *testing.T) {
+	md := pmetric.NewMetrics()
+	ms := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	ms.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty().Attributes().PutStr(UniqueIDAttrName, "gauge")
+	ms.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty().Attributes().PutStr(UniqueIDAttrName, "sum")
+	ms.AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty().Attributes().PutStr(UniqueIDAttrName, "summary")
+	ms.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty().Attributes().PutStr(UniqueIDAttrName, "histogram")
+	ms.AppendEmpty().SetEmptyExponentialHistogram().DataPoints().AppendEmpty().Attributes().PutStr(UniqueIDAttrName, "exp_histogram")
+
+	ids, err := IDSetFromMetrics(md)
+	require.NoError(t, err)
+	require.Equal(t, IDSet{"gauge": true, "sum": true, "summary": true, "histogram": true, "exp_histogram": true}, ids)
+
+	ms.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty()
+	_, err = IDSetFromMetrics(md)
+	require.Error(t, err)
+}
+
+func TestIDSetFromProfiles(t *testing.T) {
+	pd := pprofile.NewProfiles()
+	pd.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty().Attributes().PutStr(UniqueIDAttrName, "myid")
+	ids, err := IDSetFromProfiles(pd)
+	require.NoError(t, err)
+	require.Equal(t, IDSet{"myid": true}, ids)
+
+	pd = pprofile.NewProfiles()
+	pd.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty()
+	_, err = IDSetFromProfiles(pd)
+	require.Error(t, err)
+}
+
+func TestBadMetricPoint(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		metrics pmetric.Metrics
+	}{
+		{
+			name: "gauge",
+			metrics: func() pmetric.Metrics {
+				m := pmetric.NewMetrics()
+				m.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+				return m
+			}(),
+		},
+		{
+			name: "sum",
+			metrics: func() pmetric.Metrics {
+				m := pmetric.NewMetrics()
+				m.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptySum().DataPoints().AppendEmpty()
+				return m
+			}(),
+		},
+		{
+			name: "summary",
+			metrics: func() pmetric.Metrics {
+				m := pmetric.NewMetrics()
+				m.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty()
+				return m
+			}(),
+		},
+		{
+			name: "histogram",
+			metrics: func() pmetric.Metrics {
+				m := pmetric.NewMetrics()
+				m.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty()
+				return m
+			}(),
+		},
+		{
+			name: "exponential histogram",
+			metrics: func() pmetric.Metrics {
+				m := pmetric.NewMetrics()
+				m.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyExponentialHistogram().DataPoints().AppendEmpty()
+				return m
+			}(),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := IDSetFromMetrics(test.metrics)
+			require.Error(t, err)
+		})
+	}
+}
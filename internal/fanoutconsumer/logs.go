@@ -24,8 +24,9 @@ func NewLogs(lcs []consumer.Logs) consumer.Logs {
 		return lcs[0]
 	}
 
-	lc := &logsConsumer{}
+	lc := &logsConsumer{doesNotRetain: true}
 	for i := 0; i < len(lcs); i++ {
+		lc.doesNotRetain = lc.doesNotRetain && lcs[i].Capabilities().DoesNotRetainData
 		if lcs[i].Capabilities().MutatesData {
 			lc.mutable = append(lc.mutable, lcs[i])
 		} else {
@@ -36,13 +37,17 @@ func NewLogs(lcs []consumer.Logs) consumer.Logs {
 }
 
 type logsConsumer struct {
-	mutable  []consumer.Logs
-	readonly []consumer.Logs
+	mutable       []consumer.Logs
+	readonly      []consumer.Logs
+	doesNotRetain bool
 }
 
 func (lsc *logsConsumer) Capabilities() consumer.Capabilities {
 	// If all consumers are mutating, then the original data will be passed to one of them.
-	return consumer.Capabilities{MutatesData: len(lsc.mutable) > 0 && len(lsc.readonly) == 0}
+	return consumer.Capabilities{
+		MutatesData:       len(lsc.mutable) > 0 && len(lsc.readonly) == 0,
+		DoesNotRetainData: lsc.doesNotRetain,
+	}
 }
 
 // ConsumeLogs exports the plog.Logs to all consumers wrapped by the current one.
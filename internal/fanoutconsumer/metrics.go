@@ -22,8 +22,9 @@ func NewMetrics(mcs []consumer.Metrics) consumer.Metrics {
 		return mcs[0]
 	}
 
-	mc := &metricsConsumer{}
+	mc := &metricsConsumer{doesNotRetain: true}
 	for i := 0; i < len(mcs); i++ {
+		mc.doesNotRetain = mc.doesNotRetain && mcs[i].Capabilities().DoesNotRetainData
 		if mcs[i].Capabilities().MutatesData {
 			mc.mutable = append(mc.mutable, mcs[i])
 		} else {
@@ -34,13 +35,17 @@ func NewMetrics(mcs []consumer.Metrics) consumer.Metrics {
 }
 
 type metricsConsumer struct {
-	mutable  []consumer.Metrics
-	readonly []consumer.Metrics
+	mutable       []consumer.Metrics
+	readonly      []consumer.Metrics
+	doesNotRetain bool
 }
 
 func (msc *metricsConsumer) Capabilities() consumer.Capabilities {
 	// If all consumers are mutating, then the original data will be passed to one of them.
-	return consumer.Capabilities{MutatesData: len(msc.mutable) > 0 && len(msc.readonly) == 0}
+	return consumer.Capabilities{
+		MutatesData:       len(msc.mutable) > 0 && len(msc.readonly) == 0,
+		DoesNotRetainData: msc.doesNotRetain,
+	}
 }
 
 // ConsumeMetrics exports the pmetric.Metrics to all consumers wrapped by the current one.
@@ -23,8 +23,9 @@ func NewProfiles(tcs []consumerprofiles.Profiles) consumerprofiles.Profiles {
 		return tcs[0]
 	}
 
-	tc := &profilesConsumer{}
+	tc := &profilesConsumer{doesNotRetain: true}
 	for i := 0; i < len(tcs); i++ {
+		tc.doesNotRetain = tc.doesNotRetain && tcs[i].Capabilities().DoesNotRetainData
 		if tcs[i].Capabilities().MutatesData {
 			tc.mutable = append(tc.mutable, tcs[i])
 		} else {
@@ -35,13 +36,17 @@ func NewProfiles(tcs []consumerprofiles.Profiles) consumerprofiles.Profiles {
 }
 
 type profilesConsumer struct {
-	mutable  []consumerprofiles.Profiles
-	readonly []consumerprofiles.Profiles
+	mutable       []consumerprofiles.Profiles
+	readonly      []consumerprofiles.Profiles
+	doesNotRetain bool
 }
 
 func (tsc *profilesConsumer) Capabilities() consumer.Capabilities {
 	// If all consumers are mutating, then the original data will be passed to one of them.
-	return consumer.Capabilities{MutatesData: len(tsc.mutable) > 0 && len(tsc.readonly) == 0}
+	return consumer.Capabilities{
+		MutatesData:       len(tsc.mutable) > 0 && len(tsc.readonly) == 0,
+		DoesNotRetainData: tsc.doesNotRetain,
+	}
 }
 
 // ConsumeProfiles exports the pprofile.Profiles to all consumers wrapped by the current one.
@@ -22,8 +22,9 @@ func NewTraces(tcs []consumer.Traces) consumer.Traces {
 		return tcs[0]
 	}
 
-	tc := &tracesConsumer{}
+	tc := &tracesConsumer{doesNotRetain: true}
 	for i := 0; i < len(tcs); i++ {
+		tc.doesNotRetain = tc.doesNotRetain && tcs[i].Capabilities().DoesNotRetainData
 		if tcs[i].Capabilities().MutatesData {
 			tc.mutable = append(tc.mutable, tcs[i])
 		} else {
@@ -34,13 +35,17 @@ func NewTraces(tcs []consumer.Traces) consumer.Traces {
 }
 
 type tracesConsumer struct {
-	mutable  []consumer.Traces
-	readonly []consumer.Traces
+	mutable       []consumer.Traces
+	readonly      []consumer.Traces
+	doesNotRetain bool
 }
 
 func (tsc *tracesConsumer) Capabilities() consumer.Capabilities {
 	// If all consumers are mutating, then the original data will be passed to one of them.
-	return consumer.Capabilities{MutatesData: len(tsc.mutable) > 0 && len(tsc.readonly) == 0}
+	return consumer.Capabilities{
+		MutatesData:       len(tsc.mutable) > 0 && len(tsc.readonly) == 0,
+		DoesNotRetainData: tsc.doesNotRetain,
+	}
 }
 
 // ConsumeTraces exports the ptrace.Traces to all consumers wrapped by the current one.
@@ -28,6 +28,18 @@ func TestTracesNotMultiplexingMutating(t *testing.T) {
 	assert.True(t, lfc.Capabilities().MutatesData)
 }
 
+func TestTracesMultiplexingRetaining(t *testing.T) {
+	notRetaining, err := consumer.NewTraces(consumertest.NewNop().ConsumeTraces,
+		consumer.WithCapabilities(consumer.Capabilities{DoesNotRetainData: true}))
+	require.NoError(t, err)
+
+	tfc := NewTraces([]consumer.Traces{notRetaining, notRetaining})
+	assert.True(t, tfc.Capabilities().DoesNotRetainData)
+	// Consumers which do not declare the capability are assumed to retain the data.
+	tfc = NewTraces([]consumer.Traces{notRetaining, consumertest.NewNop()})
+	assert.False(t, tfc.Capabilities().DoesNotRetainData)
+}
+
 func TestTracesMultiplexingNonMutating(t *testing.T) {
 	p1 := new(consumertest.TracesSink)
 	p2 := new(consumertest.TracesSink)
@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fault // import "go.opentelemetry.io/collector/internal/fault"
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Distribution is the name of the probability distribution latencies are drawn from.
+type Distribution string
+
+const (
+	// DistributionConstant delays every request by the mean latency.
+	DistributionConstant Distribution = "constant"
+	// DistributionUniform delays requests by a latency uniformly distributed between min and max.
+	DistributionUniform Distribution = "uniform"
+	// DistributionNormal delays requests by a latency normally distributed around the mean.
+	DistributionNormal Distribution = "normal"
+	// DistributionExponential delays requests by a latency exponentially distributed with the given mean.
+	DistributionExponential Distribution = "exponential"
+)
+
+// Config defines the faults injected into requests.
+type Config struct {
+	// ErrorRate is the fraction of requests, between 0 and 1, failing with the configured error.
+	ErrorRate float64 `mapstructure:"error_rate"`
+
+	// Error describes the error failed requests are rejected with.
+	Error ErrorConfig `mapstructure:"error"`
+
+	// ThrottleRate is the fraction of requests, between 0 and 1, rejected with a throttling error.
+	// ThrottleRate and ErrorRate must not add up to more than 1.
+	ThrottleRate float64 `mapstructure:"throttle_rate"`
+
+	// RetryAfter is the delay throttled requests are asked to wait for before being retried.
+	RetryAfter time.Duration `mapstructure:"retry_after"`
+
+	// Latency is added to every request before it is processed.
+	Latency LatencyConfig `mapstructure:"latency"`
+
+	// HangRate is the fraction of requests, between 0 and 1, blocking for HangDuration
+	// before being processed.
+	HangRate float64 `mapstructure:"hang_rate"`
+
+	// HangDuration is the time hanging requests block for. Zero blocks until the request
+	// is canceled or the component is shut down.
+	HangDuration time.Duration `mapstructure:"hang_duration"`
+}
+
+// ErrorConfig describes the errors returned for failed requests.
+// At most one of Permanent, GRPCCode and HTTPStatusCode can be set.
+type ErrorConfig struct {
+	// Permanent makes errors permanent, so they are not retried. Errors are retryable by default.
+	Permanent bool `mapstructure:"permanent"`
+
+	// GRPCCode is the name of the gRPC status code of errors, e.g. "Unavailable". It can't be "OK".
+	// Errors are permanent unless the code is retryable according to the OTLP specification.
+	GRPCCode string `mapstructure:"grpc_code"`
+
+	// HTTPStatusCode is the HTTP status code of errors, e.g. 503.
+	// Errors are permanent unless the code is retryable according to the OTLP specification.
+	HTTPStatusCode int `mapstructure:"http_status_code"`
+
+	// Message is the message of errors.
+	Message string `mapstructure:"message"`
+}
+
+// LatencyConfig describes the latency added to requests.
+type LatencyConfig struct {
+	// Distribution is the distribution latencies are drawn from: constant, uniform, normal or exponential.
+	// No latency is added if empty.
+	Distribution Distribution `mapstructure:"distribution"`
+
+	// Mean is the mean latency of the constant, normal and exponential distributions.
+	Mean time.Duration `mapstructure:"mean"`
+
+	// StdDev is the standard deviation of the normal distribution.
+	StdDev time.Duration `mapstructure:"stddev"`
+
+	// Min and Max are the bounds of the uniform distribution.
+	Min time.Duration `mapstructure:"min"`
+	Max time.Duration `mapstructure:"max"`
+}
+
+// NewDefaultConfig returns a configuration injecting no fault.
+func NewDefaultConfig() Config {
+	return Config{
+		Error: ErrorConfig{
+			Message: "injected fault",
+		},
+		RetryAfter: 5 * time.Second,
+	}
+}
+
+// Validate checks if the configuration is valid.
+func (cfg *Config) Validate() error {
+	var errs error
+	rates := []struct {
+		name  string
+		value float64
+	}{{"error_rate", cfg.ErrorRate}, {"throttle_rate", cfg.ThrottleRate}, {"hang_rate", cfg.HangRate}}
+	for _, rate := range rates {
+		if rate.value < 0 || rate.value > 1 {
+			errs = errors.Join(errs, fmt.Errorf("'%s' must be between 0 and 1, got %v", rate.name, rate.value))
+		}
+	}
+	if cfg.ErrorRate+cfg.ThrottleRate > 1 {
+		errs = errors.Join(errs, errors.New("'error_rate' and 'throttle_rate' must not add up to more than 1"))
+	}
+	if cfg.ThrottleRate > 0 && cfg.RetryAfter <= 0 {
+		errs = errors.Join(errs, errors.New("'retry_after' must be positive when throttling requests"))
+	}
+	if cfg.HangDuration < 0 {
+		errs = errors.Join(errs, errors.New("'hang_duration' must not be negative"))
+	}
+	return errors.Join(errs, cfg.Error.validate(), cfg.Latency.validate())
+}
+
+func (cfg *ErrorConfig) validate() error {
+	set := 0
+	if cfg.Permanent {
+		set++
+	}
+	if cfg.GRPCCode != "" {
+		set++
+		code, ok := grpcCodes[cfg.GRPCCode]
+		if !ok {
+			return fmt.Errorf("'error::grpc_code' %q is not a valid gRPC status code", cfg.GRPCCode)
+		}
+		if code == codes.OK {
+			return errors.New("'error::grpc_code' must be an error code, got OK")
+		}
+	}
+	if cfg.HTTPStatusCode != 0 {
+		set++
+		if cfg.HTTPStatusCode < 400 || cfg.HTTPStatusCode > 599 {
+			return fmt.Errorf("'error::http_status_code' must be an HTTP error status code, got %d", cfg.HTTPStatusCode)
+		}
+	}
+	if set > 1 {
+		return errors.New("at most one of 'error::permanent', 'error::grpc_code' and 'error::http_status_code' can be set")
+	}
+	return nil
+}
+
+func (cfg *LatencyConfig) validate() error {
+	switch cfg.Distribution {
+	case "":
+		return nil
+	case DistributionConstant, DistributionExponential:
+		if cfg.Mean <= 0 {
+			return fmt.Errorf("'latency::mean' must be positive for the %s distribution", cfg.Distribution)
+		}
+	case DistributionNormal:
+		if cfg.Mean <= 0 || cfg.StdDev < 0 {
+			return errors.New("'latency::mean' must be positive and 'latency::stddev' must not be negative for the normal distribution")
+		}
+	case DistributionUniform:
+		if cfg.Min < 0 || cfg.Max <= cfg.Min {
+			return errors.New("'latency::min' must not be negative and must be lower than 'latency::max' for the uniform distribution")
+		}
+	default:
+		return fmt.Errorf("unknown 'latency::distribution' %q", cfg.Distribution)
+	}
+	return nil
+}
+
+// grpcCodes maps the names of the gRPC status codes to their value.
+var grpcCodes = func() map[string]codes.Code {
+	m := map[string]codes.Code{}
+	for c := codes.OK; c <= codes.Unauthenticated; c++ {
+		m[c.String()] = c
+	}
+	return m
+}()
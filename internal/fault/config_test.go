@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fault
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		err    string
+	}{
+		{
+			name:   "default",
+			modify: func(*Config) {},
+		},
+		{
+			name: "all faults",
+			modify: func(cfg *Config) {
+				cfg.ErrorRate = 0.25
+				cfg.Error.GRPCCode = "Unavailable"
+				cfg.ThrottleRate = 0.75
+				cfg.HangRate = 1
+				cfg.HangDuration = time.Second
+				cfg.Latency = LatencyConfig{Distribution: DistributionNormal, Mean: time.Second, StdDev: time.Millisecond}
+			},
+		},
+		{
+			name:   "negative rate",
+			modify: func(cfg *Config) { cfg.HangRate = -0.1 },
+			err:    "'hang_rate' must be between 0 and 1, got -0.1",
+		},
+		{
+			name:   "rate above one",
+			modify: func(cfg *Config) { cfg.HangRate = 1.5 },
+			err:    "'hang_rate' must be between 0 and 1, got 1.5",
+		},
+		{
+			name: "rates add up to more than one",
+			modify: func(cfg *Config) {
+				cfg.ErrorRate = 0.5
+				cfg.ThrottleRate = 0.6
+			},
+			err: "'error_rate' and 'throttle_rate' must not add up to more than 1",
+		},
+		{
+			name: "throttling without retry_after",
+			modify: func(cfg *Config) {
+				cfg.ThrottleRate = 0.5
+				cfg.RetryAfter = 0
+			},
+			err: "'retry_after' must be positive when throttling requests",
+		},
+		{
+			name:   "negative hang_duration",
+			modify: func(cfg *Config) { cfg.HangDuration = -time.Second },
+			err:    "'hang_duration' must not be negative",
+		},
+		{
+			name:   "invalid grpc_code",
+			modify: func(cfg *Config) { cfg.Error.GRPCCode = "Unknown code" },
+			err:    `'error::grpc_code' "Unknown code" is not a valid gRPC status code`,
+		},
+		{
+			name:   "OK grpc_code",
+			modify: func(cfg *Config) { cfg.Error.GRPCCode = "OK" },
+			err:    "'error::grpc_code' must be an error code, got OK",
+		},
+		{
+			name:   "invalid http_status_code",
+			modify: func(cfg *Config) { cfg.Error.HTTPStatusCode = 200 },
+			err:    "'error::http_status_code' must be an HTTP error status code, got 200",
+		},
+		{
+			name: "several error kinds",
+			modify: func(cfg *Config) {
+				cfg.Error.Permanent = true
+				cfg.Error.HTTPStatusCode = 503
+			},
+			err: "at most one of 'error::permanent', 'error::grpc_code' and 'error::http_status_code' can be set",
+		},
+		{
+			name:   "constant latency without mean",
+			modify: func(cfg *Config) { cfg.Latency.Distribution = DistributionConstant },
+			err:    "'latency::mean' must be positive for the constant distribution",
+		},
+		{
+			name: "normal latency with negative stddev",
+			modify: func(cfg *Config) {
+				cfg.Latency = LatencyConfig{Distribution: DistributionNormal, Mean: time.Second, StdDev: -time.Second}
+			},
+			err: "'latency::mean' must be positive and 'latency::stddev' must not be negative for the normal distribution",
+		},
+		{
+			name: "uniform latency with inverted bounds",
+			modify: func(cfg *Config) {
+				cfg.Latency = LatencyConfig{Distribution: DistributionUniform, Min: time.Second, Max: time.Millisecond}
+			},
+			err: "'latency::min' must not be negative and must be lower than 'latency::max' for the uniform distribution",
+		},
+		{
+			name:   "unknown distribution",
+			modify: func(cfg *Config) { cfg.Latency.Distribution = "pareto" },
+			err:    `unknown 'latency::distribution' "pareto"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewDefaultConfig()
+			tt.modify(&cfg)
+			err := cfg.Validate()
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fault injects configurable faults, such as errors, throttling, latency
+// and hangs, into requests handled by a component.
+package fault // import "go.opentelemetry.io/collector/internal/fault"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.opentelemetry.io/collector/consumer/consumererror"
+)
+
+// errShutdown is returned for requests interrupted because the injector was shut down.
+var errShutdown = status.Error(codes.Unavailable, "component is shutting down")
+
+// Injector injects the configured faults into requests.
+type Injector struct {
+	cfg         Config
+	err         error
+	throttleErr error
+
+	done         chan struct{}
+	shutdownOnce sync.Once
+}
+
+// NewInjector returns an Injector for the given configuration, which must be valid.
+func NewInjector(cfg Config) *Injector {
+	return &Injector{
+		cfg:         cfg,
+		err:         newError(cfg.Error),
+		throttleErr: newThrottleError(cfg.RetryAfter),
+		done:        make(chan struct{}),
+	}
+}
+
+// Inject delays the request according to the configured latency and hang rate, then returns
+// the error the request must be rejected with, or nil if it must be processed normally.
+// An error is also returned if the context is done, or the injector shut down, while waiting.
+func (i *Injector) Inject(ctx context.Context) error {
+	if d := i.cfg.Latency.sample(); d > 0 {
+		if err := i.wait(ctx, d); err != nil {
+			return err
+		}
+	}
+	if i.cfg.HangRate > 0 && rand.Float64() < i.cfg.HangRate {
+		d := i.cfg.HangDuration
+		if d == 0 {
+			d = -1
+		}
+		if err := i.wait(ctx, d); err != nil {
+			return err
+		}
+	}
+
+	r := rand.Float64()
+	switch {
+	case r < i.cfg.ThrottleRate:
+		return i.throttleErr
+	case r < i.cfg.ThrottleRate+i.cfg.ErrorRate:
+		return i.err
+	}
+	return nil
+}
+
+// Shutdown releases all the requests currently waiting, and makes later requests fail
+// instead of waiting.
+func (i *Injector) Shutdown() {
+	i.shutdownOnce.Do(func() { close(i.done) })
+}
+
+// wait blocks for d, or until the context is done or the injector is shut down.
+// A negative d blocks until one of the latter happens.
+func (i *Injector) wait(ctx context.Context, d time.Duration) error {
+	var elapsed <-chan time.Time
+	if d >= 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		elapsed = timer.C
+	}
+	select {
+	case <-elapsed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-i.done:
+		return errShutdown
+	}
+}
+
+// RetryDelay returns the delay a throttling error asks to wait for before retrying.
+func RetryDelay(err error) (time.Duration, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return 0, false
+	}
+	for _, detail := range st.Details() {
+		if ri, ok := detail.(*errdetails.RetryInfo); ok && ri.RetryDelay != nil {
+			return ri.RetryDelay.AsDuration(), true
+		}
+	}
+	return 0, false
+}
+
+func newError(cfg ErrorConfig) error {
+	var err error
+	permanent := cfg.Permanent
+	switch {
+	case cfg.GRPCCode != "":
+		code := grpcCodes[cfg.GRPCCode]
+		err = status.Error(code, cfg.Message)
+		permanent = !isRetryableGRPCCode(code)
+	case cfg.HTTPStatusCode != 0:
+		err = status.Error(grpcCodeFromHTTPStatus(cfg.HTTPStatusCode), fmt.Sprintf("%s (HTTP %d)", cfg.Message, cfg.HTTPStatusCode))
+		permanent = !isRetryableHTTPStatus(cfg.HTTPStatusCode)
+	default:
+		err = errors.New(cfg.Message)
+	}
+	if permanent {
+		return consumererror.NewPermanent(err)
+	}
+	return err
+}
+
+func newThrottleError(retryAfter time.Duration) error {
+	st := status.New(codes.ResourceExhausted, "injected throttling")
+	if withDetails, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)}); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
+
+// isRetryableGRPCCode returns whether requests failing with the code can be retried,
+// see https://github.com/open-telemetry/opentelemetry-proto/blob/main/docs/specification.md#failures.
+func isRetryableGRPCCode(code codes.Code) bool {
+	switch code {
+	case codes.Canceled, codes.DeadlineExceeded, codes.Aborted, codes.OutOfRange, codes.Unavailable, codes.DataLoss:
+		return true
+	}
+	return false
+}
+
+// isRetryableHTTPStatus returns whether requests failing with the status can be retried,
+// see https://github.com/open-telemetry/opentelemetry-proto/blob/main/docs/specification.md#failures-1.
+func isRetryableHTTPStatus(code int) bool {
+	switch code {
+	case 429, 502, 503, 504:
+		return true
+	}
+	return false
+}
+
+// grpcCodeFromHTTPStatus returns the gRPC code an OTLP receiver translates to the given HTTP status.
+func grpcCodeFromHTTPStatus(code int) codes.Code {
+	switch code {
+	case 400:
+		return codes.InvalidArgument
+	case 401:
+		return codes.Unauthenticated
+	case 403:
+		return codes.PermissionDenied
+	case 404:
+		return codes.Unimplemented
+	case 429:
+		return codes.ResourceExhausted
+	case 502, 503, 504:
+		return codes.Unavailable
+	}
+	if code < 500 {
+		return codes.InvalidArgument
+	}
+	return codes.Internal
+}
+
+func (cfg *LatencyConfig) sample() time.Duration {
+	switch cfg.Distribution {
+	case DistributionConstant:
+		return cfg.Mean
+	case DistributionUniform:
+		return cfg.Min + time.Duration(rand.Int64N(int64(cfg.Max-cfg.Min)))
+	case DistributionNormal:
+		return max(0, cfg.Mean+time.Duration(rand.NormFloat64()*float64(cfg.StdDev)))
+	case DistributionExponential:
+		return time.Duration(rand.ExpFloat64() * float64(cfg.Mean))
+	}
+	return 0
+}
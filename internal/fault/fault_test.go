@@ -0,0 +1,206 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fault
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.opentelemetry.io/collector/consumer/consumererror"
+)
+
+func TestInjectNoFault(t *testing.T) {
+	inj := NewInjector(NewDefaultConfig())
+	for i := 0; i < 100; i++ {
+		require.NoError(t, inj.Inject(context.Background()))
+	}
+}
+
+func TestInjectError(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       ErrorConfig
+		code      codes.Code
+		message   string
+		permanent bool
+	}{
+		{
+			name:    "retryable",
+			cfg:     ErrorConfig{Message: "boom"},
+			code:    codes.Unknown,
+			message: "boom",
+		},
+		{
+			name:      "permanent",
+			cfg:       ErrorConfig{Permanent: true, Message: "boom"},
+			code:      codes.Unknown,
+			message:   "boom",
+			permanent: true,
+		},
+		{
+			name:    "retryable grpc code",
+			cfg:     ErrorConfig{GRPCCode: "Unavailable", Message: "boom"},
+			code:    codes.Unavailable,
+			message: "boom",
+		},
+		{
+			name:      "permanent grpc code",
+			cfg:       ErrorConfig{GRPCCode: "InvalidArgument", Message: "boom"},
+			code:      codes.InvalidArgument,
+			message:   "boom",
+			permanent: true,
+		},
+		{
+			name:    "retryable http status code",
+			cfg:     ErrorConfig{HTTPStatusCode: 503, Message: "boom"},
+			code:    codes.Unavailable,
+			message: "boom (HTTP 503)",
+		},
+		{
+			name:      "permanent http status code",
+			cfg:       ErrorConfig{HTTPStatusCode: 401, Message: "boom"},
+			code:      codes.Unauthenticated,
+			message:   "boom (HTTP 401)",
+			permanent: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewDefaultConfig()
+			cfg.ErrorRate = 1
+			cfg.Error = tt.cfg
+			require.NoError(t, cfg.Validate())
+
+			err := NewInjector(cfg).Inject(context.Background())
+			require.Error(t, err)
+			assert.Equal(t, tt.permanent, consumererror.IsPermanent(err))
+			st := status.Convert(err)
+			if tt.permanent {
+				st = status.Convert(errors.Unwrap(err))
+			}
+			assert.Equal(t, tt.code, st.Code())
+			assert.Equal(t, tt.message, st.Message())
+			_, ok := RetryDelay(err)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestInjectThrottle(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.ThrottleRate = 1
+	cfg.RetryAfter = 3 * time.Second
+
+	err := NewInjector(cfg).Inject(context.Background())
+	require.Error(t, err)
+	assert.False(t, consumererror.IsPermanent(err))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	delay, ok := RetryDelay(err)
+	require.True(t, ok)
+	assert.Equal(t, 3*time.Second, delay)
+}
+
+func TestInjectLatency(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.Latency = LatencyConfig{Distribution: DistributionConstant, Mean: 20 * time.Millisecond}
+	inj := NewInjector(cfg)
+
+	start := time.Now()
+	require.NoError(t, inj.Inject(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, inj.Inject(ctx), context.Canceled)
+}
+
+func TestInjectHang(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.HangRate = 1
+	cfg.HangDuration = 20 * time.Millisecond
+
+	start := time.Now()
+	require.NoError(t, NewInjector(cfg).Inject(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+}
+
+func TestInjectHangUntilCanceled(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.HangRate = 1
+	inj := NewInjector(cfg)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, inj.Inject(ctx), context.DeadlineExceeded)
+}
+
+func TestInjectHangUntilShutdown(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.HangRate = 1
+	inj := NewInjector(cfg)
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- inj.Inject(context.Background())
+	}()
+	inj.Shutdown()
+	err := <-errCh
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// Shutting down twice is a no-op, and later requests no longer hang.
+	inj.Shutdown()
+	assert.Equal(t, codes.Unavailable, status.Code(inj.Inject(context.Background())))
+}
+
+func TestLatencySample(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      LatencyConfig
+		min, max time.Duration
+	}{
+		{
+			name: "none",
+		},
+		{
+			name: "constant",
+			cfg:  LatencyConfig{Distribution: DistributionConstant, Mean: time.Second},
+			min:  time.Second,
+			max:  time.Second,
+		},
+		{
+			name: "uniform",
+			cfg:  LatencyConfig{Distribution: DistributionUniform, Min: time.Second, Max: 2 * time.Second},
+			min:  time.Second,
+			max:  2 * time.Second,
+		},
+		{
+			name: "normal",
+			cfg:  LatencyConfig{Distribution: DistributionNormal, Mean: time.Second, StdDev: 10 * time.Second},
+			min:  0,
+			max:  time.Duration(1<<63 - 1),
+		},
+		{
+			name: "exponential",
+			cfg:  LatencyConfig{Distribution: DistributionExponential, Mean: time.Second},
+			min:  0,
+			max:  time.Duration(1<<63 - 1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 1000; i++ {
+				d := tt.cfg.sample()
+				assert.GreaterOrEqual(t, d, tt.min)
+				assert.LessOrEqual(t, d, tt.max)
+			}
+		})
+	}
+}
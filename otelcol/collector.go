@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"sort"
 	"sync/atomic"
 	"syscall"
 
@@ -22,6 +23,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/otelcol/internal/grpclog"
 	"go.opentelemetry.io/collector/service"
 )
@@ -74,6 +76,9 @@ type CollectorSettings struct {
 
 	// SkipSettingGRPCLogger avoids setting the grpc logger
 	SkipSettingGRPCLogger bool
+
+	// featureGatesFromFlags are the feature gates set with the --feature-gates flag.
+	featureGatesFromFlags map[string]bool
 }
 
 // (Internal note) Collector Lifecycle:
@@ -105,6 +110,10 @@ type Collector struct {
 	asyncErrorChannel          chan error
 	bc                         *bufferedCore
 	updateConfigProviderLogger func(core zapcore.Core)
+
+	// featureGates are the feature gates applied from the service::feature_gates config when the collector started.
+	// Feature gates are read by components when they are built, so they can't be changed by a config reload.
+	featureGates map[string]bool
 }
 
 // NewCollector creates and returns a new instance of Collector.
@@ -163,9 +172,9 @@ func (col *Collector) setupConfigurationComponents(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to initialize factories: %w", err)
 	}
-	cfg, err := col.configProvider.Get(ctx, factories)
+	cfg, err := col.getConfig(ctx, factories)
 	if err != nil {
-		return fmt.Errorf("failed to get config: %w", err)
+		return err
 	}
 
 	if err = cfg.Validate(); err != nil {
@@ -233,6 +242,104 @@ func (col *Collector) setupConfigurationComponents(ctx context.Context) error {
 	return nil
 }
 
+// getConfig gets the configuration from the config provider, applying its service::feature_gates
+// before the configuration is unmarshalled and validated.
+func (col *Collector) getConfig(ctx context.Context, factories Factories) (*Config, error) {
+	var gatesErr error
+	applyFeatureGates := func(gates map[string]bool) error {
+		gatesErr = col.applyFeatureGates(gates)
+		return gatesErr
+	}
+
+	var cfg *Config
+	var err error
+	if cp, ok := col.configProvider.(*configProvider); ok {
+		cfg, err = cp.get(ctx, factories, applyFeatureGates)
+	} else if cfg, err = col.configProvider.Get(ctx, factories); err == nil {
+		// Other providers only return the unmarshalled configuration.
+		err = applyFeatureGates(cfg.Service.FeatureGates)
+	}
+
+	switch {
+	case gatesErr != nil:
+		return nil, fmt.Errorf("invalid configuration: service::feature_gates: %w", gatesErr)
+	case err != nil:
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+	return cfg, nil
+}
+
+// applyFeatureGates sets the feature gates of the service::feature_gates config in the global registry,
+// before the components are built. Gates can't be changed by a config reload, nor set to a value different
+// from the one set with the --feature-gates flag.
+func (col *Collector) applyFeatureGates(gates map[string]bool) error {
+	if col.featureGates != nil {
+		var changed []string
+		for id, enabled := range gates {
+			if applied, ok := col.featureGates[id]; !ok || applied != enabled {
+				changed = append(changed, id)
+			}
+		}
+		for id := range col.featureGates {
+			if _, ok := gates[id]; !ok {
+				changed = append(changed, id)
+			}
+		}
+		if len(changed) > 0 {
+			sort.Strings(changed)
+			return fmt.Errorf("feature gates %v changed, the collector must be restarted to apply feature gate changes", changed)
+		}
+		return nil
+	}
+
+	// Validate all the gates first to not leave the registry partially updated.
+	if err := validateFeatureGates(featuregate.GlobalRegistry(), gates, col.set.featureGatesFromFlags); err != nil {
+		return err
+	}
+	for id, enabled := range gates {
+		if err := featuregate.GlobalRegistry().Set(id, enabled); err != nil {
+			return err
+		}
+	}
+	col.featureGates = make(map[string]bool, len(gates))
+	for id, enabled := range gates {
+		col.featureGates[id] = enabled
+	}
+	return nil
+}
+
+// validateFeatureGates checks that the gates can be set in the registry, and that they are not set
+// to a value different from the one set with the --feature-gates flag.
+func validateFeatureGates(reg *featuregate.Registry, gates map[string]bool, featureGatesFromFlags map[string]bool) error {
+	registered := map[string]*featuregate.Gate{}
+	reg.VisitAll(func(g *featuregate.Gate) {
+		registered[g.ID()] = g
+	})
+
+	ids := make([]string, 0, len(gates))
+	for id := range gates {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	var errs error
+	for _, id := range ids {
+		enabled := gates[id]
+		g, ok := registered[id]
+		switch {
+		case !ok:
+			errs = multierr.Append(errs, fmt.Errorf("no such feature gate %q", id))
+		case g.Stage() == featuregate.StageStable && !enabled:
+			errs = multierr.Append(errs, fmt.Errorf("feature gate %q is stable, can not be disabled", id))
+		case g.Stage() == featuregate.StageDeprecated && enabled:
+			errs = multierr.Append(errs, fmt.Errorf("feature gate %q is deprecated, can not be enabled", id))
+		}
+		if flagEnabled, ok := featureGatesFromFlags[id]; ok && flagEnabled != enabled {
+			errs = multierr.Append(errs, fmt.Errorf("feature gate %q is set to %v with the --feature-gates flag and to %v in the configuration, remove one of them and restart the collector", id, flagEnabled, enabled))
+		}
+	}
+	return errs
+}
+
 func (col *Collector) reloadConfiguration(ctx context.Context) error {
 	col.service.Logger().Warn("Config updated, restart service")
 	col.setCollectorState(StateClosing)
@@ -258,7 +365,14 @@ func (col *Collector) DryRun(ctx context.Context) error {
 		return fmt.Errorf("failed to get config: %w", err)
 	}
 
-	return cfg.Validate()
+	if err = cfg.Validate(); err != nil {
+		return err
+	}
+
+	if err = validateFeatureGates(featuregate.GlobalRegistry(), cfg.Service.FeatureGates, col.set.featureGatesFromFlags); err != nil {
+		return fmt.Errorf("service::feature_gates: %w", err)
+	}
+	return nil
 }
 
 func newFallbackLogger(options []zap.Option) (*zap.Logger, error) {
@@ -23,6 +23,7 @@ import (
 	"go.opentelemetry.io/collector/component/componentstatus"
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/processor/processortest"
 )
 
@@ -461,6 +462,21 @@ func TestCollectorDryRun(t *testing.T) {
 			},
 			expectedErr: `service::pipelines::traces: references processor "invalid" which is not configured`,
 		},
+		"valid_feature_gates": {
+			settings: CollectorSettings{
+				BuildInfo:              component.NewDefaultBuildInfo(),
+				Factories:              nopFactories,
+				ConfigProviderSettings: newDefaultConfigProviderSettings(t, []string{filepath.Join("testdata", "otelcol-featuregates.yaml")}),
+			},
+		},
+		"invalid_feature_gates": {
+			settings: CollectorSettings{
+				BuildInfo:              component.NewDefaultBuildInfo(),
+				Factories:              nopFactories,
+				ConfigProviderSettings: newDefaultConfigProviderSettings(t, []string{filepath.Join("testdata", "otelcol-featuregates-invalid.yaml")}),
+			},
+			expectedErr: `service::feature_gates: feature gate "otelcol.test.stableGate" is stable, can not be disabled; no such feature gate "otelcol.test.unknownGate"`,
+		},
 	}
 
 	for name, test := range tests {
@@ -474,6 +490,8 @@ func TestCollectorDryRun(t *testing.T) {
 			} else {
 				require.EqualError(t, err, test.expectedErr)
 			}
+			// A dry run doesn't set the feature gates.
+			assert.False(t, configGate.IsEnabled())
 		})
 	}
 }
@@ -594,3 +612,66 @@ func newConfFromFile(t testing.TB, fileName string) map[string]any {
 
 	return confmap.NewFromStringMap(data).ToStringMap()
 }
+
+var (
+	configGate   = featuregate.GlobalRegistry().MustRegister("otelcol.test.configGate", featuregate.StageAlpha)
+	conflictGate = featuregate.GlobalRegistry().MustRegister("otelcol.test.conflictGate", featuregate.StageBeta)
+	reloadGate   = featuregate.GlobalRegistry().MustRegister("otelcol.test.reloadGate", featuregate.StageAlpha)
+	stableGate   = featuregate.GlobalRegistry().MustRegister("otelcol.test.stableGate", featuregate.StageStable,
+		featuregate.WithRegisterToVersion("v0.200.0"))
+)
+
+// restoreFeatureGates restores the state of the gates in the global registry at the end of the test.
+func restoreFeatureGates(t *testing.T, gates ...*featuregate.Gate) {
+	for _, g := range gates {
+		enabled := g.IsEnabled()
+		t.Cleanup(func() {
+			require.NoError(t, featuregate.GlobalRegistry().Set(g.ID(), enabled))
+		})
+	}
+}
+
+func TestCollectorFeatureGatesFromConfig(t *testing.T) {
+	restoreFeatureGates(t, configGate)
+	require.False(t, configGate.IsEnabled())
+	col, err := NewCollector(CollectorSettings{
+		BuildInfo:              component.NewDefaultBuildInfo(),
+		Factories:              nopFactories,
+		ConfigProviderSettings: newDefaultConfigProviderSettings(t, []string{filepath.Join("testdata", "otelcol-featuregates.yaml")}),
+	})
+	require.NoError(t, err)
+
+	wg := startCollector(context.Background(), t, col)
+	assert.Eventually(t, func() bool {
+		return StateRunning == col.GetState()
+	}, 2*time.Second, 200*time.Millisecond)
+	assert.True(t, configGate.IsEnabled())
+
+	col.Shutdown()
+	wg.Wait()
+	assert.Equal(t, StateClosed, col.GetState())
+}
+
+func TestCollectorApplyFeatureGates(t *testing.T) {
+	restoreFeatureGates(t, conflictGate, reloadGate)
+	col := &Collector{set: CollectorSettings{featureGatesFromFlags: map[string]bool{conflictGate.ID(): false}}}
+
+	err := col.applyFeatureGates(map[string]bool{conflictGate.ID(): true})
+	require.EqualError(t, err, `feature gate "otelcol.test.conflictGate" is set to false with the --feature-gates flag and to true in the configuration, remove one of them and restart the collector`)
+	require.EqualError(t, col.applyFeatureGates(map[string]bool{stableGate.ID(): false}), `feature gate "otelcol.test.stableGate" is stable, can not be disabled`)
+	require.ErrorContains(t, col.applyFeatureGates(map[string]bool{"otelcol.test.unknownGate": true}), `no such feature gate "otelcol.test.unknownGate"`)
+
+	// No gate is set if any of them is invalid.
+	require.Error(t, col.applyFeatureGates(map[string]bool{reloadGate.ID(): true, stableGate.ID(): false}))
+	assert.False(t, reloadGate.IsEnabled())
+
+	// Setting a gate to the same value as the flag is allowed.
+	require.NoError(t, col.applyFeatureGates(map[string]bool{conflictGate.ID(): false, reloadGate.ID(): true}))
+	assert.True(t, reloadGate.IsEnabled())
+
+	// Reloads keeping the same gates are allowed, changes require a restart.
+	require.NoError(t, col.applyFeatureGates(map[string]bool{reloadGate.ID(): true, conflictGate.ID(): false}))
+	err = col.applyFeatureGates(map[string]bool{reloadGate.ID(): false})
+	require.EqualError(t, err, "feature gates [otelcol.test.conflictGate otelcol.test.reloadGate] changed, the collector must be restarted to apply feature gate changes")
+	assert.True(t, reloadGate.IsEnabled())
+}
@@ -61,5 +61,7 @@ func updateSettingsUsingFlags(set *CollectorSettings, flags *flag.FlagSet) error
 	if len(resolverSet.ProviderFactories) == 0 {
 		return errors.New("at least one Provider must be supplied")
 	}
+
+	set.featureGatesFromFlags = getFeatureGatesFlag(flags)
 	return nil
 }
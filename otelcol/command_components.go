@@ -14,14 +14,22 @@ import (
 	"go.opentelemetry.io/collector/connector"
 	"go.opentelemetry.io/collector/exporter"
 	"go.opentelemetry.io/collector/extension"
+	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/receiver"
 )
 
 type componentWithStability struct {
-	Name      component.Type
-	Module    string
-	Stability map[string]string
+	Name         component.Type
+	Module       string
+	Stability    map[string]string
+	FeatureGates []componentFeatureGate `yaml:",omitempty"`
+}
+
+type componentFeatureGate struct {
+	ID      string
+	Stage   string
+	Enabled bool
 }
 
 type componentsOutput struct {
@@ -38,7 +46,7 @@ func newComponentsCommand(set CollectorSettings) *cobra.Command {
 	return &cobra.Command{
 		Use:   "components",
 		Short: "Outputs available components in this collector distribution",
-		Long:  "Outputs available components in this collector distribution including their stability levels. The output format is not stable and can change between releases.",
+		Long:  "Outputs available components in this collector distribution including their stability levels and feature gates. The output format is not stable and can change between releases.",
 		Args:  cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			factories, err := set.Factories()
@@ -46,11 +54,13 @@ func newComponentsCommand(set CollectorSettings) *cobra.Command {
 				return fmt.Errorf("failed to initialize factories: %w", err)
 			}
 
+			gates := componentFeatureGates(featuregate.GlobalRegistry())
 			components := componentsOutput{}
 			for _, con := range sortFactoriesByType[connector.Factory](factories.Connectors) {
 				components.Connectors = append(components.Connectors, componentWithStability{
-					Name:   con.Type(),
-					Module: factories.ConnectorModules[con.Type()],
+					Name:         con.Type(),
+					Module:       factories.ConnectorModules[con.Type()],
+					FeatureGates: gates["connector/"+con.Type().String()],
 					Stability: map[string]string{
 						"logs-to-logs":    con.LogsToLogsStability().String(),
 						"logs-to-metrics": con.LogsToMetricsStability().String(),
@@ -68,8 +78,9 @@ func newComponentsCommand(set CollectorSettings) *cobra.Command {
 			}
 			for _, ext := range sortFactoriesByType[extension.Factory](factories.Extensions) {
 				components.Extensions = append(components.Extensions, componentWithStability{
-					Name:   ext.Type(),
-					Module: factories.ExtensionModules[ext.Type()],
+					Name:         ext.Type(),
+					Module:       factories.ExtensionModules[ext.Type()],
+					FeatureGates: gates["extension/"+ext.Type().String()],
 					Stability: map[string]string{
 						"extension": ext.Stability().String(),
 					},
@@ -77,8 +88,9 @@ func newComponentsCommand(set CollectorSettings) *cobra.Command {
 			}
 			for _, prs := range sortFactoriesByType[processor.Factory](factories.Processors) {
 				components.Processors = append(components.Processors, componentWithStability{
-					Name:   prs.Type(),
-					Module: factories.ProcessorModules[prs.Type()],
+					Name:         prs.Type(),
+					Module:       factories.ProcessorModules[prs.Type()],
+					FeatureGates: gates["processor/"+prs.Type().String()],
 					Stability: map[string]string{
 						"logs":    prs.LogsStability().String(),
 						"metrics": prs.MetricsStability().String(),
@@ -88,8 +100,9 @@ func newComponentsCommand(set CollectorSettings) *cobra.Command {
 			}
 			for _, rcv := range sortFactoriesByType[receiver.Factory](factories.Receivers) {
 				components.Receivers = append(components.Receivers, componentWithStability{
-					Name:   rcv.Type(),
-					Module: factories.ReceiverModules[rcv.Type()],
+					Name:         rcv.Type(),
+					Module:       factories.ReceiverModules[rcv.Type()],
+					FeatureGates: gates["receiver/"+rcv.Type().String()],
 					Stability: map[string]string{
 						"logs":    rcv.LogsStability().String(),
 						"metrics": rcv.MetricsStability().String(),
@@ -99,8 +112,9 @@ func newComponentsCommand(set CollectorSettings) *cobra.Command {
 			}
 			for _, exp := range sortFactoriesByType[exporter.Factory](factories.Exporters) {
 				components.Exporters = append(components.Exporters, componentWithStability{
-					Name:   exp.Type(),
-					Module: factories.ExporterModules[exp.Type()],
+					Name:         exp.Type(),
+					Module:       factories.ExporterModules[exp.Type()],
+					FeatureGates: gates["exporter/"+exp.Type().String()],
 					Stability: map[string]string{
 						"logs":    exp.LogsStability().String(),
 						"metrics": exp.MetricsStability().String(),
@@ -119,6 +133,23 @@ func newComponentsCommand(set CollectorSettings) *cobra.Command {
 	}
 }
 
+// componentFeatureGates returns the feature gates of the registry owned by a component,
+// indexed by the "<kind>/<type>" ID of the component.
+func componentFeatureGates(reg *featuregate.Registry) map[string][]componentFeatureGate {
+	gates := map[string][]componentFeatureGate{}
+	reg.VisitAll(func(g *featuregate.Gate) {
+		if g.Component() == "" {
+			return
+		}
+		gates[g.Component()] = append(gates[g.Component()], componentFeatureGate{
+			ID:      g.ID(),
+			Stage:   g.Stage().String(),
+			Enabled: g.IsEnabled(),
+		})
+	})
+	return gates
+}
+
 func sortFactoriesByType[T component.Factory](factories map[component.Type]T) []T {
 	// Gather component types (factories map keys)
 	componentTypes := make([]component.Type, 0, len(factories))
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/featuregate"
 )
 
 func TestNewBuildSubCommand(t *testing.T) {
@@ -37,3 +38,22 @@ func TestNewBuildSubCommand(t *testing.T) {
 	// line that makes the test fail.
 	assert.Equal(t, strings.ReplaceAll(strings.ReplaceAll(string(ExpectedOutput), "\n", ""), "\r", ""), strings.ReplaceAll(strings.ReplaceAll(b.String(), "\n", ""), "\r", ""))
 }
+
+func TestComponentFeatureGates(t *testing.T) {
+	reg := featuregate.NewRegistry()
+	reg.MustRegister("global.gate", featuregate.StageAlpha)
+	reg.MustRegister("receiver.foo.beta", featuregate.StageBeta, featuregate.WithRegisterComponent("receiver/foo"))
+	reg.MustRegister("receiver.foo.alpha", featuregate.StageAlpha, featuregate.WithRegisterComponent("receiver/foo"))
+	reg.MustRegister("exporter.bar.stable", featuregate.StageStable,
+		featuregate.WithRegisterComponent("exporter/bar"), featuregate.WithRegisterToVersion("v0.120.0"))
+
+	assert.Equal(t, map[string][]componentFeatureGate{
+		"receiver/foo": {
+			{ID: "receiver.foo.alpha", Stage: "Alpha", Enabled: false},
+			{ID: "receiver.foo.beta", Stage: "Beta", Enabled: true},
+		},
+		"exporter/bar": {
+			{ID: "exporter.bar.stable", Stage: "Stable", Enabled: true},
+		},
+	}, componentFeatureGates(reg))
+}
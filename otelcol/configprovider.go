@@ -74,11 +74,27 @@ func NewConfigProvider(set ConfigProviderSettings) (ConfigProvider, error) {
 }
 
 func (cm *configProvider) Get(ctx context.Context, factories Factories) (*Config, error) {
+	return cm.get(ctx, factories, nil)
+}
+
+// get returns the service configuration like Get, calling applyFeatureGates with the service::feature_gates
+// of the resolved configuration before unmarshalling it, since components may read feature gates to do so.
+func (cm *configProvider) get(ctx context.Context, factories Factories, applyFeatureGates func(map[string]bool) error) (*Config, error) {
 	conf, err := cm.mapResolver.Resolve(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("cannot resolve the configuration: %w", err)
 	}
 
+	if applyFeatureGates != nil {
+		// Malformed feature gates are reported when unmarshalling the whole configuration below.
+		var gates map[string]bool
+		if sub, subErr := conf.Sub("service::feature_gates"); subErr == nil && sub.Unmarshal(&gates) == nil {
+			if err = applyFeatureGates(gates); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	var cfg *configSettings
 	if cfg, err = unmarshal(conf, factories); err != nil {
 		return nil, fmt.Errorf("cannot unmarshal the configuration: %w", err)
@@ -5,6 +5,7 @@ package otelcol
 
 import (
 	"context"
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -106,3 +107,22 @@ func TestConfigProviderFile(t *testing.T) {
 
 	assert.EqualValues(t, configNop, cfg)
 }
+
+func TestConfigProviderFeatureGatesBeforeUnmarshal(t *testing.T) {
+	cp, err := NewConfigProvider(newDefaultConfigProviderSettings(t, []string{filepath.Join("testdata", "otelcol-featuregates.yaml")}))
+	require.NoError(t, err)
+
+	var applied map[string]bool
+	// Without factories, unmarshalling fails: the feature gates must be applied before.
+	_, err = cp.(*configProvider).get(context.Background(), Factories{}, func(gates map[string]bool) error {
+		applied = gates
+		return nil
+	})
+	require.ErrorContains(t, err, "cannot unmarshal the configuration")
+	assert.Equal(t, map[string]bool{"otelcol.test.configGate": true}, applied)
+
+	_, err = cp.(*configProvider).get(context.Background(), Factories{}, func(map[string]bool) error {
+		return errors.New("invalid gates")
+	})
+	require.EqualError(t, err, "invalid gates")
+}
@@ -12,7 +12,8 @@ import (
 )
 
 const (
-	configFlag = "config"
+	configFlag       = "config"
+	featureGatesFlag = "feature-gates"
 )
 
 type configFlagValue struct {
@@ -50,9 +51,43 @@ func flags(reg *featuregate.Registry) *flag.FlagSet {
 		})
 
 	reg.RegisterFlags(flagSet)
+	fgFlag := flagSet.Lookup(featureGatesFlag)
+	fgFlag.Value = &featureGatesFlagValue{Value: fgFlag.Value, gates: map[string]bool{}}
 	return flagSet
 }
 
+// featureGatesFlagValue wraps the flag registered by featuregate.Registry.RegisterFlags to record the
+// gates set on the command line, so they can be checked against the service::feature_gates config.
+type featureGatesFlagValue struct {
+	flag.Value
+	gates map[string]bool
+}
+
+func (f *featureGatesFlagValue) Set(s string) error {
+	if err := f.Value.Set(s); err != nil {
+		return err
+	}
+	for _, id := range strings.Split(s, ",") {
+		if id == "" {
+			continue
+		}
+		enabled := true
+		switch id[0] {
+		case '-':
+			id = id[1:]
+			enabled = false
+		case '+':
+			id = id[1:]
+		}
+		f.gates[id] = enabled
+	}
+	return nil
+}
+
+func getFeatureGatesFlag(flagSet *flag.FlagSet) map[string]bool {
+	return flagSet.Lookup(featureGatesFlag).Value.(*featureGatesFlagValue).gates
+}
+
 func getConfigFlag(flagSet *flag.FlagSet) []string {
 	cfv := flagSet.Lookup(configFlag).Value.(*configFlagValue)
 	return append(cfv.values, cfv.sets...)
@@ -69,3 +69,16 @@ func TestSetFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestFeatureGatesFlag(t *testing.T) {
+	reg := featuregate.NewRegistry()
+	reg.MustRegister("alpha", featuregate.StageAlpha)
+	reg.MustRegister("beta", featuregate.StageBeta)
+	flgs := flags(reg)
+	require.NoError(t, flgs.Parse([]string{"--feature-gates=+alpha,-beta"}))
+	assert.Equal(t, map[string]bool{"alpha": true, "beta": false}, getFeatureGatesFlag(flgs))
+
+	flgs = flags(reg)
+	require.Error(t, flgs.Parse([]string{"--feature-gates=unknown"}))
+	assert.Empty(t, getFeatureGatesFlag(flgs))
+}
@@ -0,0 +1,270 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcoltest // import "go.opentelemetry.io/collector/otelcol/otelcoltest"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/provider/envprovider"
+	"go.opentelemetry.io/collector/confmap/provider/yamlprovider"
+	"go.opentelemetry.io/collector/otelcol"
+)
+
+const (
+	// harnessTimeout is the maximum time waited for the collector to change state.
+	harnessTimeout = 30 * time.Second
+
+	harnessScheme    = "harness"
+	harnessConfigURI = harnessScheme + ":config"
+
+	// harnessDefaults are overridden by the config of the harness. The telemetry metrics
+	// are disabled to avoid binding the default Prometheus port in tests.
+	harnessDefaults = "yaml:service::telemetry::metrics::level: none"
+)
+
+// Harness runs a collector in-process, for end-to-end tests of pipelines including
+// processors and connectors.
+//
+// The config of the harness can use receivers of the "memory" type, pushing the data
+// given to Receiver into the pipelines, and exporters of the "sink" type, capturing
+// the data exported by the pipelines for Sink, e.g.:
+//
+//	h := otelcoltest.NewHarness(t, factories, `
+//	receivers:
+//	  memory:
+//	processors:
+//	  batch:
+//	exporters:
+//	  sink:
+//	service:
+//	  pipelines:
+//	    traces:
+//	      receivers: [memory]
+//	      processors: [batch]
+//	      exporters: [sink]
+//	`)
+//	require.NoError(t, h.Receiver("memory").ConsumeTraces(ctx, td))
+//	assert.Eventually(t, func() bool { return h.Sink("sink").Traces().SpanCount() == td.SpanCount() }, time.Second, 10*time.Millisecond)
+type Harness struct {
+	tb         testing.TB
+	col        *otelcol.Collector
+	provider   *harnessProvider
+	components *harnessComponents
+
+	done         chan struct{}
+	runErr       error
+	shutdownOnce sync.Once
+}
+
+// NewHarness starts a collector with the given factories and YAML config, and waits for it to be running.
+// The "memory" receiver and "sink" exporter factories are added to the factories. The collector is shut
+// down when the test ends, if Shutdown wasn't called.
+func NewHarness(tb testing.TB, factories otelcol.Factories, config string) *Harness {
+	tb.Helper()
+	h := &Harness{
+		tb:         tb,
+		provider:   &harnessProvider{config: config},
+		components: newHarnessComponents(),
+		done:       make(chan struct{}),
+	}
+
+	factories, err := h.addFactories(factories)
+	if err != nil {
+		tb.Fatalf("failed to add the harness factories: %v", err)
+	}
+	h.col, err = otelcol.NewCollector(otelcol.CollectorSettings{
+		BuildInfo: component.NewDefaultBuildInfo(),
+		Factories: func() (otelcol.Factories, error) { return factories, nil },
+		ConfigProviderSettings: otelcol.ConfigProviderSettings{
+			ResolverSettings: confmap.ResolverSettings{
+				URIs: []string{harnessDefaults, harnessConfigURI},
+				ProviderFactories: []confmap.ProviderFactory{
+					confmap.NewProviderFactory(func(confmap.ProviderSettings) confmap.Provider { return h.provider }),
+					envprovider.NewFactory(),
+					yamlprovider.NewFactory(),
+				},
+			},
+		},
+		DisableGracefulShutdown: true,
+		LoggingOptions: []zap.Option{zap.WrapCore(func(zapcore.Core) zapcore.Core {
+			return zaptest.NewLogger(tb).Core()
+		})},
+		SkipSettingGRPCLogger: true,
+	})
+	if err != nil {
+		tb.Fatalf("failed to create the collector: %v", err)
+	}
+
+	go func() {
+		defer close(h.done)
+		h.runErr = h.col.Run(context.Background())
+	}()
+	tb.Cleanup(func() {
+		if err := h.Shutdown(); err != nil {
+			tb.Errorf("failed to shut down the collector: %v", err)
+		}
+	})
+	if err = h.waitRunning(); err != nil {
+		tb.Fatalf("failed to start the collector: %v", err)
+	}
+	return h
+}
+
+func (h *Harness) addFactories(factories otelcol.Factories) (otelcol.Factories, error) {
+	var err error
+	if factories.Receivers, err = addFactory(factories.Receivers, h.components.receiverFactory()); err != nil {
+		return factories, err
+	}
+	factories.Exporters, err = addFactory(factories.Exporters, h.components.exporterFactory())
+	return factories, err
+}
+
+func addFactory[F interface{ Type() component.Type }](factories map[component.Type]F, f F) (map[component.Type]F, error) {
+	if _, ok := factories[f.Type()]; ok {
+		return nil, fmt.Errorf("duplicate factory %q", f.Type())
+	}
+	// Clone the map to avoid modifying the one owned by the caller.
+	factories = maps.Clone(factories)
+	if factories == nil {
+		factories = map[component.Type]F{}
+	}
+	factories[f.Type()] = f
+	return factories, nil
+}
+
+// Collector returns the collector run by the harness.
+func (h *Harness) Collector() *otelcol.Collector {
+	return h.col
+}
+
+// Receiver returns the "memory" receiver with the given ID, e.g. "memory" or "memory/2".
+func (h *Harness) Receiver(id string) *MemoryReceiver {
+	h.tb.Helper()
+	return h.components.receiver(h.componentID(id, MemoryReceiverType))
+}
+
+// Sink returns the data captured by the "sink" exporter with the given ID, e.g. "sink" or "sink/2".
+func (h *Harness) Sink(id string) *Sink {
+	h.tb.Helper()
+	return h.components.sink(h.componentID(id, SinkExporterType))
+}
+
+func (h *Harness) componentID(id string, typ component.Type) component.ID {
+	h.tb.Helper()
+	var cid component.ID
+	if err := cid.UnmarshalText([]byte(id)); err != nil {
+		h.tb.Fatalf("invalid component ID %q: %v", id, err)
+	}
+	if cid.Type() != typ {
+		h.tb.Fatalf("component ID %q is not of type %q", id, typ)
+	}
+	return cid
+}
+
+// Reload replaces the config of the collector, and waits for the collector to be running with it.
+func (h *Harness) Reload(config string) {
+	h.tb.Helper()
+	retrieved := h.provider.update(config)
+	select {
+	case <-retrieved:
+	case <-h.done:
+		h.tb.Fatalf("the collector stopped before reloading its config: %v", h.runErr)
+	case <-time.After(harnessTimeout):
+		h.tb.Fatalf("timed out waiting for the collector to reload its config")
+	}
+	if err := h.waitRunning(); err != nil {
+		h.tb.Fatalf("failed to reload the collector: %v", err)
+	}
+}
+
+// Shutdown shuts down the collector, and returns the error returned by otelcol.Collector.Run.
+func (h *Harness) Shutdown() error {
+	h.shutdownOnce.Do(func() {
+		// A shutdown request is ignored while the config is reloaded.
+		for {
+			h.col.Shutdown()
+			select {
+			case <-h.done:
+				return
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	})
+	return h.runErr
+}
+
+func (h *Harness) waitRunning() error {
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	timeout := time.After(harnessTimeout)
+	for h.col.GetState() != otelcol.StateRunning {
+		select {
+		case <-h.done:
+			if h.runErr != nil {
+				return h.runErr
+			}
+			return errors.New("the collector stopped")
+		case <-timeout:
+			return errors.New("timed out waiting for the collector to be running")
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// harnessProvider is the confmap.Provider of the config of a Harness.
+type harnessProvider struct {
+	mu        sync.Mutex
+	config    string
+	watcher   confmap.WatcherFunc
+	retrieved chan struct{}
+}
+
+// update replaces the config, and returns a channel closed once the collector retrieved it.
+func (p *harnessProvider) update(config string) <-chan struct{} {
+	p.mu.Lock()
+	p.config = config
+	retrieved := make(chan struct{})
+	p.retrieved = retrieved
+	watcher := p.watcher
+	p.mu.Unlock()
+	if watcher != nil {
+		watcher(&confmap.ChangeEvent{})
+	}
+	return retrieved
+}
+
+func (p *harnessProvider) Retrieve(_ context.Context, uri string, watcher confmap.WatcherFunc) (*confmap.Retrieved, error) {
+	if uri != harnessConfigURI {
+		return nil, fmt.Errorf("%q uri is not supported by the harness provider", uri)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.watcher = watcher
+	if p.retrieved != nil {
+		close(p.retrieved)
+		p.retrieved = nil
+	}
+	return confmap.NewRetrievedFromYAML([]byte(p.config))
+}
+
+func (*harnessProvider) Scheme() string {
+	return harnessScheme
+}
+
+func (*harnessProvider) Shutdown(context.Context) error {
+	return nil
+}
@@ -0,0 +1,215 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcoltest // import "go.opentelemetry.io/collector/otelcol/otelcoltest"
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pipeline"
+	"go.opentelemetry.io/collector/receiver"
+)
+
+var (
+	// MemoryReceiverType is the type of the receivers pushing the data given to a Harness into the pipelines.
+	MemoryReceiverType = component.MustNewType("memory")
+	// SinkExporterType is the type of the exporters capturing the data exported by the pipelines of a Harness.
+	SinkExporterType = component.MustNewType("sink")
+
+	errReceiverNotRunning = errors.New("the receiver is not running in a pipeline of this signal")
+)
+
+// MemoryReceiver pushes data into the pipelines using a "memory" receiver.
+// It is kept across config reloads, pushing into the pipelines of the current config.
+type MemoryReceiver struct {
+	mu sync.RWMutex
+	// running holds the started component of each signal.
+	running map[pipeline.Signal]*memoryReceiverComponent
+}
+
+// ConsumeTraces pushes the traces into the traces pipelines of the receiver.
+func (r *MemoryReceiver) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
+	next, ok := r.consumer(pipeline.SignalTraces).(consumer.Traces)
+	if !ok {
+		return errReceiverNotRunning
+	}
+	return next.ConsumeTraces(ctx, td)
+}
+
+// ConsumeMetrics pushes the metrics into the metrics pipelines of the receiver.
+func (r *MemoryReceiver) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
+	next, ok := r.consumer(pipeline.SignalMetrics).(consumer.Metrics)
+	if !ok {
+		return errReceiverNotRunning
+	}
+	return next.ConsumeMetrics(ctx, md)
+}
+
+// ConsumeLogs pushes the logs into the logs pipelines of the receiver.
+func (r *MemoryReceiver) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	next, ok := r.consumer(pipeline.SignalLogs).(consumer.Logs)
+	if !ok {
+		return errReceiverNotRunning
+	}
+	return next.ConsumeLogs(ctx, ld)
+}
+
+func (r *MemoryReceiver) consumer(signal pipeline.Signal) any {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if c, ok := r.running[signal]; ok {
+		return c.next
+	}
+	return nil
+}
+
+// memoryReceiverComponent is the component of a MemoryReceiver for one signal, connecting
+// the receiver to the pipelines while it is started.
+type memoryReceiverComponent struct {
+	receiver *MemoryReceiver
+	signal   pipeline.Signal
+	next     any
+}
+
+func (c *memoryReceiverComponent) Start(context.Context, component.Host) error {
+	c.receiver.mu.Lock()
+	defer c.receiver.mu.Unlock()
+	c.receiver.running[c.signal] = c
+	return nil
+}
+
+func (c *memoryReceiverComponent) Shutdown(context.Context) error {
+	c.receiver.mu.Lock()
+	defer c.receiver.mu.Unlock()
+	if c.receiver.running[c.signal] == c {
+		delete(c.receiver.running, c.signal)
+	}
+	return nil
+}
+
+// Sink captures the data exported by a "sink" exporter.
+// It is kept across config reloads, accumulating the data exported with all the configs.
+type Sink struct {
+	traces  consumertest.TracesSink
+	metrics consumertest.MetricsSink
+	logs    consumertest.LogsSink
+}
+
+// Traces returns the sink of the exported traces.
+func (s *Sink) Traces() *consumertest.TracesSink {
+	return &s.traces
+}
+
+// Metrics returns the sink of the exported metrics.
+func (s *Sink) Metrics() *consumertest.MetricsSink {
+	return &s.metrics
+}
+
+// Logs returns the sink of the exported logs.
+func (s *Sink) Logs() *consumertest.LogsSink {
+	return &s.logs
+}
+
+// harnessComponents holds the receivers and sinks of a Harness, by component ID.
+type harnessComponents struct {
+	mu        sync.Mutex
+	receivers map[component.ID]*MemoryReceiver
+	sinks     map[component.ID]*Sink
+}
+
+func newHarnessComponents() *harnessComponents {
+	return &harnessComponents{
+		receivers: map[component.ID]*MemoryReceiver{},
+		sinks:     map[component.ID]*Sink{},
+	}
+}
+
+func (hc *harnessComponents) receiver(id component.ID) *MemoryReceiver {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	r, ok := hc.receivers[id]
+	if !ok {
+		r = &MemoryReceiver{running: map[pipeline.Signal]*memoryReceiverComponent{}}
+		hc.receivers[id] = r
+	}
+	return r
+}
+
+func (hc *harnessComponents) sink(id component.ID) *Sink {
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+	s, ok := hc.sinks[id]
+	if !ok {
+		s = &Sink{}
+		hc.sinks[id] = s
+	}
+	return s
+}
+
+type harnessConfig struct{}
+
+func createHarnessConfig() component.Config {
+	return &harnessConfig{}
+}
+
+func (hc *harnessComponents) receiverFactory() receiver.Factory {
+	create := func(set receiver.Settings, signal pipeline.Signal, next any) *memoryReceiverComponent {
+		return &memoryReceiverComponent{receiver: hc.receiver(set.ID), signal: signal, next: next}
+	}
+	return receiver.NewFactory(
+		MemoryReceiverType,
+		createHarnessConfig,
+		receiver.WithTraces(func(_ context.Context, set receiver.Settings, _ component.Config, next consumer.Traces) (receiver.Traces, error) {
+			return create(set, pipeline.SignalTraces, next), nil
+		}, component.StabilityLevelDevelopment),
+		receiver.WithMetrics(func(_ context.Context, set receiver.Settings, _ component.Config, next consumer.Metrics) (receiver.Metrics, error) {
+			return create(set, pipeline.SignalMetrics, next), nil
+		}, component.StabilityLevelDevelopment),
+		receiver.WithLogs(func(_ context.Context, set receiver.Settings, _ component.Config, next consumer.Logs) (receiver.Logs, error) {
+			return create(set, pipeline.SignalLogs, next), nil
+		}, component.StabilityLevelDevelopment),
+	)
+}
+
+type tracesSinkExporter struct {
+	component.StartFunc
+	component.ShutdownFunc
+	*consumertest.TracesSink
+}
+
+type metricsSinkExporter struct {
+	component.StartFunc
+	component.ShutdownFunc
+	*consumertest.MetricsSink
+}
+
+type logsSinkExporter struct {
+	component.StartFunc
+	component.ShutdownFunc
+	*consumertest.LogsSink
+}
+
+func (hc *harnessComponents) exporterFactory() exporter.Factory {
+	return exporter.NewFactory(
+		SinkExporterType,
+		createHarnessConfig,
+		exporter.WithTraces(func(_ context.Context, set exporter.Settings, _ component.Config) (exporter.Traces, error) {
+			return &tracesSinkExporter{TracesSink: hc.sink(set.ID).Traces()}, nil
+		}, component.StabilityLevelDevelopment),
+		exporter.WithMetrics(func(_ context.Context, set exporter.Settings, _ component.Config) (exporter.Metrics, error) {
+			return &metricsSinkExporter{MetricsSink: hc.sink(set.ID).Metrics()}, nil
+		}, component.StabilityLevelDevelopment),
+		exporter.WithLogs(func(_ context.Context, set exporter.Settings, _ component.Config) (exporter.Logs, error) {
+			return &logsSinkExporter{LogsSink: hc.sink(set.ID).Logs()}, nil
+		}, component.StabilityLevelDevelopment),
+	)
+}
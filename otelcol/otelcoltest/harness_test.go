@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otelcoltest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/connector"
+	"go.opentelemetry.io/collector/connector/forwardconnector"
+	"go.opentelemetry.io/collector/otelcol"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/batchprocessor"
+)
+
+const harnessTestConfig = `
+receivers:
+  memory:
+processors:
+  batch:
+    timeout: 10ms
+connectors:
+  forward:
+exporters:
+  sink:
+  sink/forwarded:
+service:
+  pipelines:
+    traces/in:
+      receivers: [memory]
+      processors: [batch]
+      exporters: [sink, forward]
+    traces/out:
+      receivers: [forward]
+      exporters: [sink/forwarded]
+`
+
+func newHarnessTestFactories(t *testing.T) otelcol.Factories {
+	factories, err := NopFactories()
+	require.NoError(t, err)
+	factories.Processors, err = processor.MakeFactoryMap(batchprocessor.NewFactory())
+	require.NoError(t, err)
+	factories.Connectors, err = connector.MakeFactoryMap(forwardconnector.NewFactory())
+	require.NoError(t, err)
+	return factories
+}
+
+func newTestTraces(name string) ptrace.Traces {
+	td := ptrace.NewTraces()
+	td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName(name)
+	return td
+}
+
+func TestHarness(t *testing.T) {
+	h := NewHarness(t, newHarnessTestFactories(t), harnessTestConfig)
+	assert.Equal(t, otelcol.StateRunning, h.Collector().GetState())
+
+	require.NoError(t, h.Receiver("memory").ConsumeTraces(context.Background(), newTestTraces("first")))
+	require.NoError(t, h.Receiver("memory").ConsumeTraces(context.Background(), newTestTraces("second")))
+	assert.Eventually(t, func() bool {
+		return h.Sink("sink").Traces().SpanCount() == 2 && h.Sink("sink/forwarded").Traces().SpanCount() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The receiver isn't in a logs pipeline.
+	require.Error(t, h.Receiver("memory").ConsumeLogs(context.Background(), plog.NewLogs()))
+
+	require.NoError(t, h.Shutdown())
+	assert.Equal(t, otelcol.StateClosed, h.Collector().GetState())
+	require.Error(t, h.Receiver("memory").ConsumeTraces(context.Background(), newTestTraces("after")))
+}
+
+func TestHarnessReload(t *testing.T) {
+	h := NewHarness(t, newHarnessTestFactories(t), harnessTestConfig)
+	require.NoError(t, h.Receiver("memory").ConsumeTraces(context.Background(), newTestTraces("before")))
+	assert.Eventually(t, func() bool { return h.Sink("sink").Traces().SpanCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	h.Reload(`
+receivers:
+  memory:
+exporters:
+  sink:
+service:
+  pipelines:
+    logs:
+      receivers: [memory]
+      exporters: [sink]
+`)
+	require.Error(t, h.Receiver("memory").ConsumeTraces(context.Background(), newTestTraces("after")))
+
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	require.NoError(t, h.Receiver("memory").ConsumeLogs(context.Background(), ld))
+	assert.Equal(t, 1, h.Sink("sink").Logs().LogRecordCount())
+	// The sinks keep the data exported with the previous config.
+	assert.Equal(t, 1, h.Sink("sink").Traces().SpanCount())
+}
+
+func TestHarnessDuplicateFactory(t *testing.T) {
+	factories, err := NopFactories()
+	require.NoError(t, err)
+	factories.Receivers[MemoryReceiverType] = factories.Receivers[nopType]
+	_, err = (&Harness{components: newHarnessComponents()}).addFactories(factories)
+	require.ErrorContains(t, err, `duplicate factory "memory"`)
+}
@@ -0,0 +1,196 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package golden reads and writes pdata as golden files, to snapshot the output of components in tests.
+//
+// The files are written as YAML when their extension is .yaml or .yml, and as JSON otherwise,
+// following the OTLP/JSON encoding with the object keys and the attributes sorted so that
+// the files are stable. The Assert functions compare data against a golden file, and rewrite
+// the file instead when the tests are run with the UPDATE_GOLDEN environment variable set to true, e.g.:
+//
+//	UPDATE_GOLDEN=true go test ./receiver/myreceiver
+//
+// An environment variable is used rather than an -update flag, since "go test ./... -update"
+// fails for the test binaries of the packages not importing this package.
+package golden // import "go.opentelemetry.io/collector/pdata/golden"
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// updateEnvVar is the environment variable which, when set to true, makes the Assert functions
+// update the golden files instead of comparing against them.
+const updateEnvVar = "UPDATE_GOLDEN"
+
+func shouldUpdate() bool {
+	update, _ := strconv.ParseBool(os.Getenv(updateEnvVar))
+	return update
+}
+
+// attributesKeys are the keys of the OTLP/JSON arrays holding attributes as key-value objects.
+var attributesKeys = map[string]bool{
+	"attributes":         true,
+	"filteredAttributes": true,
+	"metadata":           true,
+}
+
+func isYAML(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// encode converts OTLP/JSON into the stable file format of the path.
+func encode(path string, otlpJSON []byte) ([]byte, error) {
+	var v any
+	if err := json.Unmarshal(otlpJSON, &v); err != nil {
+		return nil, err
+	}
+	sortAttributes(v)
+	if isYAML(path) {
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		if err := enc.Encode(v); err != nil {
+			return nil, err
+		}
+		if err := enc.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	// Marshaling a map sorts its keys.
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+// decode converts the content of a file into OTLP/JSON.
+func decode(path string, content []byte) ([]byte, error) {
+	if !isYAML(path) {
+		return content, nil
+	}
+	var v any
+	if err := yaml.Unmarshal(content, &v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// sortAttributes sorts by key the attributes found in the decoded OTLP/JSON value.
+func sortAttributes(v any) {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, child := range t {
+			if attrs, ok := child.([]any); ok && attributesKeys[k] {
+				sort.SliceStable(attrs, func(i, j int) bool {
+					return attributeKey(attrs[i]) < attributeKey(attrs[j])
+				})
+			}
+			sortAttributes(child)
+		}
+	case []any:
+		for _, child := range t {
+			sortAttributes(child)
+		}
+	}
+}
+
+func attributeKey(v any) string {
+	if kv, ok := v.(map[string]any); ok {
+		if k, ok := kv["key"].(string); ok {
+			return k
+		}
+	}
+	return ""
+}
+
+func readFile(path string) ([]byte, error) {
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	otlpJSON, err := decode(path, content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %q: %w", path, err)
+	}
+	return otlpJSON, nil
+}
+
+func writeFile(path string, otlpJSON []byte) error {
+	content, err := encode(path, otlpJSON)
+	if err != nil {
+		return fmt.Errorf("failed to encode %q: %w", path, err)
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+	return os.WriteFile(path, content, 0o600)
+}
+
+// normalize returns the OTLP/JSON after a round trip through the file format of the path,
+// for the data to compare equal to the data read from a golden file.
+func normalize(path string, otlpJSON []byte) ([]byte, error) {
+	content, err := encode(path, otlpJSON)
+	if err != nil {
+		return nil, err
+	}
+	return decode(path, content)
+}
+
+func read[T any](path string, unmarshal func([]byte) (T, error)) (T, error) {
+	otlpJSON, err := readFile(path)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return unmarshal(otlpJSON)
+}
+
+func write[T any](path string, data T, marshal func(T) ([]byte, error)) error {
+	otlpJSON, err := marshal(data)
+	if err != nil {
+		return err
+	}
+	return writeFile(path, otlpJSON)
+}
+
+func assertGolden[T any](tb testing.TB, path string, actual T, marshal func(T) ([]byte, error), unmarshal func([]byte) (T, error), compare func(expected, actual T) error) {
+	tb.Helper()
+	if shouldUpdate() {
+		if err := write(path, actual, marshal); err != nil {
+			tb.Fatalf("failed to update the golden file %q: %v", path, err)
+		}
+		return
+	}
+	expected, err := read(path, unmarshal)
+	if err != nil {
+		tb.Fatalf("failed to read the golden file %q, run the tests with %s=true to create it: %v", path, updateEnvVar, err)
+		return
+	}
+	otlpJSON, err := marshal(actual)
+	if err == nil {
+		otlpJSON, err = normalize(path, otlpJSON)
+	}
+	if err == nil {
+		actual, err = unmarshal(otlpJSON)
+	}
+	if err != nil {
+		tb.Fatalf("failed to normalize the actual data: %v", err)
+		return
+	}
+	if err = compare(expected, actual); err != nil {
+		tb.Errorf("the data doesn't match the golden file %q, run the tests with %s=true to update it:\n%v", path, updateEnvVar, err)
+	}
+}
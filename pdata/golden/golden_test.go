@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package golden
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogtest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetrictest"
+	"go.opentelemetry.io/collector/pdata/pprofile/pprofiletest"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptracetest"
+	"go.opentelemetry.io/collector/pdata/testdata"
+)
+
+func TestWriteRead(t *testing.T) {
+	for _, ext := range []string{".yaml", ".yml", ".json"} {
+		t.Run(ext, func(t *testing.T) {
+			dir := t.TempDir()
+
+			td := testdata.GenerateTraces(2)
+			require.NoError(t, WriteTraces(filepath.Join(dir, "traces"+ext), td))
+			readTraces, err := ReadTraces(filepath.Join(dir, "traces"+ext))
+			require.NoError(t, err)
+			require.NoError(t, ptracetest.CompareTraces(td, readTraces, ptracetest.IgnoreAttributesOrder()))
+
+			md := testdata.GenerateMetricsAllTypes()
+			require.NoError(t, WriteMetrics(filepath.Join(dir, "metrics"+ext), md))
+			readMetrics, err := ReadMetrics(filepath.Join(dir, "metrics"+ext))
+			require.NoError(t, err)
+			require.NoError(t, pmetrictest.CompareMetrics(md, readMetrics, pmetrictest.IgnoreAttributesOrder()))
+
+			ld := testdata.GenerateLogs(2)
+			require.NoError(t, WriteLogs(filepath.Join(dir, "logs"+ext), ld))
+			readLogs, err := ReadLogs(filepath.Join(dir, "logs"+ext))
+			require.NoError(t, err)
+			require.NoError(t, plogtest.CompareLogs(ld, readLogs, plogtest.IgnoreAttributesOrder()))
+
+			pd := testdata.GenerateProfiles(2)
+			require.NoError(t, WriteProfiles(filepath.Join(dir, "nested", "profiles"+ext), pd))
+			readProfiles, err := ReadProfiles(filepath.Join(dir, "nested", "profiles"+ext))
+			require.NoError(t, err)
+			require.NoError(t, pprofiletest.CompareProfiles(pd, readProfiles, pprofiletest.IgnoreAttributesOrder()))
+		})
+	}
+}
+
+func TestWriteStable(t *testing.T) {
+	newLogs := func(keys ...string) plog.Logs {
+		ld := plog.NewLogs()
+		rl := ld.ResourceLogs().AppendEmpty()
+		lr := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		for _, k := range keys {
+			rl.Resource().Attributes().PutStr(k, "value")
+			lr.Attributes().PutStr(k, "value")
+		}
+		return ld
+	}
+	for _, ext := range []string{".yaml", ".json"} {
+		dir := t.TempDir()
+		require.NoError(t, WriteLogs(filepath.Join(dir, "a"+ext), newLogs("a", "b", "c")))
+		require.NoError(t, WriteLogs(filepath.Join(dir, "b"+ext), newLogs("c", "a", "b")))
+		a, err := os.ReadFile(filepath.Join(dir, "a"+ext))
+		require.NoError(t, err)
+		b, err := os.ReadFile(filepath.Join(dir, "b"+ext))
+		require.NoError(t, err)
+		assert.Equal(t, string(a), string(b))
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	_, err := ReadTraces(filepath.Join("testdata", "missing.yaml"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	path := filepath.Join(t.TempDir(), "invalid.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("resourceMetrics: ["), 0o600))
+	_, err = ReadMetrics(path)
+	require.ErrorContains(t, err, "failed to decode")
+}
+
+func TestAssertMetrics(t *testing.T) {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "test")
+	rm.Resource().Attributes().PutStr("host.name", "localhost")
+	m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	m.SetName("requests")
+	dp := m.SetEmptySum().DataPoints().AppendEmpty()
+	dp.SetIntValue(10)
+	dp.SetTimestamp(1)
+
+	// The golden file is written with sorted attributes, which doesn't fail the assertion.
+	AssertMetrics(t, filepath.Join("testdata", "metrics.yaml"), md)
+
+	dp.SetIntValue(11)
+	dp.SetTimestamp(2)
+	tb := &recordingTB{TB: t}
+	AssertMetrics(tb, filepath.Join("testdata", "metrics.yaml"), md, pmetrictest.IgnoreTimestamps())
+	require.Len(t, tb.errors, 1)
+	assert.Contains(t, tb.errors[0], `metric "requests": datapoint "map[]": value doesn't match expected: 10, actual: 11`)
+}
+
+func TestAssertUpdate(t *testing.T) {
+	t.Setenv(updateEnvVar, "true")
+
+	path := filepath.Join(t.TempDir(), "traces.json")
+	td := testdata.GenerateTraces(1)
+	AssertTraces(t, path, td)
+
+	t.Setenv(updateEnvVar, "false")
+	AssertTraces(t, path, td)
+
+	tb := &recordingTB{TB: t}
+	AssertLogs(tb, filepath.Join(t.TempDir(), "missing.yaml"), testdata.GenerateLogs(1))
+	require.Len(t, tb.fatals, 1)
+	assert.Contains(t, tb.fatals[0], "run the tests with UPDATE_GOLDEN=true to create it")
+}
+
+// recordingTB records the failures instead of failing the test.
+type recordingTB struct {
+	testing.TB
+	errors []string
+	fatals []string
+}
+
+func (tb *recordingTB) Helper() {}
+
+func (tb *recordingTB) Errorf(format string, args ...any) {
+	tb.errors = append(tb.errors, fmt.Sprintf(format, args...))
+}
+
+func (tb *recordingTB) Fatalf(format string, args ...any) {
+	tb.fatals = append(tb.fatals, fmt.Sprintf(format, args...))
+}
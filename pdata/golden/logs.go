@@ -0,0 +1,29 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package golden // import "go.opentelemetry.io/collector/pdata/golden"
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogtest"
+)
+
+// ReadLogs reads the logs from the golden file at path.
+func ReadLogs(path string) (plog.Logs, error) {
+	return read(path, (&plog.JSONUnmarshaler{}).UnmarshalLogs)
+}
+
+// WriteLogs writes the logs to the golden file at path, creating its directory if needed.
+func WriteLogs(path string, logs plog.Logs) error {
+	return write(path, logs, (&plog.JSONMarshaler{}).MarshalLogs)
+}
+
+// AssertLogs fails the test if the logs differ from the ones of the golden file at path.
+// With UPDATE_GOLDEN=true, the golden file is written with the logs instead.
+func AssertLogs(tb testing.TB, path string, actual plog.Logs, options ...plogtest.CompareLogsOption) {
+	tb.Helper()
+	assertGolden(tb, path, actual, (&plog.JSONMarshaler{}).MarshalLogs, (&plog.JSONUnmarshaler{}).UnmarshalLogs,
+		func(expected, actual plog.Logs) error { return plogtest.CompareLogs(expected, actual, options...) })
+}
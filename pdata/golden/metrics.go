@@ -0,0 +1,31 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package golden // import "go.opentelemetry.io/collector/pdata/golden"
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetrictest"
+)
+
+// ReadMetrics reads the metrics from the golden file at path.
+func ReadMetrics(path string) (pmetric.Metrics, error) {
+	return read(path, (&pmetric.JSONUnmarshaler{}).UnmarshalMetrics)
+}
+
+// WriteMetrics writes the metrics to the golden file at path, creating its directory if needed.
+func WriteMetrics(path string, metrics pmetric.Metrics) error {
+	return write(path, metrics, (&pmetric.JSONMarshaler{}).MarshalMetrics)
+}
+
+// AssertMetrics fails the test if the metrics differ from the ones of the golden file at path.
+// With UPDATE_GOLDEN=true, the golden file is written with the metrics instead.
+func AssertMetrics(tb testing.TB, path string, actual pmetric.Metrics, options ...pmetrictest.CompareMetricsOption) {
+	tb.Helper()
+	assertGolden(tb, path, actual, (&pmetric.JSONMarshaler{}).MarshalMetrics, (&pmetric.JSONUnmarshaler{}).UnmarshalMetrics,
+		func(expected, actual pmetric.Metrics) error {
+			return pmetrictest.CompareMetrics(expected, actual, options...)
+		})
+}
@@ -0,0 +1,31 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package golden // import "go.opentelemetry.io/collector/pdata/golden"
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/pprofile/pprofiletest"
+)
+
+// ReadProfiles reads the profiles from the golden file at path.
+func ReadProfiles(path string) (pprofile.Profiles, error) {
+	return read(path, (&pprofile.JSONUnmarshaler{}).UnmarshalProfiles)
+}
+
+// WriteProfiles writes the profiles to the golden file at path, creating its directory if needed.
+func WriteProfiles(path string, profiles pprofile.Profiles) error {
+	return write(path, profiles, (&pprofile.JSONMarshaler{}).MarshalProfiles)
+}
+
+// AssertProfiles fails the test if the profiles differ from the ones of the golden file at path.
+// With UPDATE_GOLDEN=true, the golden file is written with the profiles instead.
+func AssertProfiles(tb testing.TB, path string, actual pprofile.Profiles, options ...pprofiletest.CompareProfilesOption) {
+	tb.Helper()
+	assertGolden(tb, path, actual, (&pprofile.JSONMarshaler{}).MarshalProfiles, (&pprofile.JSONUnmarshaler{}).UnmarshalProfiles,
+		func(expected, actual pprofile.Profiles) error {
+			return pprofiletest.CompareProfiles(expected, actual, options...)
+		})
+}
@@ -0,0 +1,31 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package golden // import "go.opentelemetry.io/collector/pdata/golden"
+
+import (
+	"testing"
+
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptracetest"
+)
+
+// ReadTraces reads the traces from the golden file at path.
+func ReadTraces(path string) (ptrace.Traces, error) {
+	return read(path, (&ptrace.JSONUnmarshaler{}).UnmarshalTraces)
+}
+
+// WriteTraces writes the traces to the golden file at path, creating its directory if needed.
+func WriteTraces(path string, traces ptrace.Traces) error {
+	return write(path, traces, (&ptrace.JSONMarshaler{}).MarshalTraces)
+}
+
+// AssertTraces fails the test if the traces differ from the ones of the golden file at path.
+// With UPDATE_GOLDEN=true, the golden file is written with the traces instead.
+func AssertTraces(tb testing.TB, path string, actual ptrace.Traces, options ...ptracetest.CompareTracesOption) {
+	tb.Helper()
+	assertGolden(tb, path, actual, (&ptrace.JSONMarshaler{}).MarshalTraces, (&ptrace.JSONUnmarshaler{}).UnmarshalTraces,
+		func(expected, actual ptrace.Traces) error {
+			return ptracetest.CompareTraces(expected, actual, options...)
+		})
+}
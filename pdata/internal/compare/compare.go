@@ -0,0 +1,251 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package compare holds the helpers shared by the packages comparing pdata signals.
+package compare // import "go.opentelemetry.io/collector/pdata/internal/compare"
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// Options configures the differences ignored by a comparison.
+type Options struct {
+	// IgnoreTimestamps ignores all the timestamps.
+	IgnoreTimestamps bool
+	// IgnoreStartTimestamps ignores the start timestamps of the data points.
+	IgnoreStartTimestamps bool
+	// IgnoreResourcesOrder ignores the order of the resources.
+	IgnoreResourcesOrder bool
+	// IgnoreScopesOrder ignores the order of the scopes in a resource.
+	IgnoreScopesOrder bool
+	// IgnoreRecordsOrder ignores the order of the spans, metrics, log records or profiles in a scope.
+	IgnoreRecordsOrder bool
+	// IgnoreDataPointsOrder ignores the order of the data points of a metric.
+	IgnoreDataPointsOrder bool
+	// IgnoreAttributesOrder ignores the order of the attributes.
+	IgnoreAttributesOrder bool
+	// IgnoreIDs ignores the trace, span and profile IDs.
+	IgnoreIDs bool
+}
+
+// Prefix qualifies each of the differences joined in err with the given path element.
+func Prefix(prefix string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs := joined.Unwrap()
+		prefixed := make([]error, 0, len(errs))
+		for _, e := range errs {
+			prefixed = append(prefixed, Prefix(prefix, e))
+		}
+		return errors.Join(prefixed...)
+	}
+	return fmt.Errorf("%s: %w", prefix, err)
+}
+
+// Equal reports a difference between two comparable values.
+func Equal[T comparable](name string, expected, actual T) error {
+	if expected == actual {
+		return nil
+	}
+	return fmt.Errorf("%s doesn't match expected: %s, actual: %s", name, format(expected), format(actual))
+}
+
+// Raw reports a difference between two values compared deeply, e.g. the result of AsRaw.
+func Raw(name string, expected, actual any) error {
+	if reflect.DeepEqual(expected, actual) {
+		return nil
+	}
+	return fmt.Errorf("%s doesn't match expected: %s, actual: %s", name, format(expected), format(actual))
+}
+
+func format(v any) string {
+	if s, ok := v.(string); ok {
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(v)
+}
+
+// Timestamp reports a difference between two timestamps, unless they are ignored.
+func Timestamp(opts *Options, name string, expected, actual pcommon.Timestamp) error {
+	if opts.IgnoreTimestamps {
+		return nil
+	}
+	return Equal(name, expected, actual)
+}
+
+// StartTimestamp reports a difference between two start timestamps, unless they are ignored.
+func StartTimestamp(opts *Options, name string, expected, actual pcommon.Timestamp) error {
+	if opts.IgnoreStartTimestamps {
+		return nil
+	}
+	return Timestamp(opts, name, expected, actual)
+}
+
+// ID reports a difference between two trace, span or profile IDs, unless they are ignored.
+func ID[T comparable](opts *Options, name string, expected, actual T) error {
+	if opts.IgnoreIDs {
+		return nil
+	}
+	return Equal(name, expected, actual)
+}
+
+// Value reports a difference between two values.
+func Value(name string, expected, actual pcommon.Value) error {
+	if expected.Type() != actual.Type() {
+		return fmt.Errorf("%s type doesn't match expected: %s, actual: %s", name, expected.Type(), actual.Type())
+	}
+	return Raw(name, expected.AsRaw(), actual.AsRaw())
+}
+
+// Attributes reports the differences between two attribute maps.
+func Attributes(opts *Options, expected, actual pcommon.Map) error {
+	var errs []error
+	expected.Range(func(k string, ev pcommon.Value) bool {
+		av, ok := actual.Get(k)
+		if !ok {
+			errs = append(errs, fmt.Errorf("missing expected attribute %q", k))
+			return true
+		}
+		errs = append(errs, Value(fmt.Sprintf("attribute %q", k), ev, av))
+		return true
+	})
+	actual.Range(func(k string, _ pcommon.Value) bool {
+		if _, ok := expected.Get(k); !ok {
+			errs = append(errs, fmt.Errorf("unexpected attribute %q", k))
+		}
+		return true
+	})
+	if err := errors.Join(errs...); err != nil || opts.IgnoreAttributesOrder {
+		return err
+	}
+	expectedKeys, actualKeys := keys(expected), keys(actual)
+	if !reflect.DeepEqual(expectedKeys, actualKeys) {
+		return fmt.Errorf("attributes are out of order: expected %q, actual %q", expectedKeys, actualKeys)
+	}
+	return nil
+}
+
+func keys(m pcommon.Map) []string {
+	ks := make([]string, 0, m.Len())
+	m.Range(func(k string, _ pcommon.Value) bool {
+		ks = append(ks, k)
+		return true
+	})
+	return ks
+}
+
+// ResourceID identifies a resource by its attributes.
+func ResourceID(res pcommon.Resource) string {
+	return fmt.Sprint(res.Attributes().AsRaw())
+}
+
+// Resource reports the differences between two resources.
+func Resource(opts *Options, expected, actual pcommon.Resource) error {
+	return errors.Join(
+		Attributes(opts, expected.Attributes(), actual.Attributes()),
+		Equal("dropped attributes count", expected.DroppedAttributesCount(), actual.DroppedAttributesCount()),
+	)
+}
+
+// ScopeID identifies an instrumentation scope by its name and version.
+func ScopeID(scope pcommon.InstrumentationScope) string {
+	if scope.Version() == "" {
+		return scope.Name()
+	}
+	return scope.Name() + "@" + scope.Version()
+}
+
+// Scope reports the differences between two instrumentation scopes.
+func Scope(opts *Options, expected, actual pcommon.InstrumentationScope) error {
+	return errors.Join(
+		Equal("name", expected.Name(), actual.Name()),
+		Equal("version", expected.Version(), actual.Version()),
+		Attributes(opts, expected.Attributes(), actual.Attributes()),
+		Equal("dropped attributes count", expected.DroppedAttributesCount(), actual.DroppedAttributesCount()),
+	)
+}
+
+// Slice reports the differences between two slices of kind elements.
+//
+// The elements are paired by the identifier returned by id, and each pair is compared
+// with compare. The differences of a pair are qualified with the kind and identifier
+// of the elements. The missing and unexpected elements are reported instead of comparing
+// the pairs, and the elements paired at different indexes are reported as out of order
+// when ordered is set.
+func Slice[E any](kind string, ordered bool, expLen, actLen int, expAt, actAt func(int) E, id func(E) string, compare func(expected, actual E) error) error {
+	var errs []error
+	if expLen != actLen {
+		errs = append(errs, fmt.Errorf("number of %ss doesn't match expected: %d, actual: %d", kind, expLen, actLen))
+	}
+
+	actIDs := make([]string, actLen)
+	for a := 0; a < actLen; a++ {
+		actIDs[a] = id(actAt(a))
+	}
+	matched := make([]int, expLen)
+	used := make([]bool, actLen)
+	for e := 0; e < expLen; e++ {
+		eid := id(expAt(e))
+		matched[e] = -1
+		// Prefer the element at the same index, to pair duplicated identifiers in order.
+		if e < actLen && !used[e] && actIDs[e] == eid {
+			matched[e], used[e] = e, true
+			continue
+		}
+		for a := 0; a < actLen; a++ {
+			if !used[a] && actIDs[a] == eid {
+				matched[e], used[a] = a, true
+				break
+			}
+		}
+		if matched[e] == -1 {
+			errs = append(errs, fmt.Errorf("missing expected %s %q", kind, eid))
+		}
+	}
+	for a := 0; a < actLen; a++ {
+		if !used[a] {
+			errs = append(errs, fmt.Errorf("unexpected %s %q", kind, actIDs[a]))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	for e, a := range matched {
+		if ordered && e != a {
+			errs = append(errs, fmt.Errorf("%ss are out of order: %s %q expected at index %d, found at index %d", kind, kind, actIDs[a], e, a))
+		}
+		errs = append(errs, Prefix(fmt.Sprintf("%s %q", kind, actIDs[a]), compare(expAt(e), actAt(a))))
+	}
+	return errors.Join(errs...)
+}
+
+// Positional reports the differences between two slices of kind elements compared by index.
+func Positional[E any](kind string, expLen, actLen int, expAt, actAt func(int) E, compare func(expected, actual E) error) error {
+	if expLen != actLen {
+		return fmt.Errorf("number of %ss doesn't match expected: %d, actual: %d", kind, expLen, actLen)
+	}
+	var errs []error
+	for i := 0; i < expLen; i++ {
+		errs = append(errs, Prefix(fmt.Sprintf("%s[%d]", kind, i), compare(expAt(i), actAt(i))))
+	}
+	return errors.Join(errs...)
+}
+
+// Optional reports a difference between two optional values.
+func Optional[T comparable](name string, expectedSet bool, expected T, actualSet bool, actual T) error {
+	if expectedSet != actualSet {
+		return fmt.Errorf("%s presence doesn't match expected: %t, actual: %t", name, expectedSet, actualSet)
+	}
+	if !expectedSet {
+		return nil
+	}
+	return Equal(name, expected, actual)
+}
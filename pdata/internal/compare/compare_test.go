@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package compare
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestPrefix(t *testing.T) {
+	require.NoError(t, Prefix("a", nil))
+	err := Prefix("a", errors.Join(errors.New("x"), Prefix("b", errors.Join(errors.New("y"), errors.New("z")))))
+	assert.EqualError(t, err, "a: x\na: b: y\na: b: z")
+}
+
+func TestSlice(t *testing.T) {
+	at := func(s []string) func(int) string { return func(i int) string { return s[i] } }
+	id := func(s string) string { return s }
+	compareNone := func(string, string) error { return nil }
+
+	require.NoError(t, Slice("item", true, 3, 3, at([]string{"a", "a", "b"}), at([]string{"a", "a", "b"}), id, compareNone))
+	require.NoError(t, Slice("item", false, 2, 2, at([]string{"a", "b"}), at([]string{"b", "a"}), id, compareNone))
+	assert.EqualError(t, Slice("item", true, 2, 2, at([]string{"a", "b"}), at([]string{"b", "a"}), id, compareNone),
+		"items are out of order: item \"a\" expected at index 0, found at index 1\n"+
+			"items are out of order: item \"b\" expected at index 1, found at index 0")
+	assert.EqualError(t, Slice("item", true, 1, 2, at([]string{"a"}), at([]string{"c", "b"}), id, compareNone),
+		"number of items doesn't match expected: 1, actual: 2\n"+
+			"missing expected item \"a\"\n"+
+			"unexpected item \"c\"\n"+
+			"unexpected item \"b\"")
+	assert.EqualError(t, Slice("item", true, 1, 1, at([]string{"a"}), at([]string{"a"}), id, func(string, string) error { return errors.New("differs") }),
+		"item \"a\": differs")
+}
+
+func TestAttributes(t *testing.T) {
+	expected, actual := pcommon.NewMap(), pcommon.NewMap()
+	expected.PutStr("a", "1")
+	expected.PutInt("b", 2)
+	actual.PutInt("b", 2)
+	actual.PutStr("a", "1")
+	assert.EqualError(t, Attributes(&Options{}, expected, actual), `attributes are out of order: expected ["a" "b"], actual ["b" "a"]`)
+	require.NoError(t, Attributes(&Options{IgnoreAttributesOrder: true}, expected, actual))
+
+	actual.PutStr("b", "2")
+	assert.EqualError(t, Attributes(&Options{}, expected, actual), `attribute "b" type doesn't match expected: Int, actual: Str`)
+}
+
+func TestOptional(t *testing.T) {
+	require.NoError(t, Optional("sum", false, 1.0, false, 2.0))
+	assert.EqualError(t, Optional("sum", true, 1.0, false, 0.0), "sum presence doesn't match expected: true, actual: false")
+	assert.EqualError(t, Optional("sum", true, 1.0, true, 2.0), "sum doesn't match expected: 1, actual: 2")
+}
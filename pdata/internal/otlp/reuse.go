@@ -0,0 +1,176 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlp // import "go.opentelemetry.io/collector/pdata/internal/otlp"
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+
+	otlpcollectorlog "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/logs/v1"
+	otlpcollectormetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/metrics/v1"
+	otlpcollectortrace "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/trace/v1"
+	otlpcommon "go.opentelemetry.io/collector/pdata/internal/data/protogen/common/v1"
+	otlplogs "go.opentelemetry.io/collector/pdata/internal/data/protogen/logs/v1"
+	otlpmetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/metrics/v1"
+	otlpresource "go.opentelemetry.io/collector/pdata/internal/data/protogen/resource/v1"
+	otlptrace "go.opentelemetry.io/collector/pdata/internal/data/protogen/trace/v1"
+)
+
+// The functions below decode export requests reusing the memory of the requests previously decoded
+// into the same message, down to the individual spans, log records and metrics.
+//
+// Messages are reset by the Reset* functions, which keep the elements of their repeated fields past
+// the length of the slices, up to their capacity. The Unmarshal*Reusing functions decode into these
+// elements instead of allocating new ones. Elements past the length of a slice but within its capacity
+// MUST have been reset, and MUST NOT be referenced anywhere else.
+
+// scopesFieldNumber is the field number of the repeated scope field in all OTLP resource messages
+// (ResourceSpans.scope_spans, ResourceLogs.scope_logs, ...). The repeated spans, log records and metrics
+// have the same field number in their scope message.
+const scopesFieldNumber = 2
+
+// ResetTracesRequest resets orig, keeping its memory to decode other requests with UnmarshalTracesRequestReusing.
+func ResetTracesRequest(orig *otlpcollectortrace.ExportTraceServiceRequest) {
+	*orig = otlpcollectortrace.ExportTraceServiceRequest{ResourceSpans: resetElems(orig.ResourceSpans, func(rs *otlptrace.ResourceSpans) {
+		*rs = otlptrace.ResourceSpans{
+			Resource: resetResource(rs.Resource),
+			ScopeSpans: resetElems(rs.ScopeSpans, func(ss *otlptrace.ScopeSpans) {
+				*ss = otlptrace.ScopeSpans{
+					Scope: resetScope(ss.Scope),
+					Spans: resetElems(ss.Spans, func(s *otlptrace.Span) {
+						*s = otlptrace.Span{Attributes: resetAttributes(s.Attributes)}
+					}),
+				}
+			}),
+		}
+	})}
+}
+
+// UnmarshalTracesRequestReusing decodes buf into orig, which must have been reset with ResetTracesRequest.
+func UnmarshalTracesRequestReusing(orig *otlpcollectortrace.ExportTraceServiceRequest, buf []byte) error {
+	return unmarshalRepeated(buf, resourcesFieldNumber, &orig.ResourceSpans, orig.Unmarshal, func(rs *otlptrace.ResourceSpans, buf []byte) error {
+		return unmarshalRepeated(buf, scopesFieldNumber, &rs.ScopeSpans, rs.Unmarshal, func(ss *otlptrace.ScopeSpans, buf []byte) error {
+			return unmarshalRepeated(buf, scopesFieldNumber, &ss.Spans, ss.Unmarshal, (*otlptrace.Span).Unmarshal)
+		})
+	})
+}
+
+// ResetLogsRequest resets orig, keeping its memory to decode other requests with UnmarshalLogsRequestReusing.
+func ResetLogsRequest(orig *otlpcollectorlog.ExportLogsServiceRequest) {
+	*orig = otlpcollectorlog.ExportLogsServiceRequest{ResourceLogs: resetElems(orig.ResourceLogs, func(rl *otlplogs.ResourceLogs) {
+		*rl = otlplogs.ResourceLogs{
+			Resource: resetResource(rl.Resource),
+			ScopeLogs: resetElems(rl.ScopeLogs, func(sl *otlplogs.ScopeLogs) {
+				*sl = otlplogs.ScopeLogs{
+					Scope: resetScope(sl.Scope),
+					LogRecords: resetElems(sl.LogRecords, func(lr *otlplogs.LogRecord) {
+						*lr = otlplogs.LogRecord{Attributes: resetAttributes(lr.Attributes)}
+					}),
+				}
+			}),
+		}
+	})}
+}
+
+// UnmarshalLogsRequestReusing decodes buf into orig, which must have been reset with ResetLogsRequest.
+func UnmarshalLogsRequestReusing(orig *otlpcollectorlog.ExportLogsServiceRequest, buf []byte) error {
+	return unmarshalRepeated(buf, resourcesFieldNumber, &orig.ResourceLogs, orig.Unmarshal, func(rl *otlplogs.ResourceLogs, buf []byte) error {
+		return unmarshalRepeated(buf, scopesFieldNumber, &rl.ScopeLogs, rl.Unmarshal, func(sl *otlplogs.ScopeLogs, buf []byte) error {
+			return unmarshalRepeated(buf, scopesFieldNumber, &sl.LogRecords, sl.Unmarshal, (*otlplogs.LogRecord).Unmarshal)
+		})
+	})
+}
+
+// ResetMetricsRequest resets orig, keeping its memory to decode other requests with UnmarshalMetricsRequestReusing.
+func ResetMetricsRequest(orig *otlpcollectormetrics.ExportMetricsServiceRequest) {
+	*orig = otlpcollectormetrics.ExportMetricsServiceRequest{ResourceMetrics: resetElems(orig.ResourceMetrics, func(rm *otlpmetrics.ResourceMetrics) {
+		*rm = otlpmetrics.ResourceMetrics{
+			Resource: resetResource(rm.Resource),
+			ScopeMetrics: resetElems(rm.ScopeMetrics, func(sm *otlpmetrics.ScopeMetrics) {
+				*sm = otlpmetrics.ScopeMetrics{
+					Scope: resetScope(sm.Scope),
+					Metrics: resetElems(sm.Metrics, func(m *otlpmetrics.Metric) {
+						// The data points are held by the oneof data field, which is allocated for each metric.
+						*m = otlpmetrics.Metric{Metadata: resetAttributes(m.Metadata)}
+					}),
+				}
+			}),
+		}
+	})}
+}
+
+// UnmarshalMetricsRequestReusing decodes buf into orig, which must have been reset with ResetMetricsRequest.
+func UnmarshalMetricsRequestReusing(orig *otlpcollectormetrics.ExportMetricsServiceRequest, buf []byte) error {
+	return unmarshalRepeated(buf, resourcesFieldNumber, &orig.ResourceMetrics, orig.Unmarshal, func(rm *otlpmetrics.ResourceMetrics, buf []byte) error {
+		return unmarshalRepeated(buf, scopesFieldNumber, &rm.ScopeMetrics, rm.Unmarshal, func(sm *otlpmetrics.ScopeMetrics, buf []byte) error {
+			return unmarshalRepeated(buf, scopesFieldNumber, &sm.Metrics, sm.Unmarshal, (*otlpmetrics.Metric).Unmarshal)
+		})
+	})
+}
+
+// resetElems resets the elements of elems and returns an empty slice whose capacity holds them.
+// Only the elements up to the length of elems are kept: the ones past it may be referenced by
+// the elements before them, e.g. after removing elements from the slice.
+func resetElems[T any](elems []*T, reset func(*T)) []*T {
+	for _, elem := range elems {
+		reset(elem)
+	}
+	return elems[:0:len(elems)]
+}
+
+func resetResource(r otlpresource.Resource) otlpresource.Resource {
+	return otlpresource.Resource{Attributes: resetAttributes(r.Attributes)}
+}
+
+func resetScope(s otlpcommon.InstrumentationScope) otlpcommon.InstrumentationScope {
+	return otlpcommon.InstrumentationScope{Attributes: resetAttributes(s.Attributes)}
+}
+
+// resetAttributes drops the values of attrs, and returns an empty slice keeping its capacity.
+// The generated Unmarshal functions append to the attributes, which reuses the capacity.
+func resetAttributes(attrs []otlpcommon.KeyValue) []otlpcommon.KeyValue {
+	clear(attrs)
+	return attrs[:0]
+}
+
+// unmarshalRepeated decodes the message buf field by field. The occurrences of the repeated message field num
+// are decoded by unmarshalElem into elements appended to elems, reusing the elements past its length.
+// The other fields are merged into the message by unmarshalOther, the Unmarshal function generated for it.
+func unmarshalRepeated[T any](buf []byte, num protowire.Number, elems *[]*T, unmarshalOther func([]byte) error, unmarshalElem func(*T, []byte) error) error {
+	for len(buf) > 0 {
+		fieldNum, wireType, tagLen := protowire.ConsumeTag(buf)
+		if tagLen < 0 {
+			return protowire.ParseError(tagLen)
+		}
+		valueLen := protowire.ConsumeFieldValue(fieldNum, wireType, buf[tagLen:])
+		if valueLen < 0 {
+			return protowire.ParseError(valueLen)
+		}
+		field := buf[:tagLen+valueLen]
+		buf = buf[tagLen+valueLen:]
+
+		if fieldNum != num || wireType != protowire.BytesType {
+			if err := unmarshalOther(field); err != nil {
+				return err
+			}
+			continue
+		}
+		value, _ := protowire.ConsumeBytes(field[tagLen:])
+		if err := unmarshalElem(nextElem(elems), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextElem appends an element to elems and returns it. The element past the length of elems is reused if any.
+func nextElem[T any](elems *[]*T) *T {
+	s := *elems
+	if n := len(s); n < cap(s) && s[:n+1][n] != nil {
+		*elems = s[:n+1]
+		return (*elems)[n]
+	}
+	elem := new(T)
+	*elems = append(s, elem)
+	return elem
+}
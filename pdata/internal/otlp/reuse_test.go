@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	otlpcollectorlog "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/logs/v1"
+	otlpcollectormetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/metrics/v1"
+	otlpcollectortrace "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/trace/v1"
+	otlpcommon "go.opentelemetry.io/collector/pdata/internal/data/protogen/common/v1"
+	otlplogs "go.opentelemetry.io/collector/pdata/internal/data/protogen/logs/v1"
+	otlpmetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/metrics/v1"
+	otlpresource "go.opentelemetry.io/collector/pdata/internal/data/protogen/resource/v1"
+	otlptrace "go.opentelemetry.io/collector/pdata/internal/data/protogen/trace/v1"
+)
+
+func stringAttr(key, value string) otlpcommon.KeyValue {
+	return otlpcommon.KeyValue{Key: key, Value: otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_StringValue{StringValue: value}}}
+}
+
+func TestUnmarshalTracesRequestReusing(t *testing.T) {
+	large := &otlpcollectortrace.ExportTraceServiceRequest{ResourceSpans: []*otlptrace.ResourceSpans{
+		{
+			Resource: otlpresource.Resource{Attributes: []otlpcommon.KeyValue{stringAttr("service.name", "a")}},
+			ScopeSpans: []*otlptrace.ScopeSpans{{
+				Scope: otlpcommon.InstrumentationScope{Name: "scope"},
+				Spans: []*otlptrace.Span{
+					{Name: "a1", Attributes: []otlpcommon.KeyValue{stringAttr("k1", "v1"), stringAttr("k2", "v2")}},
+					{Name: "a2", Events: []*otlptrace.Span_Event{{Name: "event"}}},
+				},
+			}},
+			SchemaUrl: "https://opentelemetry.io/schemas/1.0.0",
+		},
+		{Resource: otlpresource.Resource{Attributes: []otlpcommon.KeyValue{stringAttr("service.name", "b")}}},
+	}}
+	small := &otlpcollectortrace.ExportTraceServiceRequest{ResourceSpans: []*otlptrace.ResourceSpans{{
+		ScopeSpans: []*otlptrace.ScopeSpans{{
+			Spans: []*otlptrace.Span{{Name: "b1", Attributes: []otlpcommon.KeyValue{stringAttr("k3", "v3")}}},
+		}},
+	}}}
+
+	orig := &otlpcollectortrace.ExportTraceServiceRequest{}
+	buf, err := large.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, UnmarshalTracesRequestReusing(orig, buf))
+	assert.Equal(t, large, orig)
+	span := orig.ResourceSpans[0].ScopeSpans[0].Spans[0]
+
+	ResetTracesRequest(orig)
+	assert.Empty(t, orig.ResourceSpans)
+	assert.Empty(t, span.Name)
+	assert.Empty(t, span.Attributes)
+	assert.Equal(t, 2, cap(span.Attributes))
+
+	buf, err = small.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, UnmarshalTracesRequestReusing(orig, buf))
+	got, err := orig.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, buf, got)
+	assert.Same(t, span, orig.ResourceSpans[0].ScopeSpans[0].Spans[0])
+
+	ResetTracesRequest(orig)
+	require.Error(t, UnmarshalTracesRequestReusing(orig, []byte{0x0a, 0x02, 0x12, 0xff}))
+}
+
+func TestUnmarshalLogsRequestReusing(t *testing.T) {
+	large := &otlpcollectorlog.ExportLogsServiceRequest{ResourceLogs: []*otlplogs.ResourceLogs{{
+		ScopeLogs: []*otlplogs.ScopeLogs{{
+			LogRecords: []*otlplogs.LogRecord{
+				{SeverityText: "INFO", Attributes: []otlpcommon.KeyValue{stringAttr("k1", "v1")}},
+				{Body: otlpcommon.AnyValue{Value: &otlpcommon.AnyValue_StringValue{StringValue: "body"}}},
+			},
+		}},
+	}}}
+	small := &otlpcollectorlog.ExportLogsServiceRequest{ResourceLogs: []*otlplogs.ResourceLogs{{
+		ScopeLogs: []*otlplogs.ScopeLogs{{
+			LogRecords: []*otlplogs.LogRecord{{SeverityText: "WARN"}},
+		}},
+	}}}
+
+	orig := &otlpcollectorlog.ExportLogsServiceRequest{}
+	buf, err := large.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, UnmarshalLogsRequestReusing(orig, buf))
+	assert.Equal(t, large, orig)
+	record := orig.ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+
+	ResetLogsRequest(orig)
+	assert.Empty(t, orig.ResourceLogs)
+	assert.Empty(t, record.SeverityText)
+
+	buf, err = small.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, UnmarshalLogsRequestReusing(orig, buf))
+	got, err := orig.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, buf, got)
+	assert.Same(t, record, orig.ResourceLogs[0].ScopeLogs[0].LogRecords[0])
+}
+
+func TestUnmarshalMetricsRequestReusing(t *testing.T) {
+	large := &otlpcollectormetrics.ExportMetricsServiceRequest{ResourceMetrics: []*otlpmetrics.ResourceMetrics{{
+		ScopeMetrics: []*otlpmetrics.ScopeMetrics{{
+			Metrics: []*otlpmetrics.Metric{
+				{Name: "gauge", Data: &otlpmetrics.Metric_Gauge{Gauge: &otlpmetrics.Gauge{
+					DataPoints: []*otlpmetrics.NumberDataPoint{{Value: &otlpmetrics.NumberDataPoint_AsInt{AsInt: 1}}},
+				}}},
+				{Name: "sum", Data: &otlpmetrics.Metric_Sum{Sum: &otlpmetrics.Sum{IsMonotonic: true}}},
+			},
+		}},
+	}}}
+	small := &otlpcollectormetrics.ExportMetricsServiceRequest{ResourceMetrics: []*otlpmetrics.ResourceMetrics{{
+		ScopeMetrics: []*otlpmetrics.ScopeMetrics{{
+			Metrics: []*otlpmetrics.Metric{{Name: "other", Unit: "s"}},
+		}},
+	}}}
+
+	orig := &otlpcollectormetrics.ExportMetricsServiceRequest{}
+	buf, err := large.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, UnmarshalMetricsRequestReusing(orig, buf))
+	assert.Equal(t, large, orig)
+	metric := orig.ResourceMetrics[0].ScopeMetrics[0].Metrics[0]
+
+	ResetMetricsRequest(orig)
+	assert.Empty(t, orig.ResourceMetrics)
+	assert.Nil(t, metric.Data)
+
+	buf, err = small.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, UnmarshalMetricsRequestReusing(orig, buf))
+	got, err := orig.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, buf, got)
+	assert.Same(t, metric, orig.ResourceMetrics[0].ScopeMetrics[0].Metrics[0])
+}
+
+func TestResetElemsAfterRemoval(t *testing.T) {
+	b := &otlptrace.Span{Name: "b"}
+	// Removing the first span from [a, b] moves b and leaves it past the length of the slice too.
+	spans := []*otlptrace.Span{b, b}[:1]
+	spans = resetElems(spans, func(s *otlptrace.Span) { *s = otlptrace.Span{} })
+	assert.Equal(t, 1, cap(spans), "elements past the length are not reused")
+	assert.Same(t, b, nextElem(&spans))
+	assert.NotSame(t, b, nextElem(&spans))
+}
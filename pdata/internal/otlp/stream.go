@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlp // import "go.opentelemetry.io/collector/pdata/internal/otlp"
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Protobuf wire types, see https://protobuf.dev/programming-guides/encoding/#structure.
+const (
+	wireTypeVarint  = 0
+	wireTypeFixed64 = 1
+	wireTypeBytes   = 2
+	wireTypeFixed32 = 5
+)
+
+// resourcesFieldNumber is the field number of the repeated resource field in all OTLP
+// export requests and data messages (e.g. ExportTraceServiceRequest.resource_spans, LogsData.resource_logs).
+const resourcesFieldNumber = 1
+
+// ReadResources walks the protobuf wire format of an OTLP export request read from r and calls fn
+// with the encoded bytes of every top-level resource message (ResourceSpans, ResourceLogs, ...).
+// Unknown fields are skipped. The slice passed to fn is only valid until fn returns.
+//
+// Only one resource message is buffered at a time, so the memory used is bounded by the largest
+// resource in the request instead of the whole request. Decoding stops at the first error
+// returned by fn, which is returned to the caller.
+func ReadResources(r io.Reader, fn func([]byte) error) error {
+	br := bufio.NewReader(r)
+	var buf bytes.Buffer
+	for {
+		tag, err := binary.ReadUvarint(br)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("proto: failed to read field tag: %w", err)
+		}
+		fieldNum, wireType := tag>>3, tag&0x7
+		if fieldNum == 0 {
+			return errors.New("proto: illegal field number 0")
+		}
+		switch wireType {
+		case wireTypeVarint:
+			if _, err = binary.ReadUvarint(br); err != nil {
+				return fmt.Errorf("proto: failed to read varint field %d: %w", fieldNum, unexpectedEOF(err))
+			}
+		case wireTypeFixed64:
+			if _, err = br.Discard(8); err != nil {
+				return fmt.Errorf("proto: failed to read fixed64 field %d: %w", fieldNum, unexpectedEOF(err))
+			}
+		case wireTypeFixed32:
+			if _, err = br.Discard(4); err != nil {
+				return fmt.Errorf("proto: failed to read fixed32 field %d: %w", fieldNum, unexpectedEOF(err))
+			}
+		case wireTypeBytes:
+			var size uint64
+			if size, err = binary.ReadUvarint(br); err != nil {
+				return fmt.Errorf("proto: failed to read length of field %d: %w", fieldNum, unexpectedEOF(err))
+			}
+			if size > uint64(^uint(0)>>1) {
+				return fmt.Errorf("proto: invalid length %d of field %d", size, fieldNum)
+			}
+			if fieldNum != resourcesFieldNumber {
+				if _, err = io.CopyN(io.Discard, br, int64(size)); err != nil {
+					return fmt.Errorf("proto: failed to read field %d: %w", fieldNum, unexpectedEOF(err))
+				}
+				continue
+			}
+			buf.Reset()
+			// Copy instead of allocating the announced size upfront, so a bogus length cannot
+			// allocate more memory than the data actually received.
+			if _, err = io.CopyN(&buf, br, int64(size)); err != nil {
+				return fmt.Errorf("proto: failed to read field %d: %w", fieldNum, unexpectedEOF(err))
+			}
+			if err = fn(buf.Bytes()); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("proto: unsupported wire type %d for field %d", wireType, fieldNum)
+		}
+	}
+}
+
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlp
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadResources(t *testing.T) {
+	buf := []byte{
+		0x0a, 0x02, 0x01, 0x02, // field 1, bytes
+		0x10, 0x96, 0x01, // field 2, varint
+		0x19, 1, 2, 3, 4, 5, 6, 7, 8, // field 3, fixed64
+		0x25, 1, 2, 3, 4, // field 4, fixed32
+		0x2a, 0x01, 0xff, // field 5, bytes
+		0x0a, 0x00, // field 1, empty bytes
+		0x0a, 0x01, 0x03, // field 1, bytes
+	}
+	var got [][]byte
+	require.NoError(t, ReadResources(bytes.NewReader(buf), func(b []byte) error {
+		got = append(got, bytes.Clone(b))
+		return nil
+	}))
+	assert.Equal(t, [][]byte{{0x01, 0x02}, {}, {0x03}}, got)
+}
+
+func TestReadResourcesEmpty(t *testing.T) {
+	require.NoError(t, ReadResources(bytes.NewReader(nil), func([]byte) error {
+		t.Fatal("unexpected call")
+		return nil
+	}))
+}
+
+func TestReadResourcesCallbackError(t *testing.T) {
+	errStop := errors.New("stop")
+	calls := 0
+	err := ReadResources(bytes.NewReader([]byte{0x0a, 0x00, 0x0a, 0x00}), func([]byte) error {
+		calls++
+		return errStop
+	})
+	require.ErrorIs(t, err, errStop)
+	assert.Equal(t, 1, calls)
+}
+
+func TestReadResourcesInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		buf  []byte
+	}{
+		{name: "truncated_tag", buf: []byte{0x80}},
+		{name: "field_zero", buf: []byte{0x02, 0x00}},
+		{name: "truncated_length", buf: []byte{0x0a}},
+		{name: "truncated_resource", buf: []byte{0x0a, 0x05, 0x01}},
+		{name: "truncated_unknown", buf: []byte{0x12, 0x05, 0x01}},
+		{name: "truncated_varint", buf: []byte{0x10, 0x80}},
+		{name: "truncated_fixed64", buf: []byte{0x19, 0x01}},
+		{name: "truncated_fixed32", buf: []byte{0x25, 0x01}},
+		{name: "huge_length", buf: []byte{0x0a, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f}},
+		{name: "group", buf: []byte{0x0b}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, ReadResources(bytes.NewReader(tt.buf), func([]byte) error { return nil }))
+		})
+	}
+}
+
+func TestReadResourcesUnexpectedEOF(t *testing.T) {
+	err := ReadResources(bytes.NewReader([]byte{0x0a, 0x05, 0x01}), func([]byte) error { return nil })
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
@@ -0,0 +1,188 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pcommon // import "go.opentelemetry.io/collector/pdata/pcommon"
+
+import (
+	"go.opentelemetry.io/collector/pdata/internal"
+	otlpcommon "go.opentelemetry.io/collector/pdata/internal/data/protogen/common/v1"
+)
+
+// mapIndexThreshold is the number of entries at which MapBuilder starts to maintain
+// a hash index of its keys. Below this size a linear scan over the underlying slice
+// is faster than hashing the key, see BenchmarkMapBuilderGet.
+const mapIndexThreshold = 16
+
+// MapBuilder is a helper to build or update a Map with a large number of entries.
+//
+// Lookups and updates on a Map require a linear scan of all its entries. MapBuilder
+// lazily builds a hash index of the keys once it grows beyond a small number of entries,
+// which makes Get and Put* operations O(1). The wire representation of the resulting
+// Map is the same as if it was built using the Map API directly.
+//
+// Values returned by the MapBuilder are only valid until the next call that modifies
+// the builder and must not be used after the builder content is moved to a Map.
+//
+// Must use NewMapBuilder function to create new instances.
+type MapBuilder struct {
+	orig  []otlpcommon.KeyValue
+	index map[string]int
+	// dups is set when the indexed entries contain duplicate keys, which can happen for data from the wire.
+	dups  bool
+	state internal.State
+}
+
+// NewMapBuilder creates a MapBuilder with 0 elements and the given initial capacity.
+func NewMapBuilder(capacity int) *MapBuilder {
+	return &MapBuilder{
+		orig:  make([]otlpcommon.KeyValue, 0, capacity),
+		state: internal.StateMutable,
+	}
+}
+
+// Len returns the number of entries in the builder.
+func (mb *MapBuilder) Len() int {
+	return len(mb.orig)
+}
+
+// Get returns the Value associated with the key and true. Returned Value is not a copy,
+// it is a reference to the value stored in this builder.
+//
+// If the key does not exist returns a zero-initialized Value and false.
+// Calling any functions on the returned invalid instance may cause a panic.
+func (mb *MapBuilder) Get(key string) (Value, bool) {
+	if i := mb.find(key); i >= 0 {
+		return newValue(&mb.orig[i].Value, &mb.state), true
+	}
+	return newValue(nil, &mb.state), false
+}
+
+// Remove removes the entry associated with the key and returns true if the key
+// was present in the builder, otherwise returns false.
+func (mb *MapBuilder) Remove(key string) bool {
+	i := mb.find(key)
+	if i < 0 {
+		return false
+	}
+	last := len(mb.orig) - 1
+	mb.orig[i] = mb.orig[last]
+	mb.orig[last] = otlpcommon.KeyValue{}
+	mb.orig = mb.orig[:last]
+	switch {
+	case mb.dups:
+		// Another entry with the same key may still exist, rebuild the index on next lookup.
+		mb.index = nil
+	case mb.index != nil:
+		delete(mb.index, key)
+		if i != last {
+			mb.index[mb.orig[i].Key] = i
+		}
+	}
+	return true
+}
+
+// PutEmpty inserts or updates an empty value under the given key and returns it.
+func (mb *MapBuilder) PutEmpty(k string) Value {
+	av := mb.put(k)
+	av.Value = nil
+	return newValue(av, &mb.state)
+}
+
+// PutStr performs the Insert or Update action for a string Value.
+func (mb *MapBuilder) PutStr(k string, v string) {
+	newValue(mb.put(k), &mb.state).SetStr(v)
+}
+
+// PutInt performs the Insert or Update action for an int Value.
+func (mb *MapBuilder) PutInt(k string, v int64) {
+	newValue(mb.put(k), &mb.state).SetInt(v)
+}
+
+// PutDouble performs the Insert or Update action for a double Value.
+func (mb *MapBuilder) PutDouble(k string, v float64) {
+	newValue(mb.put(k), &mb.state).SetDouble(v)
+}
+
+// PutBool performs the Insert or Update action for a bool Value.
+func (mb *MapBuilder) PutBool(k string, v bool) {
+	newValue(mb.put(k), &mb.state).SetBool(v)
+}
+
+// PutEmptyBytes inserts or updates an empty byte slice under given key and returns it.
+func (mb *MapBuilder) PutEmptyBytes(k string) ByteSlice {
+	return newValue(mb.put(k), &mb.state).SetEmptyBytes()
+}
+
+// PutEmptyMap inserts or updates an empty map under given key and returns it.
+func (mb *MapBuilder) PutEmptyMap(k string) Map {
+	return newValue(mb.put(k), &mb.state).SetEmptyMap()
+}
+
+// PutEmptySlice inserts or updates an empty slice under given key and returns it.
+func (mb *MapBuilder) PutEmptySlice(k string) Slice {
+	return newValue(mb.put(k), &mb.state).SetEmptySlice()
+}
+
+// MoveFrom moves all key/values from the src Map into the builder, overriding the builder content
+// and resetting the src Map to its zero value.
+func (mb *MapBuilder) MoveFrom(src Map) {
+	src.getState().AssertMutable()
+	mb.orig = *src.getOrig()
+	mb.index = nil
+	mb.dups = false
+	*src.getOrig() = nil
+}
+
+// MoveTo moves all key/values from the builder to the dest Map, overriding the destination
+// and resetting the builder to its zero value.
+func (mb *MapBuilder) MoveTo(dest Map) {
+	dest.getState().AssertMutable()
+	*dest.getOrig() = mb.orig
+	mb.orig = nil
+	mb.index = nil
+	mb.dups = false
+}
+
+// find returns the position of the first entry with the given key, or -1 if there is none.
+func (mb *MapBuilder) find(key string) int {
+	if mb.index == nil && len(mb.orig) >= mapIndexThreshold {
+		mb.buildIndex()
+	}
+	if mb.index != nil {
+		if i, ok := mb.index[key]; ok {
+			return i
+		}
+		return -1
+	}
+	for i := range mb.orig {
+		if mb.orig[i].Key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// put returns the value stored under the given key, appending a new entry if the key does not exist.
+func (mb *MapBuilder) put(k string) *otlpcommon.AnyValue {
+	if i := mb.find(k); i >= 0 {
+		return &mb.orig[i].Value
+	}
+	mb.orig = append(mb.orig, otlpcommon.KeyValue{Key: k})
+	if mb.index != nil {
+		mb.index[k] = len(mb.orig) - 1
+	}
+	return &mb.orig[len(mb.orig)-1].Value
+}
+
+func (mb *MapBuilder) buildIndex() {
+	mb.index = make(map[string]int, len(mb.orig))
+	mb.dups = false
+	for i := range mb.orig {
+		// Keep the first occurrence to match the semantics of Map.Get for duplicate keys.
+		if _, ok := mb.index[mb.orig[i].Key]; ok {
+			mb.dups = true
+			continue
+		}
+		mb.index[mb.orig[i].Key] = i
+	}
+}
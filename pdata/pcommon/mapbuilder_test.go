@@ -0,0 +1,172 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pcommon
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/internal"
+	otlpcommon "go.opentelemetry.io/collector/pdata/internal/data/protogen/common/v1"
+)
+
+func TestMapBuilder(t *testing.T) {
+	for _, size := range []int{1, mapIndexThreshold - 1, mapIndexThreshold, 4 * mapIndexThreshold} {
+		t.Run(strconv.Itoa(size), func(t *testing.T) {
+			mb := NewMapBuilder(size)
+			expected := NewMap()
+			for i := 0; i < size; i++ {
+				k := "key" + strconv.Itoa(i)
+				mb.PutStr(k, "old")
+				expected.PutStr(k, "old")
+			}
+			for i := 0; i < size; i++ {
+				k := "key" + strconv.Itoa(i)
+				mb.PutInt(k, int64(i))
+				expected.PutInt(k, int64(i))
+			}
+			mb.PutDouble("double", 1.5)
+			expected.PutDouble("double", 1.5)
+			mb.PutBool("bool", true)
+			expected.PutBool("bool", true)
+			mb.PutEmptyBytes("bytes").FromRaw([]byte{1, 2})
+			expected.PutEmptyBytes("bytes").FromRaw([]byte{1, 2})
+			mb.PutEmptyMap("map").PutStr("k", "v")
+			expected.PutEmptyMap("map").PutStr("k", "v")
+			mb.PutEmptySlice("slice").AppendEmpty().SetInt(1)
+			expected.PutEmptySlice("slice").AppendEmpty().SetInt(1)
+			mb.PutEmpty("empty")
+			expected.PutEmpty("empty")
+			assert.Equal(t, size+6, mb.Len())
+
+			for i := 0; i < size; i++ {
+				val, ok := mb.Get("key" + strconv.Itoa(i))
+				require.True(t, ok)
+				assert.Equal(t, int64(i), val.Int())
+			}
+			_, ok := mb.Get("missing")
+			assert.False(t, ok)
+
+			assert.False(t, mb.Remove("missing"))
+			assert.True(t, mb.Remove("key0"))
+			assert.True(t, expected.Remove("key0"))
+			_, ok = mb.Get("key0")
+			assert.False(t, ok)
+			val, ok := mb.Get("empty")
+			require.True(t, ok)
+			assert.Equal(t, ValueTypeEmpty, val.Type())
+
+			m := NewMap()
+			mb.MoveTo(m)
+			assert.Equal(t, 0, mb.Len())
+			assert.Equal(t, expected.AsRaw(), m.AsRaw())
+			assert.Equal(t, *expected.getOrig(), *m.getOrig())
+		})
+	}
+}
+
+func TestMapBuilderMoveFrom(t *testing.T) {
+	src := NewMap()
+	for i := 0; i < 2*mapIndexThreshold; i++ {
+		src.PutInt("key"+strconv.Itoa(i), int64(i))
+	}
+	// Duplicate keys can be received from the wire, the first one wins as in Map.Get.
+	*src.getOrig() = append(*src.getOrig(), newKeyValueInt("key1", 100))
+
+	mb := NewMapBuilder(0)
+	mb.MoveFrom(src)
+	assert.Equal(t, 0, src.Len())
+	val, ok := mb.Get("key1")
+	require.True(t, ok)
+	assert.Equal(t, int64(1), val.Int())
+
+	assert.True(t, mb.Remove("key1"))
+	val, ok = mb.Get("key1")
+	require.True(t, ok)
+	assert.Equal(t, int64(100), val.Int())
+	assert.True(t, mb.Remove("key1"))
+	_, ok = mb.Get("key1")
+	assert.False(t, ok)
+
+	mb.PutStr("new", "v")
+	dest := NewMap()
+	mb.MoveTo(dest)
+	assert.Equal(t, 2*mapIndexThreshold, dest.Len())
+	val, ok = dest.Get("new")
+	require.True(t, ok)
+	assert.Equal(t, "v", val.Str())
+}
+
+func TestMapBuilderReadOnly(t *testing.T) {
+	state := internal.StateReadOnly
+	m := newMap(&[]otlpcommon.KeyValue{}, &state)
+	mb := NewMapBuilder(0)
+	assert.Panics(t, func() { mb.MoveFrom(m) })
+	assert.Panics(t, func() { mb.MoveTo(m) })
+}
+
+// BenchmarkMapBuilderGet compares lookups in a Map with lookups in a MapBuilder to find
+// the size at which maintaining a hash index pays off, see mapIndexThreshold.
+func BenchmarkMapBuilderGet(b *testing.B) {
+	for _, size := range []int{4, 8, 12, 16, 24, 32, 64, 256} {
+		m := NewMap()
+		for i := 0; i < size; i++ {
+			m.PutStr("attribute.key."+strconv.Itoa(i), "value")
+		}
+		key := "attribute.key." + strconv.Itoa(size-1)
+
+		b.Run("Map/"+strconv.Itoa(size), func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				m.Get(key)
+			}
+		})
+
+		b.Run("MapBuilder/"+strconv.Itoa(size), func(b *testing.B) {
+			mb := NewMapBuilder(0)
+			m.CopyTo(Map(internal.NewMap(&mb.orig, &mb.state)))
+			// Always use the index to measure its cost below the threshold.
+			mb.buildIndex()
+			b.ReportAllocs()
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				mb.Get(key)
+			}
+		})
+	}
+}
+
+func BenchmarkMapBuilderPutStr(b *testing.B) {
+	for _, size := range []int{8, 16, 64, 256} {
+		keys := make([]string, size)
+		for i := range keys {
+			keys[i] = "attribute.key." + strconv.Itoa(i)
+		}
+
+		b.Run("Map/"+strconv.Itoa(size), func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				m := NewMap()
+				m.EnsureCapacity(size)
+				for _, k := range keys {
+					m.PutStr(k, "value")
+				}
+			}
+		})
+
+		b.Run("MapBuilder/"+strconv.Itoa(size), func(b *testing.B) {
+			b.ReportAllocs()
+			for n := 0; n < b.N; n++ {
+				mb := NewMapBuilder(size)
+				for _, k := range keys {
+					mb.PutStr(k, "value")
+				}
+				mb.MoveTo(NewMap())
+			}
+		})
+	}
+}
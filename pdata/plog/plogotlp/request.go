@@ -5,9 +5,12 @@ package plogotlp // import "go.opentelemetry.io/collector/pdata/plog/plogotlp"
 
 import (
 	"bytes"
+	"io"
+	"sync"
 
 	"go.opentelemetry.io/collector/pdata/internal"
 	otlpcollectorlog "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/logs/v1"
+	otlplogs "go.opentelemetry.io/collector/pdata/internal/data/protogen/logs/v1"
 	"go.opentelemetry.io/collector/pdata/internal/json"
 	"go.opentelemetry.io/collector/pdata/internal/otlp"
 	"go.opentelemetry.io/collector/pdata/plog"
@@ -20,6 +23,8 @@ var jsonUnmarshaler = &plog.JSONUnmarshaler{}
 type ExportRequest struct {
 	orig  *otlpcollectorlog.ExportLogsServiceRequest
 	state *internal.State
+	// pooled is set if orig was obtained from requestPool.
+	pooled bool
 }
 
 // NewExportRequest returns an empty ExportRequest.
@@ -31,6 +36,39 @@ func NewExportRequest() ExportRequest {
 	}
 }
 
+var requestPool = sync.Pool{
+	New: func() any {
+		return &otlpcollectorlog.ExportLogsServiceRequest{}
+	},
+}
+
+// NewPooledExportRequest returns an empty ExportRequest which reuses the memory of previously released requests:
+// UnmarshalProto decodes into their resources, scopes and log records instead of allocating new ones.
+// Once the request and all the data obtained from it are no longer referenced, it should be released with Release.
+func NewPooledExportRequest() ExportRequest {
+	state := internal.StateMutable
+	return ExportRequest{
+		orig:   requestPool.Get().(*otlpcollectorlog.ExportLogsServiceRequest),
+		state:  &state,
+		pooled: true,
+	}
+}
+
+// Release returns the memory of an ExportRequest created with NewPooledExportRequest to the pool, so it can
+// be reused by other requests. The request and any data obtained from it, including the plog.Logs returned by Logs,
+// MUST NOT be used after calling Release, and Release MUST NOT be called more than once.
+// For requests not created with NewPooledExportRequest this function is a no-op.
+func (ms ExportRequest) Release() {
+	if !ms.pooled {
+		return
+	}
+	// Any attempt to modify the data through a leaked reference panics.
+	*ms.state = internal.StateReadOnly
+	// Keep the allocated messages for reuse, but drop everything else they reference so it can be garbage collected.
+	otlp.ResetLogsRequest(ms.orig)
+	requestPool.Put(ms.orig)
+}
+
 // NewExportRequestFromLogs returns a ExportRequest from plog.Logs.
 // Because ExportRequest is a wrapper for plog.Logs,
 // any changes to the provided Logs struct will be reflected in the ExportRequest and vice versa.
@@ -48,7 +86,11 @@ func (ms ExportRequest) MarshalProto() ([]byte, error) {
 
 // UnmarshalProto unmarshalls ExportRequest from proto bytes.
 func (ms ExportRequest) UnmarshalProto(data []byte) error {
-	if err := ms.orig.Unmarshal(data); err != nil {
+	unmarshal := ms.orig.Unmarshal
+	if ms.pooled {
+		unmarshal = func(data []byte) error { return otlp.UnmarshalLogsRequestReusing(ms.orig, data) }
+	}
+	if err := unmarshal(data); err != nil {
 		return err
 	}
 	otlp.MigrateLogs(ms.orig.ResourceLogs)
@@ -77,3 +119,22 @@ func (ms ExportRequest) UnmarshalJSON(data []byte) error {
 func (ms ExportRequest) Logs() plog.Logs {
 	return plog.Logs(internal.NewLogs(ms.orig, ms.state))
 }
+
+// UnmarshalProtoStream reads a proto-encoded ExportRequest from r without materializing the whole request.
+// For every ResourceLogs in the request fn is called with a plog.Logs holding only that ResourceLogs,
+// so the memory needed is bounded by the largest ResourceLogs instead of the whole request.
+// This can be used to enforce limits or to split oversized requests while decoding.
+//
+// Decoding stops at the first error returned by fn, which is returned to the caller.
+// The plog.Logs passed to fn is owned by fn.
+func UnmarshalProtoStream(r io.Reader, fn func(plog.Logs) error) error {
+	return otlp.ReadResources(r, func(buf []byte) error {
+		orig := &otlplogs.ResourceLogs{}
+		if err := orig.Unmarshal(buf); err != nil {
+			return err
+		}
+		origs := []*otlplogs.ResourceLogs{orig}
+		otlp.MigrateLogs(origs)
+		return fn(plog.Logs(internal.LogsFromProto(otlplogs.LogsData{ResourceLogs: origs})))
+	})
+}
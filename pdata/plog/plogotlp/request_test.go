@@ -4,12 +4,16 @@
 package plogotlp
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/plog"
 )
 
 var _ json.Unmarshaler = ExportRequest{}
@@ -54,3 +58,91 @@ func TestRequestJSON(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, strings.Join(strings.Fields(string(logsRequestJSON)), ""), string(got))
 }
+
+func TestUnmarshalProtoStream(t *testing.T) {
+	req := NewExportRequest()
+	for _, name := range []string{"a", "b", "c"} {
+		req.Logs().ResourceLogs().AppendEmpty().Resource().Attributes().PutStr("name", name)
+	}
+	buf, err := req.MarshalProto()
+	require.NoError(t, err)
+
+	var got []plog.Logs
+	require.NoError(t, UnmarshalProtoStream(bytes.NewReader(buf), func(md plog.Logs) error {
+		got = append(got, md)
+		return nil
+	}))
+	require.Len(t, got, 3)
+	for i, md := range got {
+		require.Equal(t, 1, md.ResourceLogs().Len())
+		assert.Equal(t, req.Logs().ResourceLogs().At(i), md.ResourceLogs().At(0))
+	}
+
+	errStop := errors.New("stop")
+	require.ErrorIs(t, UnmarshalProtoStream(bytes.NewReader(buf), func(plog.Logs) error { return errStop }), errStop)
+	require.Error(t, UnmarshalProtoStream(bytes.NewReader([]byte{0x0a, 0x02, 0xff, 0xff}), func(plog.Logs) error { return nil }))
+}
+
+func TestPooledExportRequest(t *testing.T) {
+	req := NewPooledExportRequest()
+	require.NoError(t, req.UnmarshalJSON(logsRequestJSON))
+	data := req.Logs()
+	assert.Equal(t, 1, data.ResourceLogs().Len())
+
+	req.Release()
+	assert.True(t, data.IsReadOnly())
+	assert.Panics(t, func() { data.ResourceLogs().AppendEmpty() })
+
+	req = NewPooledExportRequest()
+	assert.Equal(t, 0, req.Logs().ResourceLogs().Len())
+	assert.False(t, req.Logs().IsReadOnly())
+	src := NewExportRequest()
+	require.NoError(t, src.UnmarshalJSON(logsRequestJSON))
+	src.Logs().CopyTo(req.Logs())
+	assert.Equal(t, src.Logs(), req.Logs())
+	req.Release()
+
+	// Release is a no-op for requests not obtained from the pool.
+	req = NewExportRequest()
+	req.Logs().ResourceLogs().AppendEmpty()
+	req.Release()
+	assert.Equal(t, 1, req.Logs().ResourceLogs().Len())
+	assert.False(t, req.Logs().IsReadOnly())
+}
+
+func BenchmarkExportRequestUnmarshalProto(b *testing.B) {
+	req := NewExportRequest()
+	for i := 0; i < 10; i++ {
+		rl := req.Logs().ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("service.name", "benchmark")
+		records := rl.ScopeLogs().AppendEmpty().LogRecords()
+		for j := 0; j < 100; j++ {
+			record := records.AppendEmpty()
+			record.Body().SetStr("log")
+			record.Attributes().PutStr("key", "value")
+			record.Attributes().PutInt("count", int64(j))
+		}
+	}
+	buf, err := req.MarshalProto()
+	require.NoError(b, err)
+
+	b.Run("new", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			req := NewExportRequest()
+			if err := req.UnmarshalProto(buf); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			req := NewPooledExportRequest()
+			if err := req.UnmarshalProto(buf); err != nil {
+				b.Fatal(err)
+			}
+			req.Release()
+		}
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package plogtest compares logs, e.g. the ones captured by consumertest.LogsSink.
+package plogtest // import "go.opentelemetry.io/collector/pdata/plog/plogtest"
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/pdata/internal/compare"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+// CompareLogs returns an error joining the differences between the expected and actual logs,
+// each qualified with its path, e.g. `resource "map[service.name:svc]": scope "lib": log record "message": ...`.
+// It returns nil if the logs are equal, ignoring the differences configured by the options.
+func CompareLogs(expected, actual plog.Logs, options ...CompareLogsOption) error {
+	return compareResourceLogsSlice(newOptions(options), expected.ResourceLogs(), actual.ResourceLogs())
+}
+
+// CompareResourceLogs returns an error joining the differences between the expected and actual ResourceLogs.
+func CompareResourceLogs(expected, actual plog.ResourceLogs, options ...CompareLogsOption) error {
+	return compareResourceLogs(newOptions(options), expected, actual)
+}
+
+// CompareScopeLogs returns an error joining the differences between the expected and actual ScopeLogs.
+func CompareScopeLogs(expected, actual plog.ScopeLogs, options ...CompareLogsOption) error {
+	return compareScopeLogs(newOptions(options), expected, actual)
+}
+
+// CompareLogRecord returns an error joining the differences between the expected and actual log records.
+func CompareLogRecord(expected, actual plog.LogRecord, options ...CompareLogsOption) error {
+	return compareLogRecord(newOptions(options), expected, actual)
+}
+
+func compareResourceLogsSlice(opts *compare.Options, expected, actual plog.ResourceLogsSlice) error {
+	return compare.Slice("resource", !opts.IgnoreResourcesOrder, expected.Len(), actual.Len(), expected.At, actual.At,
+		func(rl plog.ResourceLogs) string { return compare.ResourceID(rl.Resource()) },
+		func(expected, actual plog.ResourceLogs) error { return compareResourceLogs(opts, expected, actual) })
+}
+
+func compareResourceLogs(opts *compare.Options, expected, actual plog.ResourceLogs) error {
+	return errors.Join(
+		compare.Resource(opts, expected.Resource(), actual.Resource()),
+		compare.Equal("schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		compare.Slice("scope", !opts.IgnoreScopesOrder, expected.ScopeLogs().Len(), actual.ScopeLogs().Len(),
+			expected.ScopeLogs().At, actual.ScopeLogs().At,
+			func(sl plog.ScopeLogs) string { return compare.ScopeID(sl.Scope()) },
+			func(expected, actual plog.ScopeLogs) error { return compareScopeLogs(opts, expected, actual) }),
+	)
+}
+
+func compareScopeLogs(opts *compare.Options, expected, actual plog.ScopeLogs) error {
+	return errors.Join(
+		compare.Scope(opts, expected.Scope(), actual.Scope()),
+		compare.Equal("schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		compare.Slice("log record", !opts.IgnoreRecordsOrder, expected.LogRecords().Len(), actual.LogRecords().Len(),
+			expected.LogRecords().At, actual.LogRecords().At,
+			func(lr plog.LogRecord) string { return lr.Body().AsString() },
+			func(expected, actual plog.LogRecord) error { return compareLogRecord(opts, expected, actual) }),
+	)
+}
+
+func compareLogRecord(opts *compare.Options, expected, actual plog.LogRecord) error {
+	return errors.Join(
+		compare.Timestamp(opts, "timestamp", expected.Timestamp(), actual.Timestamp()),
+		compare.Timestamp(opts, "observed timestamp", expected.ObservedTimestamp(), actual.ObservedTimestamp()),
+		compare.ID(opts, "trace id", expected.TraceID(), actual.TraceID()),
+		compare.ID(opts, "span id", expected.SpanID(), actual.SpanID()),
+		compare.Equal("flags", expected.Flags(), actual.Flags()),
+		compare.Equal("severity text", expected.SeverityText(), actual.SeverityText()),
+		compare.Equal("severity number", expected.SeverityNumber(), actual.SeverityNumber()),
+		compare.Value("body", expected.Body(), actual.Body()),
+		compare.Attributes(opts, expected.Attributes(), actual.Attributes()),
+		compare.Equal("dropped attributes count", expected.DroppedAttributesCount(), actual.DroppedAttributesCount()),
+	)
+}
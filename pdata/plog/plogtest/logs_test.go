@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package plogtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+)
+
+func newLogs() plog.Logs {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "svc")
+	for _, scope := range []string{"first", "second"} {
+		sl := rl.ScopeLogs().AppendEmpty()
+		sl.Scope().SetName(scope)
+		sl.Scope().SetVersion("v1")
+		lr := sl.LogRecords().AppendEmpty()
+		lr.Body().SetStr("message")
+		lr.SetTimestamp(1)
+		lr.SetObservedTimestamp(2)
+		lr.SetSeverityNumber(plog.SeverityNumberInfo)
+		lr.SetTraceID(pcommon.TraceID([16]byte{1}))
+		lr.Attributes().PutStr("key", "value")
+	}
+	return ld
+}
+
+func TestCompareLogs(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(plog.Logs)
+		options  []CompareLogsOption
+		expected []string
+	}{
+		{
+			name:   "equal",
+			modify: func(plog.Logs) {},
+		},
+		{
+			name: "severity",
+			modify: func(ld plog.Logs) {
+				ld.ResourceLogs().At(0).ScopeLogs().At(1).LogRecords().At(0).SetSeverityNumber(plog.SeverityNumberWarn)
+			},
+			expected: []string{`resource "map[service.name:svc]": scope "second@v1": log record "message": severity number doesn't match expected: Info, actual: Warn`},
+		},
+		{
+			name: "body type",
+			modify: func(ld plog.Logs) {
+				ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().SetEmptyMap().PutStr("message", "")
+			},
+			expected: []string{
+				`resource "map[service.name:svc]": scope "first@v1": missing expected log record "message"`,
+				`resource "map[service.name:svc]": scope "first@v1": unexpected log record "{\"message\":\"\"}"`,
+			},
+		},
+		{
+			name: "missing attribute",
+			modify: func(ld plog.Logs) {
+				attrs := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes()
+				attrs.Remove("key")
+				attrs.PutStr("other", "value")
+			},
+			expected: []string{
+				`resource "map[service.name:svc]": scope "first@v1": log record "message": missing expected attribute "key"`,
+				`resource "map[service.name:svc]": scope "first@v1": log record "message": unexpected attribute "other"`,
+			},
+		},
+		{
+			name: "scopes order",
+			modify: func(ld plog.Logs) {
+				ld.ResourceLogs().At(0).ScopeLogs().Sort(func(a, b plog.ScopeLogs) bool { return a.Scope().Name() > b.Scope().Name() })
+			},
+			expected: []string{
+				`resource "map[service.name:svc]": scopes are out of order: scope "first@v1" expected at index 0, found at index 1`,
+				`resource "map[service.name:svc]": scopes are out of order: scope "second@v1" expected at index 1, found at index 0`,
+			},
+		},
+		{
+			name: "ignore scopes order",
+			modify: func(ld plog.Logs) {
+				ld.ResourceLogs().At(0).ScopeLogs().Sort(func(a, b plog.ScopeLogs) bool { return a.Scope().Name() > b.Scope().Name() })
+			},
+			options: []CompareLogsOption{IgnoreScopeLogsOrder()},
+		},
+		{
+			name: "ignore timestamps and ids",
+			modify: func(ld plog.Logs) {
+				lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+				lr.SetTimestamp(0)
+				lr.SetObservedTimestamp(0)
+				lr.SetTraceID(pcommon.NewTraceIDEmpty())
+			},
+			options: []CompareLogsOption{IgnoreTimestamps(), IgnoreIDs()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected, actual := newLogs(), newLogs()
+			tt.modify(actual)
+			err := CompareLogs(expected, actual, tt.options...)
+			if len(tt.expected) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.expected, splitErrors(err))
+		})
+	}
+}
+
+func TestCompareLogRecord(t *testing.T) {
+	expected := newLogs().ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	actual := plog.NewLogRecord()
+	expected.CopyTo(actual)
+	actual.Body().SetInt(1)
+	assert.EqualError(t, CompareLogRecord(expected, actual), "body type doesn't match expected: Str, actual: Int")
+}
+
+func splitErrors(err error) []string {
+	var msgs []string
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			msgs = append(msgs, splitErrors(e)...)
+		}
+		return msgs
+	}
+	return []string{err.Error()}
+}
@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package plogtest // import "go.opentelemetry.io/collector/pdata/plog/plogtest"
+
+import (
+	"go.opentelemetry.io/collector/pdata/internal/compare"
+)
+
+// CompareLogsOption configures the differences ignored when comparing logs.
+type CompareLogsOption interface {
+	applyOnLogs(*compare.Options)
+}
+
+type compareLogsOptionFunc func(*compare.Options)
+
+func (f compareLogsOptionFunc) applyOnLogs(opts *compare.Options) {
+	f(opts)
+}
+
+// IgnoreTimestamps ignores the timestamps and observed timestamps of the log records.
+func IgnoreTimestamps() CompareLogsOption {
+	return compareLogsOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreTimestamps = true
+	})
+}
+
+// IgnoreResourceLogsOrder ignores the order of the ResourceLogs.
+func IgnoreResourceLogsOrder() CompareLogsOption {
+	return compareLogsOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreResourcesOrder = true
+	})
+}
+
+// IgnoreScopeLogsOrder ignores the order of the ScopeLogs in a ResourceLogs.
+func IgnoreScopeLogsOrder() CompareLogsOption {
+	return compareLogsOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreScopesOrder = true
+	})
+}
+
+// IgnoreLogRecordsOrder ignores the order of the log records in a ScopeLogs.
+func IgnoreLogRecordsOrder() CompareLogsOption {
+	return compareLogsOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreRecordsOrder = true
+	})
+}
+
+// IgnoreAttributesOrder ignores the order of the attributes of the resources, scopes
+// and log records.
+func IgnoreAttributesOrder() CompareLogsOption {
+	return compareLogsOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreAttributesOrder = true
+	})
+}
+
+// IgnoreIDs ignores the trace and span IDs of the log records.
+func IgnoreIDs() CompareLogsOption {
+	return compareLogsOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreIDs = true
+	})
+}
+
+func newOptions(options []CompareLogsOption) *compare.Options {
+	opts := &compare.Options{}
+	for _, o := range options {
+		o.applyOnLogs(opts)
+	}
+	return opts
+}
@@ -5,10 +5,14 @@ package pmetricotlp // import "go.opentelemetry.io/collector/pdata/pmetric/pmetr
 
 import (
 	"bytes"
+	"io"
+	"sync"
 
 	"go.opentelemetry.io/collector/pdata/internal"
 	otlpcollectormetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/metrics/v1"
+	otlpmetrics "go.opentelemetry.io/collector/pdata/internal/data/protogen/metrics/v1"
 	"go.opentelemetry.io/collector/pdata/internal/json"
+	"go.opentelemetry.io/collector/pdata/internal/otlp"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 )
 
@@ -19,6 +23,8 @@ var jsonUnmarshaler = &pmetric.JSONUnmarshaler{}
 type ExportRequest struct {
 	orig  *otlpcollectormetrics.ExportMetricsServiceRequest
 	state *internal.State
+	// pooled is set if orig was obtained from requestPool.
+	pooled bool
 }
 
 // NewExportRequest returns an empty ExportRequest.
@@ -30,6 +36,39 @@ func NewExportRequest() ExportRequest {
 	}
 }
 
+var requestPool = sync.Pool{
+	New: func() any {
+		return &otlpcollectormetrics.ExportMetricsServiceRequest{}
+	},
+}
+
+// NewPooledExportRequest returns an empty ExportRequest which reuses the memory of previously released requests:
+// UnmarshalProto decodes into their resources, scopes and metrics instead of allocating new ones.
+// Once the request and all the data obtained from it are no longer referenced, it should be released with Release.
+func NewPooledExportRequest() ExportRequest {
+	state := internal.StateMutable
+	return ExportRequest{
+		orig:   requestPool.Get().(*otlpcollectormetrics.ExportMetricsServiceRequest),
+		state:  &state,
+		pooled: true,
+	}
+}
+
+// Release returns the memory of an ExportRequest created with NewPooledExportRequest to the pool, so it can
+// be reused by other requests. The request and any data obtained from it, including the pmetric.Metrics returned by Metrics,
+// MUST NOT be used after calling Release, and Release MUST NOT be called more than once.
+// For requests not created with NewPooledExportRequest this function is a no-op.
+func (ms ExportRequest) Release() {
+	if !ms.pooled {
+		return
+	}
+	// Any attempt to modify the data through a leaked reference panics.
+	*ms.state = internal.StateReadOnly
+	// Keep the allocated messages for reuse, but drop everything else they reference so it can be garbage collected.
+	otlp.ResetMetricsRequest(ms.orig)
+	requestPool.Put(ms.orig)
+}
+
 // NewExportRequestFromMetrics returns a ExportRequest from pmetric.Metrics.
 // Because ExportRequest is a wrapper for pmetric.Metrics,
 // any changes to the provided Metrics struct will be reflected in the ExportRequest and vice versa.
@@ -47,6 +86,9 @@ func (ms ExportRequest) MarshalProto() ([]byte, error) {
 
 // UnmarshalProto unmarshalls ExportRequest from proto bytes.
 func (ms ExportRequest) UnmarshalProto(data []byte) error {
+	if ms.pooled {
+		return otlp.UnmarshalMetricsRequestReusing(ms.orig, data)
+	}
 	return ms.orig.Unmarshal(data)
 }
 
@@ -72,3 +114,22 @@ func (ms ExportRequest) UnmarshalJSON(data []byte) error {
 func (ms ExportRequest) Metrics() pmetric.Metrics {
 	return pmetric.Metrics(internal.NewMetrics(ms.orig, ms.state))
 }
+
+// UnmarshalProtoStream reads a proto-encoded ExportRequest from r without materializing the whole request.
+// For every ResourceMetrics in the request fn is called with a pmetric.Metrics holding only that ResourceMetrics,
+// so the memory needed is bounded by the largest ResourceMetrics instead of the whole request.
+// This can be used to enforce limits or to split oversized requests while decoding.
+//
+// Decoding stops at the first error returned by fn, which is returned to the caller.
+// The pmetric.Metrics passed to fn is owned by fn.
+func UnmarshalProtoStream(r io.Reader, fn func(pmetric.Metrics) error) error {
+	return otlp.ReadResources(r, func(buf []byte) error {
+		orig := &otlpmetrics.ResourceMetrics{}
+		if err := orig.Unmarshal(buf); err != nil {
+			return err
+		}
+		origs := []*otlpmetrics.ResourceMetrics{orig}
+		otlp.MigrateMetrics(origs)
+		return fn(pmetric.Metrics(internal.MetricsFromProto(otlpmetrics.MetricsData{ResourceMetrics: origs})))
+	})
+}
@@ -4,12 +4,16 @@
 package pmetricotlp
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
 )
 
 var _ json.Unmarshaler = ExportRequest{}
@@ -50,3 +54,90 @@ func TestRequestJSON(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, strings.Join(strings.Fields(string(metricsRequestJSON)), ""), string(got))
 }
+
+func TestUnmarshalProtoStream(t *testing.T) {
+	req := NewExportRequest()
+	for _, name := range []string{"a", "b", "c"} {
+		req.Metrics().ResourceMetrics().AppendEmpty().Resource().Attributes().PutStr("name", name)
+	}
+	buf, err := req.MarshalProto()
+	require.NoError(t, err)
+
+	var got []pmetric.Metrics
+	require.NoError(t, UnmarshalProtoStream(bytes.NewReader(buf), func(md pmetric.Metrics) error {
+		got = append(got, md)
+		return nil
+	}))
+	require.Len(t, got, 3)
+	for i, md := range got {
+		require.Equal(t, 1, md.ResourceMetrics().Len())
+		assert.Equal(t, req.Metrics().ResourceMetrics().At(i), md.ResourceMetrics().At(0))
+	}
+
+	errStop := errors.New("stop")
+	require.ErrorIs(t, UnmarshalProtoStream(bytes.NewReader(buf), func(pmetric.Metrics) error { return errStop }), errStop)
+	require.Error(t, UnmarshalProtoStream(bytes.NewReader([]byte{0x0a, 0x02, 0xff, 0xff}), func(pmetric.Metrics) error { return nil }))
+}
+
+func TestPooledExportRequest(t *testing.T) {
+	req := NewPooledExportRequest()
+	require.NoError(t, req.UnmarshalJSON(metricsRequestJSON))
+	data := req.Metrics()
+	assert.Equal(t, 1, data.ResourceMetrics().Len())
+
+	req.Release()
+	assert.True(t, data.IsReadOnly())
+	assert.Panics(t, func() { data.ResourceMetrics().AppendEmpty() })
+
+	req = NewPooledExportRequest()
+	assert.Equal(t, 0, req.Metrics().ResourceMetrics().Len())
+	assert.False(t, req.Metrics().IsReadOnly())
+	src := NewExportRequest()
+	require.NoError(t, src.UnmarshalJSON(metricsRequestJSON))
+	src.Metrics().CopyTo(req.Metrics())
+	assert.Equal(t, src.Metrics(), req.Metrics())
+	req.Release()
+
+	// Release is a no-op for requests not obtained from the pool.
+	req = NewExportRequest()
+	req.Metrics().ResourceMetrics().AppendEmpty()
+	req.Release()
+	assert.Equal(t, 1, req.Metrics().ResourceMetrics().Len())
+	assert.False(t, req.Metrics().IsReadOnly())
+}
+
+func BenchmarkExportRequestUnmarshalProto(b *testing.B) {
+	req := NewExportRequest()
+	for i := 0; i < 10; i++ {
+		rm := req.Metrics().ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("service.name", "benchmark")
+		metrics := rm.ScopeMetrics().AppendEmpty().Metrics()
+		for j := 0; j < 100; j++ {
+			metric := metrics.AppendEmpty()
+			metric.SetName("metric")
+			metric.SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(int64(j))
+		}
+	}
+	buf, err := req.MarshalProto()
+	require.NoError(b, err)
+
+	b.Run("new", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			req := NewExportRequest()
+			if err := req.UnmarshalProto(buf); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			req := NewPooledExportRequest()
+			if err := req.UnmarshalProto(buf); err != nil {
+				b.Fatal(err)
+			}
+			req.Release()
+		}
+	})
+}
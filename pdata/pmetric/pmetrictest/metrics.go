@@ -0,0 +1,233 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pmetrictest compares metrics, e.g. the ones captured by consumertest.MetricsSink.
+package pmetrictest // import "go.opentelemetry.io/collector/pdata/pmetric/pmetrictest"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/internal/compare"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// CompareMetrics returns an error joining the differences between the expected and actual metrics,
+// each qualified with its path, e.g. `resource "map[service.name:svc]": scope "lib": metric "requests": ...`.
+// It returns nil if the metrics are equal, ignoring the differences configured by the options.
+func CompareMetrics(expected, actual pmetric.Metrics, options ...CompareMetricsOption) error {
+	return compareResourceMetricsSlice(newOptions(options), expected.ResourceMetrics(), actual.ResourceMetrics())
+}
+
+// CompareResourceMetrics returns an error joining the differences between the expected and actual ResourceMetrics.
+func CompareResourceMetrics(expected, actual pmetric.ResourceMetrics, options ...CompareMetricsOption) error {
+	return compareResourceMetrics(newOptions(options), expected, actual)
+}
+
+// CompareScopeMetrics returns an error joining the differences between the expected and actual ScopeMetrics.
+func CompareScopeMetrics(expected, actual pmetric.ScopeMetrics, options ...CompareMetricsOption) error {
+	return compareScopeMetrics(newOptions(options), expected, actual)
+}
+
+// CompareMetric returns an error joining the differences between the expected and actual metrics.
+func CompareMetric(expected, actual pmetric.Metric, options ...CompareMetricsOption) error {
+	return compareMetric(newOptions(options), expected, actual)
+}
+
+func compareResourceMetricsSlice(opts *compare.Options, expected, actual pmetric.ResourceMetricsSlice) error {
+	return compare.Slice("resource", !opts.IgnoreResourcesOrder, expected.Len(), actual.Len(), expected.At, actual.At,
+		func(rm pmetric.ResourceMetrics) string { return compare.ResourceID(rm.Resource()) },
+		func(expected, actual pmetric.ResourceMetrics) error {
+			return compareResourceMetrics(opts, expected, actual)
+		})
+}
+
+func compareResourceMetrics(opts *compare.Options, expected, actual pmetric.ResourceMetrics) error {
+	return errors.Join(
+		compare.Resource(opts, expected.Resource(), actual.Resource()),
+		compare.Equal("schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		compare.Slice("scope", !opts.IgnoreScopesOrder, expected.ScopeMetrics().Len(), actual.ScopeMetrics().Len(),
+			expected.ScopeMetrics().At, actual.ScopeMetrics().At,
+			func(sm pmetric.ScopeMetrics) string { return compare.ScopeID(sm.Scope()) },
+			func(expected, actual pmetric.ScopeMetrics) error { return compareScopeMetrics(opts, expected, actual) }),
+	)
+}
+
+func compareScopeMetrics(opts *compare.Options, expected, actual pmetric.ScopeMetrics) error {
+	return errors.Join(
+		compare.Scope(opts, expected.Scope(), actual.Scope()),
+		compare.Equal("schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		compare.Slice("metric", !opts.IgnoreRecordsOrder, expected.Metrics().Len(), actual.Metrics().Len(),
+			expected.Metrics().At, actual.Metrics().At,
+			pmetric.Metric.Name,
+			func(expected, actual pmetric.Metric) error { return compareMetric(opts, expected, actual) }),
+	)
+}
+
+func compareMetric(opts *compare.Options, expected, actual pmetric.Metric) error {
+	errs := errors.Join(
+		compare.Equal("name", expected.Name(), actual.Name()),
+		compare.Equal("description", expected.Description(), actual.Description()),
+		compare.Equal("unit", expected.Unit(), actual.Unit()),
+		compare.Prefix("metadata", compare.Attributes(opts, expected.Metadata(), actual.Metadata())),
+	)
+	if expected.Type() != actual.Type() {
+		return errors.Join(errs, compare.Equal("type", expected.Type(), actual.Type()))
+	}
+	switch expected.Type() {
+	case pmetric.MetricTypeGauge:
+		return errors.Join(errs,
+			compareNumberDataPoints(opts, expected.Gauge().DataPoints(), actual.Gauge().DataPoints()))
+	case pmetric.MetricTypeSum:
+		return errors.Join(errs,
+			compare.Equal("aggregation temporality", expected.Sum().AggregationTemporality(), actual.Sum().AggregationTemporality()),
+			compare.Equal("is monotonic", expected.Sum().IsMonotonic(), actual.Sum().IsMonotonic()),
+			compareNumberDataPoints(opts, expected.Sum().DataPoints(), actual.Sum().DataPoints()))
+	case pmetric.MetricTypeHistogram:
+		return errors.Join(errs,
+			compare.Equal("aggregation temporality", expected.Histogram().AggregationTemporality(), actual.Histogram().AggregationTemporality()),
+			compareDataPoints(opts, expected.Histogram().DataPoints(), actual.Histogram().DataPoints(), compareHistogramDataPoint))
+	case pmetric.MetricTypeExponentialHistogram:
+		return errors.Join(errs,
+			compare.Equal("aggregation temporality", expected.ExponentialHistogram().AggregationTemporality(), actual.ExponentialHistogram().AggregationTemporality()),
+			compareDataPoints(opts, expected.ExponentialHistogram().DataPoints(), actual.ExponentialHistogram().DataPoints(), compareExponentialHistogramDataPoint))
+	case pmetric.MetricTypeSummary:
+		return errors.Join(errs,
+			compareDataPoints(opts, expected.Summary().DataPoints(), actual.Summary().DataPoints(), compareSummaryDataPoint))
+	}
+	return errs
+}
+
+// dataPoint is implemented by all the data point types.
+type dataPoint interface {
+	pmetric.NumberDataPoint | pmetric.HistogramDataPoint | pmetric.ExponentialHistogramDataPoint | pmetric.SummaryDataPoint
+}
+
+// dataPointSlice is implemented by all the data point slice types.
+type dataPointSlice[DP dataPoint] interface {
+	Len() int
+	At(int) DP
+}
+
+func compareDataPoints[DP dataPoint, S dataPointSlice[DP]](opts *compare.Options, expected, actual S, compareDataPoint func(*compare.Options, DP, DP) error) error {
+	return compare.Slice("datapoint", !opts.IgnoreDataPointsOrder, expected.Len(), actual.Len(), expected.At, actual.At,
+		dataPointID[DP],
+		func(expected, actual DP) error { return compareDataPoint(opts, expected, actual) })
+}
+
+// dataPointID identifies a data point by its attributes.
+func dataPointID[DP dataPoint](dp DP) string {
+	switch v := any(dp).(type) {
+	case pmetric.NumberDataPoint:
+		return fmt.Sprint(v.Attributes().AsRaw())
+	case pmetric.HistogramDataPoint:
+		return fmt.Sprint(v.Attributes().AsRaw())
+	case pmetric.ExponentialHistogramDataPoint:
+		return fmt.Sprint(v.Attributes().AsRaw())
+	case pmetric.SummaryDataPoint:
+		return fmt.Sprint(v.Attributes().AsRaw())
+	}
+	return ""
+}
+
+func compareNumberDataPoints(opts *compare.Options, expected, actual pmetric.NumberDataPointSlice) error {
+	return compareDataPoints(opts, expected, actual, compareNumberDataPoint)
+}
+
+func compareNumberDataPoint(opts *compare.Options, expected, actual pmetric.NumberDataPoint) error {
+	errs := errors.Join(
+		compare.Attributes(opts, expected.Attributes(), actual.Attributes()),
+		compare.StartTimestamp(opts, "start timestamp", expected.StartTimestamp(), actual.StartTimestamp()),
+		compare.Timestamp(opts, "timestamp", expected.Timestamp(), actual.Timestamp()),
+		compare.Equal("flags", expected.Flags(), actual.Flags()),
+		compareExemplars(opts, expected.Exemplars(), actual.Exemplars()),
+	)
+	if expected.ValueType() != actual.ValueType() {
+		return errors.Join(errs, compare.Equal("value type", expected.ValueType(), actual.ValueType()))
+	}
+	switch expected.ValueType() {
+	case pmetric.NumberDataPointValueTypeInt:
+		return errors.Join(errs, compare.Equal("value", expected.IntValue(), actual.IntValue()))
+	case pmetric.NumberDataPointValueTypeDouble:
+		return errors.Join(errs, compare.Equal("value", expected.DoubleValue(), actual.DoubleValue()))
+	}
+	return errs
+}
+
+func compareHistogramDataPoint(opts *compare.Options, expected, actual pmetric.HistogramDataPoint) error {
+	return errors.Join(
+		compare.Attributes(opts, expected.Attributes(), actual.Attributes()),
+		compare.StartTimestamp(opts, "start timestamp", expected.StartTimestamp(), actual.StartTimestamp()),
+		compare.Timestamp(opts, "timestamp", expected.Timestamp(), actual.Timestamp()),
+		compare.Equal("flags", expected.Flags(), actual.Flags()),
+		compare.Equal("count", expected.Count(), actual.Count()),
+		compare.Optional("sum", expected.HasSum(), expected.Sum(), actual.HasSum(), actual.Sum()),
+		compare.Optional("min", expected.HasMin(), expected.Min(), actual.HasMin(), actual.Min()),
+		compare.Optional("max", expected.HasMax(), expected.Max(), actual.HasMax(), actual.Max()),
+		compare.Raw("explicit bounds", expected.ExplicitBounds().AsRaw(), actual.ExplicitBounds().AsRaw()),
+		compare.Raw("bucket counts", expected.BucketCounts().AsRaw(), actual.BucketCounts().AsRaw()),
+		compareExemplars(opts, expected.Exemplars(), actual.Exemplars()),
+	)
+}
+
+func compareExponentialHistogramDataPoint(opts *compare.Options, expected, actual pmetric.ExponentialHistogramDataPoint) error {
+	return errors.Join(
+		compare.Attributes(opts, expected.Attributes(), actual.Attributes()),
+		compare.StartTimestamp(opts, "start timestamp", expected.StartTimestamp(), actual.StartTimestamp()),
+		compare.Timestamp(opts, "timestamp", expected.Timestamp(), actual.Timestamp()),
+		compare.Equal("flags", expected.Flags(), actual.Flags()),
+		compare.Equal("count", expected.Count(), actual.Count()),
+		compare.Optional("sum", expected.HasSum(), expected.Sum(), actual.HasSum(), actual.Sum()),
+		compare.Optional("min", expected.HasMin(), expected.Min(), actual.HasMin(), actual.Min()),
+		compare.Optional("max", expected.HasMax(), expected.Max(), actual.HasMax(), actual.Max()),
+		compare.Equal("scale", expected.Scale(), actual.Scale()),
+		compare.Equal("zero count", expected.ZeroCount(), actual.ZeroCount()),
+		compare.Equal("zero threshold", expected.ZeroThreshold(), actual.ZeroThreshold()),
+		compare.Equal("positive offset", expected.Positive().Offset(), actual.Positive().Offset()),
+		compare.Raw("positive bucket counts", expected.Positive().BucketCounts().AsRaw(), actual.Positive().BucketCounts().AsRaw()),
+		compare.Equal("negative offset", expected.Negative().Offset(), actual.Negative().Offset()),
+		compare.Raw("negative bucket counts", expected.Negative().BucketCounts().AsRaw(), actual.Negative().BucketCounts().AsRaw()),
+		compareExemplars(opts, expected.Exemplars(), actual.Exemplars()),
+	)
+}
+
+func compareSummaryDataPoint(opts *compare.Options, expected, actual pmetric.SummaryDataPoint) error {
+	return errors.Join(
+		compare.Attributes(opts, expected.Attributes(), actual.Attributes()),
+		compare.StartTimestamp(opts, "start timestamp", expected.StartTimestamp(), actual.StartTimestamp()),
+		compare.Timestamp(opts, "timestamp", expected.Timestamp(), actual.Timestamp()),
+		compare.Equal("flags", expected.Flags(), actual.Flags()),
+		compare.Equal("count", expected.Count(), actual.Count()),
+		compare.Equal("sum", expected.Sum(), actual.Sum()),
+		compare.Positional("quantile", expected.QuantileValues().Len(), actual.QuantileValues().Len(),
+			expected.QuantileValues().At, actual.QuantileValues().At,
+			func(expected, actual pmetric.SummaryDataPointValueAtQuantile) error {
+				return errors.Join(
+					compare.Equal("quantile", expected.Quantile(), actual.Quantile()),
+					compare.Equal("value", expected.Value(), actual.Value()),
+				)
+			}),
+	)
+}
+
+func compareExemplars(opts *compare.Options, expected, actual pmetric.ExemplarSlice) error {
+	return compare.Positional("exemplar", expected.Len(), actual.Len(), expected.At, actual.At,
+		func(expected, actual pmetric.Exemplar) error {
+			errs := errors.Join(
+				compare.Timestamp(opts, "timestamp", expected.Timestamp(), actual.Timestamp()),
+				compare.Prefix("filtered", compare.Attributes(opts, expected.FilteredAttributes(), actual.FilteredAttributes())),
+				compare.ID(opts, "trace id", expected.TraceID(), actual.TraceID()),
+				compare.ID(opts, "span id", expected.SpanID(), actual.SpanID()),
+			)
+			if expected.ValueType() != actual.ValueType() {
+				return errors.Join(errs, compare.Equal("value type", expected.ValueType(), actual.ValueType()))
+			}
+			switch expected.ValueType() {
+			case pmetric.ExemplarValueTypeInt:
+				return errors.Join(errs, compare.Equal("value", expected.IntValue(), actual.IntValue()))
+			case pmetric.ExemplarValueTypeDouble:
+				return errors.Join(errs, compare.Equal("value", expected.DoubleValue(), actual.DoubleValue()))
+			}
+			return errs
+		})
+}
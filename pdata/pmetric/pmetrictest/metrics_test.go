@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pmetrictest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func newMetrics() pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "svc")
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName("lib")
+
+	sum := sm.Metrics().AppendEmpty()
+	sum.SetName("requests")
+	sum.SetEmptySum().SetIsMonotonic(true)
+	for _, code := range []string{"200", "500"} {
+		dp := sum.Sum().DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("code", code)
+		dp.SetStartTimestamp(1)
+		dp.SetTimestamp(2)
+		dp.SetIntValue(10)
+	}
+
+	hist := sm.Metrics().AppendEmpty()
+	hist.SetName("duration")
+	dp := hist.SetEmptyHistogram().DataPoints().AppendEmpty()
+	dp.SetCount(3)
+	dp.SetSum(4.5)
+	dp.ExplicitBounds().FromRaw([]float64{1, 2})
+	dp.BucketCounts().FromRaw([]uint64{1, 1, 1})
+	return md
+}
+
+func TestCompareMetrics(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(pmetric.Metrics)
+		options  []CompareMetricsOption
+		expected []string
+	}{
+		{
+			name:   "equal",
+			modify: func(pmetric.Metrics) {},
+		},
+		{
+			name: "data point value",
+			modify: func(md pmetric.Metrics) {
+				md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(1).SetIntValue(11)
+			},
+			expected: []string{`resource "map[service.name:svc]": scope "lib": metric "requests": datapoint "map[code:500]": value doesn't match expected: 10, actual: 11`},
+		},
+		{
+			name: "data point value type",
+			modify: func(md pmetric.Metrics) {
+				md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).SetDoubleValue(10)
+			},
+			expected: []string{`resource "map[service.name:svc]": scope "lib": metric "requests": datapoint "map[code:200]": value type doesn't match expected: Int, actual: Double`},
+		},
+		{
+			name: "missing data point",
+			modify: func(md pmetric.Metrics) {
+				md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(1).Attributes().PutStr("code", "404")
+			},
+			expected: []string{
+				`resource "map[service.name:svc]": scope "lib": metric "requests": missing expected datapoint "map[code:500]"`,
+				`resource "map[service.name:svc]": scope "lib": metric "requests": unexpected datapoint "map[code:404]"`,
+			},
+		},
+		{
+			name: "metric type",
+			modify: func(md pmetric.Metrics) {
+				md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(1).SetEmptyGauge()
+			},
+			expected: []string{`resource "map[service.name:svc]": scope "lib": metric "duration": type doesn't match expected: Histogram, actual: Gauge`},
+		},
+		{
+			name: "histogram",
+			modify: func(md pmetric.Metrics) {
+				dp := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(1).Histogram().DataPoints().At(0)
+				dp.RemoveSum()
+				dp.BucketCounts().FromRaw([]uint64{0, 2, 1})
+			},
+			expected: []string{
+				`resource "map[service.name:svc]": scope "lib": metric "duration": datapoint "map[]": sum presence doesn't match expected: true, actual: false`,
+				`resource "map[service.name:svc]": scope "lib": metric "duration": datapoint "map[]": bucket counts doesn't match expected: [1 1 1], actual: [0 2 1]`,
+			},
+		},
+		{
+			name: "start timestamps",
+			modify: func(md pmetric.Metrics) {
+				md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).SetStartTimestamp(0)
+			},
+			expected: []string{`resource "map[service.name:svc]": scope "lib": metric "requests": datapoint "map[code:200]": start timestamp doesn't match expected: 1970-01-01 00:00:00.000000001 +0000 UTC, actual: 1970-01-01 00:00:00 +0000 UTC`},
+		},
+		{
+			name: "ignore start timestamps",
+			modify: func(md pmetric.Metrics) {
+				md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0).SetStartTimestamp(0)
+			},
+			options: []CompareMetricsOption{IgnoreStartTimestamps()},
+		},
+		{
+			name: "ignore timestamps",
+			modify: func(md pmetric.Metrics) {
+				dp := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+				dp.SetStartTimestamp(0)
+				dp.SetTimestamp(0)
+			},
+			options: []CompareMetricsOption{IgnoreTimestamps()},
+		},
+		{
+			name: "data points order",
+			modify: func(md pmetric.Metrics) {
+				md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().Sort(func(a, b pmetric.NumberDataPoint) bool {
+					av, _ := a.Attributes().Get("code")
+					bv, _ := b.Attributes().Get("code")
+					return av.Str() > bv.Str()
+				})
+			},
+			expected: []string{
+				`resource "map[service.name:svc]": scope "lib": metric "requests": datapoints are out of order: datapoint "map[code:200]" expected at index 0, found at index 1`,
+				`resource "map[service.name:svc]": scope "lib": metric "requests": datapoints are out of order: datapoint "map[code:500]" expected at index 1, found at index 0`,
+			},
+		},
+		{
+			name: "ignore data points and metrics order",
+			modify: func(md pmetric.Metrics) {
+				metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+				metrics.At(0).Sum().DataPoints().Sort(func(a, b pmetric.NumberDataPoint) bool {
+					av, _ := a.Attributes().Get("code")
+					bv, _ := b.Attributes().Get("code")
+					return av.Str() > bv.Str()
+				})
+				metrics.Sort(func(a, b pmetric.Metric) bool { return a.Name() < b.Name() })
+			},
+			options: []CompareMetricsOption{IgnoreDataPointsOrder(), IgnoreMetricsOrder()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected, actual := newMetrics(), newMetrics()
+			tt.modify(actual)
+			err := CompareMetrics(expected, actual, tt.options...)
+			if len(tt.expected) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.expected, splitErrors(err))
+		})
+	}
+}
+
+func TestCompareMetric(t *testing.T) {
+	expected := newMetrics().ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	actual := pmetric.NewMetric()
+	expected.CopyTo(actual)
+	actual.SetUnit("1")
+	assert.EqualError(t, CompareMetric(expected, actual), `unit doesn't match expected: "", actual: "1"`)
+}
+
+func splitErrors(err error) []string {
+	var msgs []string
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			msgs = append(msgs, splitErrors(e)...)
+		}
+		return msgs
+	}
+	return []string{err.Error()}
+}
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pmetrictest // import "go.opentelemetry.io/collector/pdata/pmetric/pmetrictest"
+
+import (
+	"go.opentelemetry.io/collector/pdata/internal/compare"
+)
+
+// CompareMetricsOption configures the differences ignored when comparing metrics.
+type CompareMetricsOption interface {
+	applyOnMetrics(*compare.Options)
+}
+
+type compareMetricsOptionFunc func(*compare.Options)
+
+func (f compareMetricsOptionFunc) applyOnMetrics(opts *compare.Options) {
+	f(opts)
+}
+
+// IgnoreTimestamps ignores the timestamps and start timestamps of the data points,
+// and the timestamps of their exemplars.
+func IgnoreTimestamps() CompareMetricsOption {
+	return compareMetricsOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreTimestamps = true
+	})
+}
+
+// IgnoreStartTimestamps ignores the start timestamps of the data points.
+func IgnoreStartTimestamps() CompareMetricsOption {
+	return compareMetricsOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreStartTimestamps = true
+	})
+}
+
+// IgnoreResourceMetricsOrder ignores the order of the ResourceMetrics.
+func IgnoreResourceMetricsOrder() CompareMetricsOption {
+	return compareMetricsOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreResourcesOrder = true
+	})
+}
+
+// IgnoreScopeMetricsOrder ignores the order of the ScopeMetrics in a ResourceMetrics.
+func IgnoreScopeMetricsOrder() CompareMetricsOption {
+	return compareMetricsOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreScopesOrder = true
+	})
+}
+
+// IgnoreMetricsOrder ignores the order of the metrics in a ScopeMetrics.
+func IgnoreMetricsOrder() CompareMetricsOption {
+	return compareMetricsOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreRecordsOrder = true
+	})
+}
+
+// IgnoreDataPointsOrder ignores the order of the data points of a metric.
+func IgnoreDataPointsOrder() CompareMetricsOption {
+	return compareMetricsOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreDataPointsOrder = true
+	})
+}
+
+// IgnoreAttributesOrder ignores the order of the attributes of the resources, scopes,
+// data points and exemplars, and of the metadata of the metrics.
+func IgnoreAttributesOrder() CompareMetricsOption {
+	return compareMetricsOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreAttributesOrder = true
+	})
+}
+
+// IgnoreIDs ignores the trace and span IDs of the exemplars.
+func IgnoreIDs() CompareMetricsOption {
+	return compareMetricsOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreIDs = true
+	})
+}
+
+func newOptions(options []CompareMetricsOption) *compare.Options {
+	opts := &compare.Options{}
+	for _, o := range options {
+		o.applyOnMetrics(opts)
+	}
+	return opts
+}
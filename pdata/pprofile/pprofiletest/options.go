@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofiletest // import "go.opentelemetry.io/collector/pdata/pprofile/pprofiletest"
+
+import (
+	"go.opentelemetry.io/collector/pdata/internal/compare"
+)
+
+// CompareProfilesOption configures the differences ignored when comparing profiles.
+type CompareProfilesOption interface {
+	applyOnProfiles(*compare.Options)
+}
+
+type compareProfilesOptionFunc func(*compare.Options)
+
+func (f compareProfilesOptionFunc) applyOnProfiles(opts *compare.Options) {
+	f(opts)
+}
+
+// IgnoreTimestamps ignores the times and start times of the profiles, and the timestamps of their samples.
+func IgnoreTimestamps() CompareProfilesOption {
+	return compareProfilesOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreTimestamps = true
+	})
+}
+
+// IgnoreResourceProfilesOrder ignores the order of the ResourceProfiles.
+func IgnoreResourceProfilesOrder() CompareProfilesOption {
+	return compareProfilesOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreResourcesOrder = true
+	})
+}
+
+// IgnoreScopeProfilesOrder ignores the order of the ScopeProfiles in a ResourceProfiles.
+func IgnoreScopeProfilesOrder() CompareProfilesOption {
+	return compareProfilesOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreScopesOrder = true
+	})
+}
+
+// IgnoreProfilesOrder ignores the order of the profiles in a ScopeProfiles.
+func IgnoreProfilesOrder() CompareProfilesOption {
+	return compareProfilesOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreRecordsOrder = true
+	})
+}
+
+// IgnoreAttributesOrder ignores the order of the attributes of the resources, scopes and profiles.
+func IgnoreAttributesOrder() CompareProfilesOption {
+	return compareProfilesOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreAttributesOrder = true
+	})
+}
+
+// IgnoreIDs ignores the IDs of the profiles, and the trace and span IDs of their links.
+// The profiles are then identified by their attributes.
+func IgnoreIDs() CompareProfilesOption {
+	return compareProfilesOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreIDs = true
+	})
+}
+
+func newOptions(options []CompareProfilesOption) *compare.Options {
+	opts := &compare.Options{}
+	for _, o := range options {
+		o.applyOnProfiles(opts)
+	}
+	return opts
+}
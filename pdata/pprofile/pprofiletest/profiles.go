@@ -0,0 +1,190 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pprofiletest compares profiles, e.g. the ones captured by consumertest.ProfilesSink.
+package pprofiletest // import "go.opentelemetry.io/collector/pdata/pprofile/pprofiletest"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/internal/compare"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+// CompareProfiles returns an error joining the differences between the expected and actual profiles,
+// each qualified with its path, e.g. `resource "map[service.name:svc]": scope "lib": profile "0102...": ...`.
+// It returns nil if the profiles are equal, ignoring the differences configured by the options.
+func CompareProfiles(expected, actual pprofile.Profiles, options ...CompareProfilesOption) error {
+	return compareResourceProfilesSlice(newOptions(options), expected.ResourceProfiles(), actual.ResourceProfiles())
+}
+
+// CompareResourceProfiles returns an error joining the differences between the expected and actual ResourceProfiles.
+func CompareResourceProfiles(expected, actual pprofile.ResourceProfiles, options ...CompareProfilesOption) error {
+	return compareResourceProfiles(newOptions(options), expected, actual)
+}
+
+// CompareScopeProfiles returns an error joining the differences between the expected and actual ScopeProfiles.
+func CompareScopeProfiles(expected, actual pprofile.ScopeProfiles, options ...CompareProfilesOption) error {
+	return compareScopeProfiles(newOptions(options), expected, actual)
+}
+
+// CompareProfile returns an error joining the differences between the expected and actual profiles.
+func CompareProfile(expected, actual pprofile.Profile, options ...CompareProfilesOption) error {
+	return compareProfile(newOptions(options), expected, actual)
+}
+
+func compareResourceProfilesSlice(opts *compare.Options, expected, actual pprofile.ResourceProfilesSlice) error {
+	return compare.Slice("resource", !opts.IgnoreResourcesOrder, expected.Len(), actual.Len(), expected.At, actual.At,
+		func(rp pprofile.ResourceProfiles) string { return compare.ResourceID(rp.Resource()) },
+		func(expected, actual pprofile.ResourceProfiles) error {
+			return compareResourceProfiles(opts, expected, actual)
+		})
+}
+
+func compareResourceProfiles(opts *compare.Options, expected, actual pprofile.ResourceProfiles) error {
+	return errors.Join(
+		compare.Resource(opts, expected.Resource(), actual.Resource()),
+		compare.Equal("schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		compare.Slice("scope", !opts.IgnoreScopesOrder, expected.ScopeProfiles().Len(), actual.ScopeProfiles().Len(),
+			expected.ScopeProfiles().At, actual.ScopeProfiles().At,
+			func(sp pprofile.ScopeProfiles) string { return compare.ScopeID(sp.Scope()) },
+			func(expected, actual pprofile.ScopeProfiles) error {
+				return compareScopeProfiles(opts, expected, actual)
+			}),
+	)
+}
+
+func compareScopeProfiles(opts *compare.Options, expected, actual pprofile.ScopeProfiles) error {
+	profileID := func(p pprofile.Profile) string { return p.ProfileID().String() }
+	if opts.IgnoreIDs {
+		profileID = func(p pprofile.Profile) string { return fmt.Sprint(p.Attributes().AsRaw()) }
+	}
+	return errors.Join(
+		compare.Scope(opts, expected.Scope(), actual.Scope()),
+		compare.Equal("schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		compare.Slice("profile", !opts.IgnoreRecordsOrder, expected.Profiles().Len(), actual.Profiles().Len(),
+			expected.Profiles().At, actual.Profiles().At,
+			profileID,
+			func(expected, actual pprofile.Profile) error { return compareProfile(opts, expected, actual) }),
+	)
+}
+
+func compareProfile(opts *compare.Options, expected, actual pprofile.Profile) error {
+	return errors.Join(
+		compare.ID(opts, "profile id", expected.ProfileID(), actual.ProfileID()),
+		compare.Timestamp(opts, "time", expected.Time(), actual.Time()),
+		compare.Timestamp(opts, "start time", expected.StartTime(), actual.StartTime()),
+		compare.Equal("duration", expected.Duration(), actual.Duration()),
+		compare.Attributes(opts, expected.Attributes(), actual.Attributes()),
+		compare.Equal("dropped attributes count", expected.DroppedAttributesCount(), actual.DroppedAttributesCount()),
+		compare.Prefix("period type", compareValueType(expected.PeriodType(), actual.PeriodType())),
+		compare.Equal("period", expected.Period(), actual.Period()),
+		compare.Equal("default sample type string index", expected.DefaultSampleTypeStrindex(), actual.DefaultSampleTypeStrindex()),
+		compare.Raw("comment string indices", expected.CommentStrindices().AsRaw(), actual.CommentStrindices().AsRaw()),
+		compare.Equal("original payload format", expected.OriginalPayloadFormat(), actual.OriginalPayloadFormat()),
+		compare.Raw("original payload", expected.OriginalPayload().AsRaw(), actual.OriginalPayload().AsRaw()),
+		compare.Positional("sample type", expected.SampleType().Len(), actual.SampleType().Len(),
+			expected.SampleType().At, actual.SampleType().At, compareValueType),
+		compare.Positional("sample", expected.Sample().Len(), actual.Sample().Len(),
+			expected.Sample().At, actual.Sample().At,
+			func(expected, actual pprofile.Sample) error { return compareSample(opts, expected, actual) }),
+		compare.Raw("location indices", expected.LocationIndices().AsRaw(), actual.LocationIndices().AsRaw()),
+		compare.Positional("mapping", expected.MappingTable().Len(), actual.MappingTable().Len(),
+			expected.MappingTable().At, actual.MappingTable().At, compareMapping),
+		compare.Positional("location", expected.LocationTable().Len(), actual.LocationTable().Len(),
+			expected.LocationTable().At, actual.LocationTable().At, compareLocation),
+		compare.Positional("function", expected.FunctionTable().Len(), actual.FunctionTable().Len(),
+			expected.FunctionTable().At, actual.FunctionTable().At, compareFunction),
+		compare.Positional("attribute table entry", expected.AttributeTable().Len(), actual.AttributeTable().Len(),
+			expected.AttributeTable().At, actual.AttributeTable().At, compareAttribute),
+		compare.Positional("attribute unit", expected.AttributeUnits().Len(), actual.AttributeUnits().Len(),
+			expected.AttributeUnits().At, actual.AttributeUnits().At, compareAttributeUnit),
+		compare.Positional("link", expected.LinkTable().Len(), actual.LinkTable().Len(),
+			expected.LinkTable().At, actual.LinkTable().At,
+			func(expected, actual pprofile.Link) error { return compareLink(opts, expected, actual) }),
+		compare.Raw("string table", expected.StringTable().AsRaw(), actual.StringTable().AsRaw()),
+	)
+}
+
+func compareValueType(expected, actual pprofile.ValueType) error {
+	return errors.Join(
+		compare.Equal("type string index", expected.TypeStrindex(), actual.TypeStrindex()),
+		compare.Equal("unit string index", expected.UnitStrindex(), actual.UnitStrindex()),
+		compare.Equal("aggregation temporality", expected.AggregationTemporality(), actual.AggregationTemporality()),
+	)
+}
+
+func compareSample(opts *compare.Options, expected, actual pprofile.Sample) error {
+	errs := errors.Join(
+		compare.Equal("locations start index", expected.LocationsStartIndex(), actual.LocationsStartIndex()),
+		compare.Equal("locations length", expected.LocationsLength(), actual.LocationsLength()),
+		compare.Raw("value", expected.Value().AsRaw(), actual.Value().AsRaw()),
+		compare.Raw("attribute indices", expected.AttributeIndices().AsRaw(), actual.AttributeIndices().AsRaw()),
+	)
+	if opts.IgnoreTimestamps {
+		return errs
+	}
+	return errors.Join(errs, compare.Raw("timestamps", expected.TimestampsUnixNano().AsRaw(), actual.TimestampsUnixNano().AsRaw()))
+}
+
+func compareMapping(expected, actual pprofile.Mapping) error {
+	return errors.Join(
+		compare.Equal("memory start", expected.MemoryStart(), actual.MemoryStart()),
+		compare.Equal("memory limit", expected.MemoryLimit(), actual.MemoryLimit()),
+		compare.Equal("file offset", expected.FileOffset(), actual.FileOffset()),
+		compare.Equal("filename string index", expected.FilenameStrindex(), actual.FilenameStrindex()),
+		compare.Raw("attribute indices", expected.AttributeIndices().AsRaw(), actual.AttributeIndices().AsRaw()),
+		compare.Equal("has functions", expected.HasFunctions(), actual.HasFunctions()),
+		compare.Equal("has filenames", expected.HasFilenames(), actual.HasFilenames()),
+		compare.Equal("has line numbers", expected.HasLineNumbers(), actual.HasLineNumbers()),
+		compare.Equal("has inline frames", expected.HasInlineFrames(), actual.HasInlineFrames()),
+	)
+}
+
+func compareLocation(expected, actual pprofile.Location) error {
+	return errors.Join(
+		compare.Optional("mapping index", expected.HasMappingIndex(), expected.MappingIndex(), actual.HasMappingIndex(), actual.MappingIndex()),
+		compare.Equal("address", expected.Address(), actual.Address()),
+		compare.Positional("line", expected.Line().Len(), actual.Line().Len(), expected.Line().At, actual.Line().At,
+			func(expected, actual pprofile.Line) error {
+				return errors.Join(
+					compare.Equal("function index", expected.FunctionIndex(), actual.FunctionIndex()),
+					compare.Equal("line", expected.Line(), actual.Line()),
+					compare.Equal("column", expected.Column(), actual.Column()),
+				)
+			}),
+		compare.Equal("is folded", expected.IsFolded(), actual.IsFolded()),
+		compare.Raw("attribute indices", expected.AttributeIndices().AsRaw(), actual.AttributeIndices().AsRaw()),
+	)
+}
+
+func compareFunction(expected, actual pprofile.Function) error {
+	return errors.Join(
+		compare.Equal("name string index", expected.NameStrindex(), actual.NameStrindex()),
+		compare.Equal("system name string index", expected.SystemNameStrindex(), actual.SystemNameStrindex()),
+		compare.Equal("filename string index", expected.FilenameStrindex(), actual.FilenameStrindex()),
+		compare.Equal("start line", expected.StartLine(), actual.StartLine()),
+	)
+}
+
+func compareAttribute(expected, actual pprofile.Attribute) error {
+	return errors.Join(
+		compare.Equal("key", expected.Key(), actual.Key()),
+		compare.Value("value", expected.Value(), actual.Value()),
+	)
+}
+
+func compareAttributeUnit(expected, actual pprofile.AttributeUnit) error {
+	return errors.Join(
+		compare.Equal("attribute key string index", expected.AttributeKeyStrindex(), actual.AttributeKeyStrindex()),
+		compare.Equal("unit string index", expected.UnitStrindex(), actual.UnitStrindex()),
+	)
+}
+
+func compareLink(opts *compare.Options, expected, actual pprofile.Link) error {
+	return errors.Join(
+		compare.ID(opts, "trace id", expected.TraceID(), actual.TraceID()),
+		compare.ID(opts, "span id", expected.SpanID(), actual.SpanID()),
+	)
+}
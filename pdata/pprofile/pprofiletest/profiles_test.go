@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pprofiletest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/pprofile"
+)
+
+func newProfiles() pprofile.Profiles {
+	pd := pprofile.NewProfiles()
+	rp := pd.ResourceProfiles().AppendEmpty()
+	rp.Resource().Attributes().PutStr("service.name", "svc")
+	sp := rp.ScopeProfiles().AppendEmpty()
+	sp.Scope().SetName("lib")
+	for i, kind := range []string{"cpu", "memory"} {
+		p := sp.Profiles().AppendEmpty()
+		p.SetProfileID(pprofile.ProfileID([16]byte{byte(i + 1)}))
+		p.SetTime(1)
+		p.Attributes().PutStr("kind", kind)
+		p.StringTable().FromRaw([]string{"", "main"})
+		p.FunctionTable().AppendEmpty().SetNameStrindex(1)
+		loc := p.LocationTable().AppendEmpty()
+		loc.Line().AppendEmpty().SetFunctionIndex(0)
+		sample := p.Sample().AppendEmpty()
+		sample.SetLocationsLength(1)
+		sample.Value().FromRaw([]int64{10})
+		sample.TimestampsUnixNano().FromRaw([]uint64{2})
+	}
+	return pd
+}
+
+func TestCompareProfiles(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(pprofile.Profiles)
+		options  []CompareProfilesOption
+		expected []string
+	}{
+		{
+			name:   "equal",
+			modify: func(pprofile.Profiles) {},
+		},
+		{
+			name: "sample value",
+			modify: func(pd pprofile.Profiles) {
+				pd.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().At(1).Sample().At(0).Value().SetAt(0, 11)
+			},
+			expected: []string{`resource "map[service.name:svc]": scope "lib": profile "02000000000000000000000000000000": sample[0]: value doesn't match expected: [10], actual: [11]`},
+		},
+		{
+			name: "string table",
+			modify: func(pd pprofile.Profiles) {
+				pd.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().At(0).StringTable().Append("extra")
+			},
+			expected: []string{`resource "map[service.name:svc]": scope "lib": profile "01000000000000000000000000000000": string table doesn't match expected: [ main], actual: [ main extra]`},
+		},
+		{
+			name: "location line",
+			modify: func(pd pprofile.Profiles) {
+				pd.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().At(0).LocationTable().At(0).Line().At(0).SetLine(3)
+			},
+			expected: []string{`resource "map[service.name:svc]": scope "lib": profile "01000000000000000000000000000000": location[0]: line[0]: line doesn't match expected: 0, actual: 3`},
+		},
+		{
+			name: "ignore ids and timestamps",
+			modify: func(pd pprofile.Profiles) {
+				profiles := pd.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles()
+				for i := 0; i < profiles.Len(); i++ {
+					profiles.At(i).SetProfileID(pprofile.NewProfileIDEmpty())
+					profiles.At(i).SetTime(0)
+					profiles.At(i).Sample().At(0).TimestampsUnixNano().FromRaw([]uint64{3})
+				}
+			},
+			options: []CompareProfilesOption{IgnoreIDs(), IgnoreTimestamps()},
+		},
+		{
+			name: "profiles order",
+			modify: func(pd pprofile.Profiles) {
+				profiles := pd.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles()
+				profiles.Sort(func(a, b pprofile.Profile) bool { return a.ProfileID().String() > b.ProfileID().String() })
+			},
+			options: []CompareProfilesOption{IgnoreProfilesOrder()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected, actual := newProfiles(), newProfiles()
+			tt.modify(actual)
+			err := CompareProfiles(expected, actual, tt.options...)
+			if len(tt.expected) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.expected, splitErrors(err))
+		})
+	}
+}
+
+func TestCompareProfile(t *testing.T) {
+	expected := newProfiles().ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().At(0)
+	actual := pprofile.NewProfile()
+	expected.CopyTo(actual)
+	actual.SetPeriod(10)
+	assert.EqualError(t, CompareProfile(expected, actual), "period doesn't match expected: 0, actual: 10")
+}
+
+func splitErrors(err error) []string {
+	var msgs []string
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			msgs = append(msgs, splitErrors(e)...)
+		}
+		return msgs
+	}
+	return []string{err.Error()}
+}
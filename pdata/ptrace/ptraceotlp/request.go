@@ -5,9 +5,12 @@ package ptraceotlp // import "go.opentelemetry.io/collector/pdata/ptrace/ptraceo
 
 import (
 	"bytes"
+	"io"
+	"sync"
 
 	"go.opentelemetry.io/collector/pdata/internal"
 	otlpcollectortrace "go.opentelemetry.io/collector/pdata/internal/data/protogen/collector/trace/v1"
+	otlptrace "go.opentelemetry.io/collector/pdata/internal/data/protogen/trace/v1"
 	"go.opentelemetry.io/collector/pdata/internal/json"
 	"go.opentelemetry.io/collector/pdata/internal/otlp"
 	"go.opentelemetry.io/collector/pdata/ptrace"
@@ -20,6 +23,8 @@ var jsonUnmarshaler = &ptrace.JSONUnmarshaler{}
 type ExportRequest struct {
 	orig  *otlpcollectortrace.ExportTraceServiceRequest
 	state *internal.State
+	// pooled is set if orig was obtained from requestPool.
+	pooled bool
 }
 
 // NewExportRequest returns an empty ExportRequest.
@@ -31,6 +36,39 @@ func NewExportRequest() ExportRequest {
 	}
 }
 
+var requestPool = sync.Pool{
+	New: func() any {
+		return &otlpcollectortrace.ExportTraceServiceRequest{}
+	},
+}
+
+// NewPooledExportRequest returns an empty ExportRequest which reuses the memory of previously released requests:
+// UnmarshalProto decodes into their resources, scopes and spans instead of allocating new ones.
+// Once the request and all the data obtained from it are no longer referenced, it should be released with Release.
+func NewPooledExportRequest() ExportRequest {
+	state := internal.StateMutable
+	return ExportRequest{
+		orig:   requestPool.Get().(*otlpcollectortrace.ExportTraceServiceRequest),
+		state:  &state,
+		pooled: true,
+	}
+}
+
+// Release returns the memory of an ExportRequest created with NewPooledExportRequest to the pool, so it can
+// be reused by other requests. The request and any data obtained from it, including the ptrace.Traces returned by Traces,
+// MUST NOT be used after calling Release, and Release MUST NOT be called more than once.
+// For requests not created with NewPooledExportRequest this function is a no-op.
+func (ms ExportRequest) Release() {
+	if !ms.pooled {
+		return
+	}
+	// Any attempt to modify the data through a leaked reference panics.
+	*ms.state = internal.StateReadOnly
+	// Keep the allocated messages for reuse, but drop everything else they reference so it can be garbage collected.
+	otlp.ResetTracesRequest(ms.orig)
+	requestPool.Put(ms.orig)
+}
+
 // NewExportRequestFromTraces returns a ExportRequest from ptrace.Traces.
 // Because ExportRequest is a wrapper for ptrace.Traces,
 // any changes to the provided Traces struct will be reflected in the ExportRequest and vice versa.
@@ -48,7 +86,11 @@ func (ms ExportRequest) MarshalProto() ([]byte, error) {
 
 // UnmarshalProto unmarshalls ExportRequest from proto bytes.
 func (ms ExportRequest) UnmarshalProto(data []byte) error {
-	if err := ms.orig.Unmarshal(data); err != nil {
+	unmarshal := ms.orig.Unmarshal
+	if ms.pooled {
+		unmarshal = func(data []byte) error { return otlp.UnmarshalTracesRequestReusing(ms.orig, data) }
+	}
+	if err := unmarshal(data); err != nil {
 		return err
 	}
 	otlp.MigrateTraces(ms.orig.ResourceSpans)
@@ -77,3 +119,22 @@ func (ms ExportRequest) UnmarshalJSON(data []byte) error {
 func (ms ExportRequest) Traces() ptrace.Traces {
 	return ptrace.Traces(internal.NewTraces(ms.orig, ms.state))
 }
+
+// UnmarshalProtoStream reads a proto-encoded ExportRequest from r without materializing the whole request.
+// For every ResourceSpans in the request fn is called with a ptrace.Traces holding only that ResourceSpans,
+// so the memory needed is bounded by the largest ResourceSpans instead of the whole request.
+// This can be used to enforce limits or to split oversized requests while decoding.
+//
+// Decoding stops at the first error returned by fn, which is returned to the caller.
+// The ptrace.Traces passed to fn is owned by fn.
+func UnmarshalProtoStream(r io.Reader, fn func(ptrace.Traces) error) error {
+	return otlp.ReadResources(r, func(buf []byte) error {
+		orig := &otlptrace.ResourceSpans{}
+		if err := orig.Unmarshal(buf); err != nil {
+			return err
+		}
+		origs := []*otlptrace.ResourceSpans{orig}
+		otlp.MigrateTraces(origs)
+		return fn(ptrace.Traces(internal.TracesFromProto(otlptrace.TracesData{ResourceSpans: origs})))
+	})
+}
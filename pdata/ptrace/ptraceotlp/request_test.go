@@ -4,12 +4,16 @@
 package ptraceotlp
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/ptrace"
 )
 
 var _ json.Unmarshaler = ExportRequest{}
@@ -54,3 +58,91 @@ func TestRequestJSON(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, strings.Join(strings.Fields(string(tracesRequestJSON)), ""), string(got))
 }
+
+func TestUnmarshalProtoStream(t *testing.T) {
+	req := NewExportRequest()
+	for _, name := range []string{"a", "b", "c"} {
+		req.Traces().ResourceSpans().AppendEmpty().Resource().Attributes().PutStr("name", name)
+	}
+	buf, err := req.MarshalProto()
+	require.NoError(t, err)
+
+	var got []ptrace.Traces
+	require.NoError(t, UnmarshalProtoStream(bytes.NewReader(buf), func(md ptrace.Traces) error {
+		got = append(got, md)
+		return nil
+	}))
+	require.Len(t, got, 3)
+	for i, md := range got {
+		require.Equal(t, 1, md.ResourceSpans().Len())
+		assert.Equal(t, req.Traces().ResourceSpans().At(i), md.ResourceSpans().At(0))
+	}
+
+	errStop := errors.New("stop")
+	require.ErrorIs(t, UnmarshalProtoStream(bytes.NewReader(buf), func(ptrace.Traces) error { return errStop }), errStop)
+	require.Error(t, UnmarshalProtoStream(bytes.NewReader([]byte{0x0a, 0x02, 0xff, 0xff}), func(ptrace.Traces) error { return nil }))
+}
+
+func TestPooledExportRequest(t *testing.T) {
+	req := NewPooledExportRequest()
+	require.NoError(t, req.UnmarshalJSON(tracesRequestJSON))
+	data := req.Traces()
+	assert.Equal(t, 1, data.ResourceSpans().Len())
+
+	req.Release()
+	assert.True(t, data.IsReadOnly())
+	assert.Panics(t, func() { data.ResourceSpans().AppendEmpty() })
+
+	req = NewPooledExportRequest()
+	assert.Equal(t, 0, req.Traces().ResourceSpans().Len())
+	assert.False(t, req.Traces().IsReadOnly())
+	src := NewExportRequest()
+	require.NoError(t, src.UnmarshalJSON(tracesRequestJSON))
+	src.Traces().CopyTo(req.Traces())
+	assert.Equal(t, src.Traces(), req.Traces())
+	req.Release()
+
+	// Release is a no-op for requests not obtained from the pool.
+	req = NewExportRequest()
+	req.Traces().ResourceSpans().AppendEmpty()
+	req.Release()
+	assert.Equal(t, 1, req.Traces().ResourceSpans().Len())
+	assert.False(t, req.Traces().IsReadOnly())
+}
+
+func BenchmarkExportRequestUnmarshalProto(b *testing.B) {
+	req := NewExportRequest()
+	for i := 0; i < 10; i++ {
+		rs := req.Traces().ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("service.name", "benchmark")
+		spans := rs.ScopeSpans().AppendEmpty().Spans()
+		for j := 0; j < 100; j++ {
+			span := spans.AppendEmpty()
+			span.SetName("span")
+			span.Attributes().PutStr("key", "value")
+			span.Attributes().PutInt("count", int64(j))
+		}
+	}
+	buf, err := req.MarshalProto()
+	require.NoError(b, err)
+
+	b.Run("new", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			req := NewExportRequest()
+			if err := req.UnmarshalProto(buf); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			req := NewPooledExportRequest()
+			if err := req.UnmarshalProto(buf); err != nil {
+				b.Fatal(err)
+			}
+			req.Release()
+		}
+	})
+}
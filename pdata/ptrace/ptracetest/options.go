@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ptracetest // import "go.opentelemetry.io/collector/pdata/ptrace/ptracetest"
+
+import (
+	"go.opentelemetry.io/collector/pdata/internal/compare"
+)
+
+// CompareTracesOption configures the differences ignored when comparing traces.
+type CompareTracesOption interface {
+	applyOnTraces(*compare.Options)
+}
+
+type compareTracesOptionFunc func(*compare.Options)
+
+func (f compareTracesOptionFunc) applyOnTraces(opts *compare.Options) {
+	f(opts)
+}
+
+// IgnoreTimestamps ignores the start and end timestamps of the spans, and the timestamps of their events.
+func IgnoreTimestamps() CompareTracesOption {
+	return compareTracesOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreTimestamps = true
+	})
+}
+
+// IgnoreResourceSpansOrder ignores the order of the ResourceSpans.
+func IgnoreResourceSpansOrder() CompareTracesOption {
+	return compareTracesOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreResourcesOrder = true
+	})
+}
+
+// IgnoreScopeSpansOrder ignores the order of the ScopeSpans in a ResourceSpans.
+func IgnoreScopeSpansOrder() CompareTracesOption {
+	return compareTracesOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreScopesOrder = true
+	})
+}
+
+// IgnoreSpansOrder ignores the order of the spans in a ScopeSpans.
+func IgnoreSpansOrder() CompareTracesOption {
+	return compareTracesOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreRecordsOrder = true
+	})
+}
+
+// IgnoreAttributesOrder ignores the order of the attributes of the resources, scopes,
+// spans, events and links.
+func IgnoreAttributesOrder() CompareTracesOption {
+	return compareTracesOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreAttributesOrder = true
+	})
+}
+
+// IgnoreIDs ignores the trace, span and parent span IDs of the spans and links.
+func IgnoreIDs() CompareTracesOption {
+	return compareTracesOptionFunc(func(opts *compare.Options) {
+		opts.IgnoreIDs = true
+	})
+}
+
+func newOptions(options []CompareTracesOption) *compare.Options {
+	opts := &compare.Options{}
+	for _, o := range options {
+		o.applyOnTraces(opts)
+	}
+	return opts
+}
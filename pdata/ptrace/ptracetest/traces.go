@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ptracetest compares traces, e.g. the ones captured by consumertest.TracesSink.
+package ptracetest // import "go.opentelemetry.io/collector/pdata/ptrace/ptracetest"
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/pdata/internal/compare"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// CompareTraces returns an error joining the differences between the expected and actual traces,
+// each qualified with its path, e.g. `resource "map[service.name:svc]": scope "lib": span "op": name ...`.
+// It returns nil if the traces are equal, ignoring the differences configured by the options.
+func CompareTraces(expected, actual ptrace.Traces, options ...CompareTracesOption) error {
+	return compareResourceSpansSlice(newOptions(options), expected.ResourceSpans(), actual.ResourceSpans())
+}
+
+// CompareResourceSpans returns an error joining the differences between the expected and actual ResourceSpans.
+func CompareResourceSpans(expected, actual ptrace.ResourceSpans, options ...CompareTracesOption) error {
+	return compareResourceSpans(newOptions(options), expected, actual)
+}
+
+// CompareScopeSpans returns an error joining the differences between the expected and actual ScopeSpans.
+func CompareScopeSpans(expected, actual ptrace.ScopeSpans, options ...CompareTracesOption) error {
+	return compareScopeSpans(newOptions(options), expected, actual)
+}
+
+// CompareSpan returns an error joining the differences between the expected and actual spans.
+func CompareSpan(expected, actual ptrace.Span, options ...CompareTracesOption) error {
+	return compareSpan(newOptions(options), expected, actual)
+}
+
+func compareResourceSpansSlice(opts *compare.Options, expected, actual ptrace.ResourceSpansSlice) error {
+	return compare.Slice("resource", !opts.IgnoreResourcesOrder, expected.Len(), actual.Len(), expected.At, actual.At,
+		func(rs ptrace.ResourceSpans) string { return compare.ResourceID(rs.Resource()) },
+		func(expected, actual ptrace.ResourceSpans) error { return compareResourceSpans(opts, expected, actual) })
+}
+
+func compareResourceSpans(opts *compare.Options, expected, actual ptrace.ResourceSpans) error {
+	return errors.Join(
+		compare.Resource(opts, expected.Resource(), actual.Resource()),
+		compare.Equal("schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		compare.Slice("scope", !opts.IgnoreScopesOrder, expected.ScopeSpans().Len(), actual.ScopeSpans().Len(),
+			expected.ScopeSpans().At, actual.ScopeSpans().At,
+			func(ss ptrace.ScopeSpans) string { return compare.ScopeID(ss.Scope()) },
+			func(expected, actual ptrace.ScopeSpans) error { return compareScopeSpans(opts, expected, actual) }),
+	)
+}
+
+func compareScopeSpans(opts *compare.Options, expected, actual ptrace.ScopeSpans) error {
+	return errors.Join(
+		compare.Scope(opts, expected.Scope(), actual.Scope()),
+		compare.Equal("schema url", expected.SchemaUrl(), actual.SchemaUrl()),
+		compare.Slice("span", !opts.IgnoreRecordsOrder, expected.Spans().Len(), actual.Spans().Len(),
+			expected.Spans().At, actual.Spans().At,
+			ptrace.Span.Name,
+			func(expected, actual ptrace.Span) error { return compareSpan(opts, expected, actual) }),
+	)
+}
+
+func compareSpan(opts *compare.Options, expected, actual ptrace.Span) error {
+	return errors.Join(
+		compare.ID(opts, "trace id", expected.TraceID(), actual.TraceID()),
+		compare.ID(opts, "span id", expected.SpanID(), actual.SpanID()),
+		compare.ID(opts, "parent span id", expected.ParentSpanID(), actual.ParentSpanID()),
+		compare.Equal("trace state", expected.TraceState().AsRaw(), actual.TraceState().AsRaw()),
+		compare.Equal("name", expected.Name(), actual.Name()),
+		compare.Equal("flags", expected.Flags(), actual.Flags()),
+		compare.Equal("kind", expected.Kind(), actual.Kind()),
+		compare.Timestamp(opts, "start timestamp", expected.StartTimestamp(), actual.StartTimestamp()),
+		compare.Timestamp(opts, "end timestamp", expected.EndTimestamp(), actual.EndTimestamp()),
+		compare.Attributes(opts, expected.Attributes(), actual.Attributes()),
+		compare.Equal("dropped attributes count", expected.DroppedAttributesCount(), actual.DroppedAttributesCount()),
+		compare.Slice("event", true, expected.Events().Len(), actual.Events().Len(),
+			expected.Events().At, actual.Events().At,
+			ptrace.SpanEvent.Name,
+			func(expected, actual ptrace.SpanEvent) error { return compareSpanEvent(opts, expected, actual) }),
+		compare.Equal("dropped events count", expected.DroppedEventsCount(), actual.DroppedEventsCount()),
+		compare.Positional("link", expected.Links().Len(), actual.Links().Len(),
+			expected.Links().At, actual.Links().At,
+			func(expected, actual ptrace.SpanLink) error { return compareSpanLink(opts, expected, actual) }),
+		compare.Equal("dropped links count", expected.DroppedLinksCount(), actual.DroppedLinksCount()),
+		compare.Equal("status code", expected.Status().Code(), actual.Status().Code()),
+		compare.Equal("status message", expected.Status().Message(), actual.Status().Message()),
+	)
+}
+
+func compareSpanEvent(opts *compare.Options, expected, actual ptrace.SpanEvent) error {
+	return errors.Join(
+		compare.Timestamp(opts, "timestamp", expected.Timestamp(), actual.Timestamp()),
+		compare.Attributes(opts, expected.Attributes(), actual.Attributes()),
+		compare.Equal("dropped attributes count", expected.DroppedAttributesCount(), actual.DroppedAttributesCount()),
+	)
+}
+
+func compareSpanLink(opts *compare.Options, expected, actual ptrace.SpanLink) error {
+	return errors.Join(
+		compare.ID(opts, "trace id", expected.TraceID(), actual.TraceID()),
+		compare.ID(opts, "span id", expected.SpanID(), actual.SpanID()),
+		compare.Equal("trace state", expected.TraceState().AsRaw(), actual.TraceState().AsRaw()),
+		compare.Equal("flags", expected.Flags(), actual.Flags()),
+		compare.Attributes(opts, expected.Attributes(), actual.Attributes()),
+		compare.Equal("dropped attributes count", expected.DroppedAttributesCount(), actual.DroppedAttributesCount()),
+	)
+}
@@ -0,0 +1,183 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ptracetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func newTraces() ptrace.Traces {
+	td := ptrace.NewTraces()
+	rs := td.ResourceSpans().AppendEmpty()
+	rs.Resource().Attributes().PutStr("service.name", "svc")
+	ss := rs.ScopeSpans().AppendEmpty()
+	ss.Scope().SetName("lib")
+	for _, name := range []string{"first", "second"} {
+		span := ss.Spans().AppendEmpty()
+		span.SetName(name)
+		span.SetTraceID(pcommon.TraceID([16]byte{1}))
+		span.SetSpanID(pcommon.SpanID([8]byte{byte(len(name))}))
+		span.SetStartTimestamp(1)
+		span.SetEndTimestamp(2)
+		span.Attributes().PutStr("a", "1")
+		span.Attributes().PutInt("b", 2)
+		span.Events().AppendEmpty().SetName("event")
+	}
+	return td
+}
+
+func TestCompareTraces(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(ptrace.Traces)
+		options  []CompareTracesOption
+		expected []string
+	}{
+		{
+			name:   "equal",
+			modify: func(ptrace.Traces) {},
+		},
+		{
+			name: "span attribute",
+			modify: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(1).Attributes().PutStr("a", "2")
+			},
+			expected: []string{`resource "map[service.name:svc]": scope "lib": span "second": attribute "a" doesn't match expected: "1", actual: "2"`},
+		},
+		{
+			name: "missing resource",
+			modify: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).Resource().Attributes().PutStr("service.name", "other")
+			},
+			expected: []string{
+				`missing expected resource "map[service.name:svc]"`,
+				`unexpected resource "map[service.name:other]"`,
+			},
+		},
+		{
+			name: "extra span",
+			modify: func(td ptrace.Traces) {
+				td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().AppendEmpty().SetName("third")
+			},
+			expected: []string{
+				`resource "map[service.name:svc]": scope "lib": number of spans doesn't match expected: 2, actual: 3`,
+				`resource "map[service.name:svc]": scope "lib": unexpected span "third"`,
+			},
+		},
+		{
+			name: "spans order",
+			modify: func(td ptrace.Traces) {
+				spans := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+				spans.Sort(func(a, b ptrace.Span) bool { return a.Name() > b.Name() })
+			},
+			expected: []string{
+				`resource "map[service.name:svc]": scope "lib": spans are out of order: span "first" expected at index 0, found at index 1`,
+				`resource "map[service.name:svc]": scope "lib": spans are out of order: span "second" expected at index 1, found at index 0`,
+			},
+		},
+		{
+			name: "ignore spans order",
+			modify: func(td ptrace.Traces) {
+				spans := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+				spans.Sort(func(a, b ptrace.Span) bool { return a.Name() > b.Name() })
+			},
+			options: []CompareTracesOption{IgnoreSpansOrder()},
+		},
+		{
+			name: "attributes order",
+			modify: func(td ptrace.Traces) {
+				attrs := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes()
+				attrs.Remove("a")
+				attrs.PutStr("a", "1")
+			},
+			expected: []string{`resource "map[service.name:svc]": scope "lib": span "first": attributes are out of order: expected ["a" "b"], actual ["b" "a"]`},
+		},
+		{
+			name: "ignore attributes order",
+			modify: func(td ptrace.Traces) {
+				attrs := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Attributes()
+				attrs.Remove("a")
+				attrs.PutStr("a", "1")
+			},
+			options: []CompareTracesOption{IgnoreAttributesOrder()},
+		},
+		{
+			name: "timestamps and ids",
+			modify: func(td ptrace.Traces) {
+				span := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+				span.SetEndTimestamp(3)
+				span.SetSpanID(pcommon.SpanID([8]byte{9}))
+				span.Events().At(0).SetTimestamp(4)
+			},
+			expected: []string{
+				`resource "map[service.name:svc]": scope "lib": span "first": span id doesn't match expected: 0500000000000000, actual: 0900000000000000`,
+				`resource "map[service.name:svc]": scope "lib": span "first": end timestamp doesn't match expected: 1970-01-01 00:00:00.000000002 +0000 UTC, actual: 1970-01-01 00:00:00.000000003 +0000 UTC`,
+				`resource "map[service.name:svc]": scope "lib": span "first": event "event": timestamp doesn't match expected: 1970-01-01 00:00:00 +0000 UTC, actual: 1970-01-01 00:00:00.000000004 +0000 UTC`,
+			},
+		},
+		{
+			name: "ignore timestamps and ids",
+			modify: func(td ptrace.Traces) {
+				span := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+				span.SetEndTimestamp(3)
+				span.SetSpanID(pcommon.SpanID([8]byte{9}))
+				span.Events().At(0).SetTimestamp(4)
+			},
+			options: []CompareTracesOption{IgnoreTimestamps(), IgnoreIDs()},
+		},
+		{
+			name: "resources order",
+			modify: func(td ptrace.Traces) {
+				rs := ptrace.NewResourceSpans()
+				rs.Resource().Attributes().PutStr("service.name", "svc")
+				td.ResourceSpans().At(0).CopyTo(rs)
+				td.ResourceSpans().At(0).Resource().Attributes().PutStr("service.name", "other")
+				rs.CopyTo(td.ResourceSpans().AppendEmpty())
+			},
+			options: []CompareTracesOption{IgnoreResourceSpansOrder()},
+			expected: []string{
+				`number of resources doesn't match expected: 1, actual: 2`,
+				`unexpected resource "map[service.name:other]"`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected, actual := newTraces(), newTraces()
+			tt.modify(actual)
+			err := CompareTraces(expected, actual, tt.options...)
+			if len(tt.expected) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.expected, splitErrors(err))
+		})
+	}
+}
+
+func TestCompareSpan(t *testing.T) {
+	expected := newTraces().ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+	actual := ptrace.NewSpan()
+	expected.CopyTo(actual)
+	actual.Status().SetCode(ptrace.StatusCodeError)
+	assert.EqualError(t, CompareSpan(expected, actual), "status code doesn't match expected: Unset, actual: Error")
+}
+
+func splitErrors(err error) []string {
+	var msgs []string
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			msgs = append(msgs, splitErrors(e)...)
+		}
+		return msgs
+	}
+	return []string{err.Error()}
+}
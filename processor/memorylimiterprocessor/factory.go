@@ -16,7 +16,7 @@ import (
 	"go.opentelemetry.io/collector/processor/processorhelper"
 )
 
-var processorCapabilities = consumer.Capabilities{MutatesData: false}
+var processorCapabilities = consumer.Capabilities{MutatesData: false, DoesNotRetainData: true}
 
 type factory struct {
 	// memoryLimiters stores memoryLimiter instances with unique configs that multiple processors can reuse.
@@ -27,6 +27,8 @@ require (
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	go.opentelemetry.io/collector/component/componentstatus v0.115.0 // indirect
 	go.opentelemetry.io/collector/config/configtelemetry v0.115.0 // indirect
+	go.opentelemetry.io/collector/consumer/consumererror v0.115.0 // indirect
+	go.opentelemetry.io/collector/internal/consumecontract v0.115.0 // indirect
 	go.opentelemetry.io/collector/pdata v1.21.0 // indirect
 	go.opentelemetry.io/collector/pdata/testdata v0.115.0 // indirect
 	go.opentelemetry.io/collector/pipeline v0.115.0 // indirect
@@ -73,3 +75,7 @@ replace go.opentelemetry.io/collector/component/componentstatus => ../../../comp
 replace go.opentelemetry.io/collector/processor/processortest => ../../processortest
 
 replace go.opentelemetry.io/collector/processor/processorprofiles => ../../processorprofiles
+
+replace go.opentelemetry.io/collector/consumer/consumererror => ../../../consumer/consumererror
+
+replace go.opentelemetry.io/collector/internal/consumecontract => ../../../internal/consumecontract
@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package processortest // import "go.opentelemetry.io/collector/processor/processortest"
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/internal/consumecontract"
+	"go.opentelemetry.io/collector/pipeline"
+	"go.opentelemetry.io/collector/processor"
+)
+
+type CheckConsumeContractParams struct {
+	T *testing.T
+	// Factory that allows to create a processor.
+	Factory processor.Factory
+	Signal  pipeline.Signal
+	// Config of the processor to use.
+	Config component.Config
+	// NumberOfTestElements specifies the number of uniquely identifiable data elements
+	// (spans, log records or metric data points) to send to the processor for each test scenario.
+	NumberOfTestElements int
+}
+
+// CheckConsumeContract checks the contract between the processor, its caller and its next consumer.
+// Every element sent to the processor must either be delivered exactly once to the next consumer,
+// or be dropped because of a permanent error, which must be returned to the caller. Non-permanent
+// errors are retried by the checker the same way a receiver would do it. If the processor reports
+// that it does not mutate data, the checker also verifies that the data sent to it is left untouched.
+//
+// The checker is meant for processors that forward every element they receive, processors that
+// filter or aggregate data will be reported as losing data.
+func CheckConsumeContract(params CheckConsumeContractParams) {
+	consumecontract.CheckForwarding(params.T, consumecontract.ForwardingParams{
+		Signal:               params.Signal,
+		NumberOfTestElements: params.NumberOfTestElements,
+		Create: func(next *consumecontract.MockConsumer) (component.Component, error) {
+			ctx := context.Background()
+			switch params.Signal {
+			case pipeline.SignalTraces:
+				return params.Factory.CreateTraces(ctx, NewNopSettings(), params.Config, next)
+			case pipeline.SignalMetrics:
+				return params.Factory.CreateMetrics(ctx, NewNopSettings(), params.Config, next)
+			case pipeline.SignalLogs:
+				return params.Factory.CreateLogs(ctx, NewNopSettings(), params.Config, next)
+			}
+			return nil, pipeline.ErrSignalNotSupported
+		},
+	})
+}
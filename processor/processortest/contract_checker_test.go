@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package processortest
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pipeline"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+)
+
+// This file is an example that demonstrates how to use the CheckConsumeContract() function.
+// We declare a trivial example processor forwarding data as is without mutating it, and then use it
+// in TestConsumeContract().
+
+type exampleProcessorConfig struct{}
+
+func newExampleFactory() processor.Factory {
+	return processor.NewFactory(
+		component.MustNewType("example_processor"),
+		func() component.Config {
+			return &exampleProcessorConfig{}
+		},
+		processor.WithTraces(createExampleTraces, component.StabilityLevelBeta),
+		processor.WithMetrics(createExampleMetrics, component.StabilityLevelBeta),
+		processor.WithLogs(createExampleLogs, component.StabilityLevelBeta),
+	)
+}
+
+func createExampleTraces(ctx context.Context, set processor.Settings, cfg component.Config, next consumer.Traces) (processor.Traces, error) {
+	return processorhelper.NewTraces(ctx, set, cfg, next, func(_ context.Context, td ptrace.Traces) (ptrace.Traces, error) {
+		return td, nil
+	}, processorhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}))
+}
+
+func createExampleMetrics(ctx context.Context, set processor.Settings, cfg component.Config, next consumer.Metrics) (processor.Metrics, error) {
+	return processorhelper.NewMetrics(ctx, set, cfg, next, func(_ context.Context, md pmetric.Metrics) (pmetric.Metrics, error) {
+		return md, nil
+	}, processorhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}))
+}
+
+func createExampleLogs(ctx context.Context, set processor.Settings, cfg component.Config, next consumer.Logs) (processor.Logs, error) {
+	return processorhelper.NewLogs(ctx, set, cfg, next, func(_ context.Context, ld plog.Logs) (plog.Logs, error) {
+		return ld, nil
+	}, processorhelper.WithCapabilities(consumer.Capabilities{MutatesData: false}))
+}
+
+func TestConsumeContract(t *testing.T) {
+	for _, signal := range []pipeline.Signal{pipeline.SignalTraces, pipeline.SignalMetrics, pipeline.SignalLogs} {
+		t.Run(signal.String(), func(t *testing.T) {
+			// Run the contract checker. This will trigger test failures if any problems are found.
+			CheckConsumeContract(CheckConsumeContractParams{
+				T:                    t,
+				Factory:              newExampleFactory(),
+				Signal:               signal,
+				Config:               &exampleProcessorConfig{},
+				NumberOfTestElements: 100,
+			})
+		})
+	}
+}
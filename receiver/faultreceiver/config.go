@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package faultreceiver // import "go.opentelemetry.io/collector/receiver/faultreceiver"
+
+import (
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/internal/fault"
+	"go.opentelemetry.io/collector/receiver/otlpreceiver"
+)
+
+// Config defines configuration for the fault receiver.
+type Config struct {
+	fault.Config `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct.
+
+	// OTLP configures the OTLP receiver data is received with.
+	OTLP otlpreceiver.Config `mapstructure:"otlp"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate shadows the method promoted from the embedded fault configuration, which is
+// validated on its own, so that its errors are reported once.
+func (cfg *Config) Validate() error {
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package faultreceiver
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/internal/fault"
+)
+
+func TestUnmarshalDefaultConfig(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	require.NoError(t, confmap.New().Unmarshal(&cfg))
+	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
+}
+
+func TestUnmarshalConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	require.NoError(t, cm.Unmarshal(cfg))
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	assert.Equal(t, fault.Config{
+		ErrorRate: 0.5,
+		Error: fault.ErrorConfig{
+			HTTPStatusCode: 503,
+			Message:        "backend unavailable",
+		},
+		ThrottleRate: 0.1,
+		RetryAfter:   2 * time.Second,
+		Latency: fault.LatencyConfig{
+			Distribution: fault.DistributionExponential,
+			Mean:         50 * time.Millisecond,
+		},
+	}, cfg.Config)
+	// The OTLP receiver only enables the configured protocols.
+	require.NotNil(t, cfg.OTLP.GRPC)
+	assert.Equal(t, "localhost:14317", cfg.OTLP.GRPC.NetAddr.Endpoint)
+	assert.Nil(t, cfg.OTLP.HTTP)
+}
+
+func TestValidateConfig(t *testing.T) {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Latency.Distribution = "pareto"
+	assert.EqualError(t, component.ValidateConfig(cfg), `unknown 'latency::distribution' "pareto"`)
+
+	cfg = NewFactory().CreateDefaultConfig().(*Config)
+	cfg.OTLP.GRPC = nil
+	cfg.OTLP.HTTP = nil
+	assert.EqualError(t, component.ValidateConfig(cfg), "must specify at least one protocol when using the OTLP receiver")
+}
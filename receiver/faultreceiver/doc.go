@@ -0,0 +1,9 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package faultreceiver receives data over OTLP and injects configurable faults, such as
+// errors, throttling, latency and hangs, before passing it on. It is meant to test how
+// clients and exporters behave when the collector they send data to misbehaves.
+package faultreceiver // import "go.opentelemetry.io/collector/receiver/faultreceiver"
@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package faultreceiver // import "go.opentelemetry.io/collector/receiver/faultreceiver"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/internal/fault"
+	"go.opentelemetry.io/collector/internal/sharedcomponent"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/faultreceiver/internal/metadata"
+	"go.opentelemetry.io/collector/receiver/otlpreceiver"
+)
+
+// NewFactory creates a factory for the fault receiver.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithTraces(createTraces, metadata.TracesStability),
+		receiver.WithMetrics(createMetrics, metadata.MetricsStability),
+		receiver.WithLogs(createLogs, metadata.LogsStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Config: fault.NewDefaultConfig(),
+		OTLP:   *otlpFactory.CreateDefaultConfig().(*otlpreceiver.Config),
+	}
+}
+
+func createTraces(
+	ctx context.Context,
+	set receiver.Settings,
+	cfg component.Config,
+	nextConsumer consumer.Traces,
+) (receiver.Traces, error) {
+	oCfg := cfg.(*Config)
+	r, err := loadOrStore(oCfg)
+	if err != nil {
+		return nil, err
+	}
+	next, err := newTracesConsumer(r.Unwrap().injector, nextConsumer)
+	if err != nil {
+		return nil, err
+	}
+	if r.Unwrap().otlp, err = otlpFactory.CreateTraces(ctx, set, &oCfg.OTLP, next); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func createMetrics(
+	ctx context.Context,
+	set receiver.Settings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	oCfg := cfg.(*Config)
+	r, err := loadOrStore(oCfg)
+	if err != nil {
+		return nil, err
+	}
+	next, err := newMetricsConsumer(r.Unwrap().injector, nextConsumer)
+	if err != nil {
+		return nil, err
+	}
+	if r.Unwrap().otlp, err = otlpFactory.CreateMetrics(ctx, set, &oCfg.OTLP, next); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func createLogs(
+	ctx context.Context,
+	set receiver.Settings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (receiver.Logs, error) {
+	oCfg := cfg.(*Config)
+	r, err := loadOrStore(oCfg)
+	if err != nil {
+		return nil, err
+	}
+	next, err := newLogsConsumer(r.Unwrap().injector, nextConsumer)
+	if err != nil {
+		return nil, err
+	}
+	if r.Unwrap().otlp, err = otlpFactory.CreateLogs(ctx, set, &oCfg.OTLP, next); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func loadOrStore(cfg *Config) (*sharedcomponent.Component[*faultReceiver], error) {
+	return receivers.LoadOrStore(cfg, func() (*faultReceiver, error) {
+		return newFaultReceiver(cfg), nil
+	})
+}
+
+var otlpFactory = otlpreceiver.NewFactory()
+
+// This is the map of already created fault receivers for particular configurations.
+// All the signals of a configuration share the same injector, so that shutting down
+// releases every hanging request before the shared OTLP receiver stops.
+var receivers = sharedcomponent.NewMap[*Config, *faultReceiver]()
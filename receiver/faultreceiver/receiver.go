@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package faultreceiver // import "go.opentelemetry.io/collector/receiver/faultreceiver"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/internal/fault"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// faultReceiver receives data with an OTLP receiver, shared by all the signals,
+// and injects faults before passing it to the next consumers.
+type faultReceiver struct {
+	injector *fault.Injector
+	otlp     component.Component
+}
+
+func newFaultReceiver(cfg *Config) *faultReceiver {
+	return &faultReceiver{
+		injector: fault.NewInjector(cfg.Config),
+	}
+}
+
+func (r *faultReceiver) Start(ctx context.Context, host component.Host) error {
+	return r.otlp.Start(ctx, host)
+}
+
+// Shutdown releases the hanging requests first, as the OTLP servers wait for them to complete.
+func (r *faultReceiver) Shutdown(ctx context.Context) error {
+	r.injector.Shutdown()
+	if r.otlp == nil {
+		return nil
+	}
+	return r.otlp.Shutdown(ctx)
+}
+
+func newTracesConsumer(injector *fault.Injector, next consumer.Traces) (consumer.Traces, error) {
+	return consumer.NewTraces(func(ctx context.Context, td ptrace.Traces) error {
+		if err := injector.Inject(ctx); err != nil {
+			return err
+		}
+		return next.ConsumeTraces(ctx, td)
+	}, consumer.WithCapabilities(next.Capabilities()))
+}
+
+func newMetricsConsumer(injector *fault.Injector, next consumer.Metrics) (consumer.Metrics, error) {
+	return consumer.NewMetrics(func(ctx context.Context, md pmetric.Metrics) error {
+		if err := injector.Inject(ctx); err != nil {
+			return err
+		}
+		return next.ConsumeMetrics(ctx, md)
+	}, consumer.WithCapabilities(next.Capabilities()))
+}
+
+func newLogsConsumer(injector *fault.Injector, next consumer.Logs) (consumer.Logs, error) {
+	return consumer.NewLogs(func(ctx context.Context, ld plog.Logs) error {
+		if err := injector.Inject(ctx); err != nil {
+			return err
+		}
+		return next.ConsumeLogs(ctx, ld)
+	}, consumer.WithCapabilities(next.Capabilities()))
+}
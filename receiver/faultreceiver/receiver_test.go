@@ -0,0 +1,176 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package faultreceiver
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/internal/testutil"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+func newHTTPConfig(t *testing.T) *Config {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.OTLP.GRPC = nil
+	cfg.OTLP.HTTP.Endpoint = testutil.GetAvailableLocalAddress(t)
+	return cfg
+}
+
+func newGRPCConfig(t *testing.T) *Config {
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.OTLP.HTTP = nil
+	cfg.OTLP.GRPC.NetAddr.Endpoint = testutil.GetAvailableLocalAddress(t)
+	return cfg
+}
+
+func newLogs() plog.Logs {
+	ld := plog.NewLogs()
+	ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("test")
+	return ld
+}
+
+func postLogs(t *testing.T, endpoint string) *http.Response {
+	body, err := plogotlp.NewExportRequestFromLogs(newLogs()).MarshalProto()
+	require.NoError(t, err)
+	resp, err := http.Post("http://"+endpoint+"/v1/logs", "application/x-protobuf", bytes.NewReader(body))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	return resp
+}
+
+func TestReceiveNoFault(t *testing.T) {
+	cfg := newHTTPConfig(t)
+	sink := new(consumertest.LogsSink)
+	r, err := NewFactory().CreateLogs(context.Background(), receivertest.NewNopSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, r.Shutdown(context.Background())) }()
+
+	assert.Equal(t, http.StatusOK, postLogs(t, cfg.OTLP.HTTP.Endpoint).StatusCode)
+	assert.Equal(t, 1, sink.LogRecordCount())
+}
+
+func TestReceiveThrottled(t *testing.T) {
+	cfg := newHTTPConfig(t)
+	cfg.ThrottleRate = 1
+	cfg.RetryAfter = 3 * time.Second
+	sink := new(consumertest.LogsSink)
+	r, err := NewFactory().CreateLogs(context.Background(), receivertest.NewNopSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, r.Shutdown(context.Background())) }()
+
+	resp := postLogs(t, cfg.OTLP.HTTP.Endpoint)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, "3", resp.Header.Get("Retry-After"))
+	assert.Equal(t, 0, sink.LogRecordCount())
+}
+
+func TestReceiveHTTPStatusCode(t *testing.T) {
+	cfg := newHTTPConfig(t)
+	cfg.ErrorRate = 1
+	cfg.Error.HTTPStatusCode = http.StatusForbidden
+	sink := new(consumertest.LogsSink)
+	r, err := NewFactory().CreateLogs(context.Background(), receivertest.NewNopSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, r.Shutdown(context.Background())) }()
+
+	assert.Equal(t, http.StatusForbidden, postLogs(t, cfg.OTLP.HTTP.Endpoint).StatusCode)
+	assert.Equal(t, 0, sink.LogRecordCount())
+}
+
+func TestReceiveGRPCCode(t *testing.T) {
+	cfg := newGRPCConfig(t)
+	cfg.ErrorRate = 1
+	cfg.Error.GRPCCode = "Unavailable"
+	cfg.Error.Message = "try again later"
+	sink := new(consumertest.LogsSink)
+	r, err := NewFactory().CreateLogs(context.Background(), receivertest.NewNopSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() { assert.NoError(t, r.Shutdown(context.Background())) }()
+
+	cc, err := grpc.NewClient(cfg.OTLP.GRPC.NetAddr.Endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, cc.Close()) }()
+
+	_, err = plogotlp.NewGRPCClient(cc).Export(context.Background(), plogotlp.NewExportRequestFromLogs(newLogs()))
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Unavailable, st.Code())
+	assert.Equal(t, "try again later", st.Message())
+	assert.Equal(t, 0, sink.LogRecordCount())
+}
+
+func TestSharedReceiver(t *testing.T) {
+	cfg := newHTTPConfig(t)
+	factory := NewFactory()
+	set := receivertest.NewNopSettings()
+
+	tr, err := factory.CreateTraces(context.Background(), set, cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	mr, err := factory.CreateMetrics(context.Background(), set, cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	lr, err := factory.CreateLogs(context.Background(), set, cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	assert.Same(t, tr, mr)
+	assert.Same(t, tr, lr)
+
+	// The shared receiver starts and shuts down the OTLP receiver only once.
+	require.NoError(t, tr.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, mr.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, tr.Shutdown(context.Background()))
+	require.NoError(t, mr.Shutdown(context.Background()))
+}
+
+func TestShutdownReleasesHangingRequests(t *testing.T) {
+	cfg := newHTTPConfig(t)
+	cfg.HangRate = 1
+	r, err := NewFactory().CreateLogs(context.Background(), receivertest.NewNopSettings(), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+
+	body, err := plogotlp.NewExportRequestFromLogs(newLogs()).MarshalProto()
+	require.NoError(t, err)
+	statusCh := make(chan int)
+	go func() {
+		resp, postErr := http.Post("http://"+cfg.OTLP.HTTP.Endpoint+"/v1/logs", "application/x-protobuf", bytes.NewReader(body))
+		if !assert.NoError(t, postErr) {
+			statusCh <- 0
+			return
+		}
+		assert.NoError(t, resp.Body.Close())
+		statusCh <- resp.StatusCode
+	}()
+	// Give the request time to reach the receiver.
+	time.Sleep(100 * time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		assert.NoError(t, r.Shutdown(context.Background()))
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("shutdown blocked by a hanging request")
+	}
+	assert.Equal(t, http.StatusServiceUnavailable, <-statusCh)
+}
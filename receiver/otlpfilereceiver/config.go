@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfilereceiver // import "go.opentelemetry.io/collector/receiver/otlpfilereceiver"
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configcompression"
+)
+
+const (
+	// FormatJSON reads one OTLP JSON encoded request per line.
+	FormatJSON = "json"
+	// FormatProto reads OTLP protobuf encoded requests, each prefixed with its size
+	// as a 4 bytes big endian unsigned integer.
+	FormatProto = "proto"
+)
+
+// Config defines configuration for the file receiver.
+type Config struct {
+	// Include is the list of glob patterns of the files to read. The matched files are read
+	// once, in the lexical order of their paths.
+	Include []string `mapstructure:"include"`
+
+	// Format is the format of the files: json or proto.
+	Format string `mapstructure:"format"`
+
+	// Compression is the algorithm the files are compressed with: gzip or zstd.
+	// The files are not compressed by default.
+	Compression configcompression.Type `mapstructure:"compression"`
+
+	// Replay configures the timing of the replayed requests.
+	Replay ReplayConfig `mapstructure:"replay"`
+
+	// StorageID is the ID of the storage extension the progress of reading each file is
+	// saved to, so that the files are not read again after a restart.
+	StorageID *component.ID `mapstructure:"storage"`
+}
+
+// ReplayConfig configures the timing of the replayed requests.
+type ReplayConfig struct {
+	// Speed is the factor applied to the original timing of the requests, derived from the
+	// timestamps of their data: 1 replays the requests with their original timing, 2 twice
+	// as fast. Zero replays the requests as fast as possible.
+	Speed float64 `mapstructure:"speed"`
+
+	// ShiftTimestamps shifts the timestamps of every request so that it appears to be
+	// produced when it is replayed.
+	ShiftTimestamps bool `mapstructure:"shift_timestamps"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+// Validate checks if the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	var errs error
+	if len(cfg.Include) == 0 {
+		errs = errors.Join(errs, errors.New("'include' must not be empty"))
+	}
+	for _, pattern := range cfg.Include {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("invalid 'include' pattern %q: %w", pattern, err))
+		}
+	}
+	if cfg.Format != FormatJSON && cfg.Format != FormatProto {
+		errs = errors.Join(errs, fmt.Errorf("unsupported 'format' %q, must be %q or %q", cfg.Format, FormatJSON, FormatProto))
+	}
+	if cfg.Compression.IsCompressed() && cfg.Compression != configcompression.TypeGzip && cfg.Compression != configcompression.TypeZstd {
+		errs = errors.Join(errs, fmt.Errorf("unsupported 'compression' %q, must be %q or %q", cfg.Compression, configcompression.TypeGzip, configcompression.TypeZstd))
+	}
+	if cfg.Replay.Speed < 0 {
+		errs = errors.Join(errs, errors.New("'replay::speed' must not be negative"))
+	}
+	return errs
+}
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfilereceiver
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configcompression"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+)
+
+func TestUnmarshalDefaultConfig(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	require.NoError(t, confmap.New().Unmarshal(&cfg))
+	assert.Equal(t, factory.CreateDefaultConfig(), cfg)
+}
+
+func TestUnmarshalConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	cfg := NewFactory().CreateDefaultConfig()
+	require.NoError(t, cm.Unmarshal(&cfg))
+	require.NoError(t, component.ValidateConfig(cfg))
+
+	storageID := component.MustNewID("file_storage")
+	assert.Equal(t, &Config{
+		Include:     []string{"/var/lib/otelcol/export/*.binpb", "/var/lib/otelcol/archive/*.binpb"},
+		Format:      FormatProto,
+		Compression: configcompression.TypeZstd,
+		Replay: ReplayConfig{
+			Speed:           2,
+			ShiftTimestamps: true,
+		},
+		StorageID: &storageID,
+	}, cfg)
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		errs   []string
+	}{
+		{
+			name:   "valid",
+			modify: func(*Config) {},
+		},
+		{
+			name:   "no include",
+			modify: func(cfg *Config) { cfg.Include = nil },
+			errs:   []string{"'include' must not be empty"},
+		},
+		{
+			name:   "invalid pattern",
+			modify: func(cfg *Config) { cfg.Include = append(cfg.Include, "data/[.jsonl") },
+			errs:   []string{`invalid 'include' pattern "data/[.jsonl": syntax error in pattern`},
+		},
+		{
+			name:   "unsupported format",
+			modify: func(cfg *Config) { cfg.Format = "text" },
+			errs:   []string{`unsupported 'format' "text", must be "json" or "proto"`},
+		},
+		{
+			name:   "unsupported compression",
+			modify: func(cfg *Config) { cfg.Compression = configcompression.TypeLz4 },
+			errs:   []string{`unsupported 'compression' "lz4", must be "gzip" or "zstd"`},
+		},
+		{
+			name:   "negative speed",
+			modify: func(cfg *Config) { cfg.Replay.Speed = -1 },
+			errs:   []string{"'replay::speed' must not be negative"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig().(*Config)
+			cfg.Include = []string{"data/*.jsonl"}
+			tt.modify(cfg)
+			err := component.ValidateConfig(cfg)
+			if len(tt.errs) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, e := range tt.errs {
+				assert.ErrorContains(t, err, e)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package otlpfilereceiver reads data from files in the OTLP JSON or protobuf format.
+package otlpfilereceiver // import "go.opentelemetry.io/collector/receiver/otlpfilereceiver"
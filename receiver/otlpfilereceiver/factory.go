@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfilereceiver // import "go.opentelemetry.io/collector/receiver/otlpfilereceiver"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumerprofiles"
+	"go.opentelemetry.io/collector/internal/sharedcomponent"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/otlpfilereceiver/internal/metadata"
+	"go.opentelemetry.io/collector/receiver/receiverprofiles"
+)
+
+// NewFactory creates a factory for the file receiver.
+func NewFactory() receiver.Factory {
+	return receiverprofiles.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiverprofiles.WithTraces(createTraces, metadata.TracesStability),
+		receiverprofiles.WithMetrics(createMetrics, metadata.MetricsStability),
+		receiverprofiles.WithLogs(createLogs, metadata.LogsStability),
+		receiverprofiles.WithProfiles(createProfiles, metadata.ProfilesStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		Format: FormatJSON,
+	}
+}
+
+func createTraces(
+	_ context.Context,
+	set receiver.Settings,
+	cfg component.Config,
+	nextConsumer consumer.Traces,
+) (receiver.Traces, error) {
+	r, err := loadOrStore(cfg.(*Config), set)
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().nextTraces = nextConsumer
+	return r, nil
+}
+
+func createMetrics(
+	_ context.Context,
+	set receiver.Settings,
+	cfg component.Config,
+	nextConsumer consumer.Metrics,
+) (receiver.Metrics, error) {
+	r, err := loadOrStore(cfg.(*Config), set)
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().nextMetrics = nextConsumer
+	return r, nil
+}
+
+func createLogs(
+	_ context.Context,
+	set receiver.Settings,
+	cfg component.Config,
+	nextConsumer consumer.Logs,
+) (receiver.Logs, error) {
+	r, err := loadOrStore(cfg.(*Config), set)
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().nextLogs = nextConsumer
+	return r, nil
+}
+
+func createProfiles(
+	_ context.Context,
+	set receiver.Settings,
+	cfg component.Config,
+	nextConsumer consumerprofiles.Profiles,
+) (receiverprofiles.Profiles, error) {
+	r, err := loadOrStore(cfg.(*Config), set)
+	if err != nil {
+		return nil, err
+	}
+	r.Unwrap().nextProfiles = nextConsumer
+	return r, nil
+}
+
+func loadOrStore(cfg *Config, set receiver.Settings) (*sharedcomponent.Component[*fileReceiver], error) {
+	return receivers.LoadOrStore(cfg, func() (*fileReceiver, error) {
+		return newFileReceiver(cfg, set)
+	})
+}
+
+// This is the map of already created file receivers for particular configurations.
+// All the signals of a configuration share the same receiver, which reads the files once
+// and passes each request to the consumer of its signal.
+var receivers = sharedcomponent.NewMap[*Config, *fileReceiver]()
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfilereceiver // import "go.opentelemetry.io/collector/receiver/otlpfilereceiver"
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+
+	"go.opentelemetry.io/collector/config/configcompression"
+)
+
+// maxRecordSize is the maximum size of a request, which protects from allocating
+// huge buffers when reading a corrupted protobuf file.
+const maxRecordSize = 256 * 1024 * 1024
+
+// recordReader reads the requests of a file, framed according to the format.
+type recordReader struct {
+	format string
+	r      *bufio.Reader
+	// closeFn releases the resources of the decompressor, if any.
+	closeFn func()
+	// offset is the position in the uncompressed data of the next request.
+	offset int64
+}
+
+func newRecordReader(r io.Reader, format string, compression configcompression.Type) (*recordReader, error) {
+	rr := &recordReader{format: format, closeFn: func() {}}
+	switch compression {
+	case configcompression.TypeGzip:
+		gr, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		r = gr
+	case configcompression.TypeZstd:
+		zr, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		r = zr
+		rr.closeFn = zr.Close
+	}
+	rr.r = bufio.NewReader(r)
+	return rr, nil
+}
+
+// skip skips the given number of bytes of uncompressed data, already read before.
+func (rr *recordReader) skip(offset int64) error {
+	n, err := io.CopyN(io.Discard, rr.r, offset)
+	rr.offset += n
+	if errors.Is(err, io.EOF) {
+		return fmt.Errorf("file is shorter than the %d bytes already read", offset)
+	}
+	return err
+}
+
+// next returns the next request, or io.EOF at the end of the file.
+func (rr *recordReader) next() ([]byte, error) {
+	if rr.format == FormatProto {
+		return rr.nextProto()
+	}
+	for {
+		line, err := rr.r.ReadBytes('\n')
+		rr.offset += int64(len(line))
+		if errors.Is(err, io.EOF) && len(line) > 0 {
+			// Do not consume an incomplete last line, which may still be written to.
+			rr.offset -= int64(len(line))
+			return nil, io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return nil, err
+		}
+		// Skip empty lines.
+		if len(line) > 1 {
+			return line, nil
+		}
+	}
+}
+
+func (rr *recordReader) nextProto() ([]byte, error) {
+	var header [4]byte
+	if _, err := io.ReadFull(rr.r, header[:]); err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(header[:])
+	if size > maxRecordSize {
+		return nil, fmt.Errorf("request of %d bytes is larger than the maximum of %d bytes", size, maxRecordSize)
+	}
+	record := make([]byte, size)
+	if _, err := io.ReadFull(rr.r, record); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	rr.offset += int64(len(header) + len(record))
+	return record, nil
+}
+
+func (rr *recordReader) close() {
+	rr.closeFn()
+}
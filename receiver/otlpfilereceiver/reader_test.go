@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfilereceiver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/config/configcompression"
+)
+
+func TestRecordReaderJSON(t *testing.T) {
+	rr, err := newRecordReader(bytes.NewReader([]byte("{\"a\":1}\n\n{\"b\":2}\n{\"c\"")), FormatJSON, "")
+	require.NoError(t, err)
+	defer rr.close()
+
+	record, err := rr.next()
+	require.NoError(t, err)
+	assert.Equal(t, "{\"a\":1}\n", string(record))
+	assert.EqualValues(t, 8, rr.offset)
+	// Empty lines are skipped.
+	record, err = rr.next()
+	require.NoError(t, err)
+	assert.Equal(t, "{\"b\":2}\n", string(record))
+	assert.EqualValues(t, 17, rr.offset)
+	// The incomplete last line is not consumed.
+	_, err = rr.next()
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.EqualValues(t, 17, rr.offset)
+}
+
+func TestRecordReaderProto(t *testing.T) {
+	data := appendFrame(appendFrame(nil, []byte("first")), []byte("second"))
+	rr, err := newRecordReader(bytes.NewReader(append(data, 0, 0, 0, 10, 'x')), FormatProto, "")
+	require.NoError(t, err)
+	defer rr.close()
+
+	record, err := rr.next()
+	require.NoError(t, err)
+	assert.Equal(t, "first", string(record))
+	record, err = rr.next()
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(record))
+	assert.EqualValues(t, len(data), rr.offset)
+	_, err = rr.next()
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.EqualValues(t, len(data), rr.offset)
+}
+
+func TestRecordReaderProtoTooLarge(t *testing.T) {
+	rr, err := newRecordReader(bytes.NewReader([]byte{0xff, 0xff, 0xff, 0xff}), FormatProto, "")
+	require.NoError(t, err)
+	_, err = rr.next()
+	require.ErrorContains(t, err, "larger than the maximum")
+}
+
+func TestRecordReaderCompression(t *testing.T) {
+	for _, compression := range []configcompression.Type{configcompression.TypeGzip, configcompression.TypeZstd} {
+		t.Run(string(compression), func(t *testing.T) {
+			// Files appended to contain several compressed streams.
+			data := append(compress(t, compression, "{\"a\":1}\n"), compress(t, compression, "{\"b\":2}\n")...)
+			rr, err := newRecordReader(bytes.NewReader(data), FormatJSON, compression)
+			require.NoError(t, err)
+			defer rr.close()
+
+			require.NoError(t, rr.skip(8))
+			record, err := rr.next()
+			require.NoError(t, err)
+			assert.Equal(t, "{\"b\":2}\n", string(record))
+			_, err = rr.next()
+			require.ErrorIs(t, err, io.EOF)
+		})
+	}
+}
+
+func TestRecordReaderSkipPastEnd(t *testing.T) {
+	rr, err := newRecordReader(bytes.NewReader([]byte("{}\n")), FormatJSON, "")
+	require.NoError(t, err)
+	require.EqualError(t, rr.skip(10), "file is shorter than the 10 bytes already read")
+}
+
+func TestRecordReaderInvalidCompressedData(t *testing.T) {
+	_, err := newRecordReader(bytes.NewReader([]byte("not gzip")), FormatJSON, configcompression.TypeGzip)
+	require.Error(t, err)
+}
+
+func appendFrame(data []byte, record []byte) []byte {
+	data = binary.BigEndian.AppendUint32(data, uint32(len(record)))
+	return append(data, record...)
+}
+
+func compress(t *testing.T, compression configcompression.Type, data string) []byte {
+	buf := &bytes.Buffer{}
+	var w io.WriteCloser
+	if compression == configcompression.TypeGzip {
+		w = gzip.NewWriter(buf)
+	} else {
+		zw, err := zstd.NewWriter(buf)
+		require.NoError(t, err)
+		w = zw
+	}
+	_, err := w.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
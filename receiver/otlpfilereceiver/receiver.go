@@ -0,0 +1,479 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfilereceiver // import "go.opentelemetry.io/collector/receiver/otlpfilereceiver"
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"go.uber.org/zap"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumerprofiles"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pipeline"
+	"go.opentelemetry.io/collector/pipeline/pipelineprofiles"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+)
+
+// The interval between retries of a request rejected by the pipeline with a non-permanent
+// error grows from retryInitialInterval up to retryMaxInterval.
+const (
+	retryInitialInterval = 5 * time.Second
+	retryMaxInterval     = 30 * time.Second
+)
+
+var (
+	errNoStorageClient    = errors.New("no storage client extension found")
+	errWrongExtensionType = errors.New("requested extension is not a storage extension")
+)
+
+// jsonSignalKeys are the top level fields of the OTLP JSON requests of each signal.
+var jsonSignalKeys = map[string]pipeline.Signal{
+	"resourceSpans":    pipeline.SignalTraces,
+	"resourceMetrics":  pipeline.SignalMetrics,
+	"resourceLogs":     pipeline.SignalLogs,
+	"resourceProfiles": pipelineprofiles.SignalProfiles,
+}
+
+// fileReceiver reads the configured files once, and passes the requests they contain to
+// the next consumer of their signal. It is shared by the pipelines of all the signals.
+type fileReceiver struct {
+	cfg     *Config
+	set     receiver.Settings
+	obsrecv *receiverhelper.ObsReport
+
+	nextTraces   consumer.Traces
+	nextMetrics  consumer.Metrics
+	nextLogs     consumer.Logs
+	nextProfiles consumerprofiles.Profiles
+
+	// newBackOff creates the policy of the retries of a request rejected by the pipeline.
+	newBackOff func() backoff.BackOff
+
+	client storage.Client
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	// replayStart is the time the first request with a timestamp was replayed at,
+	// and firstTimestamp the earliest timestamp of that request.
+	replayStart    time.Time
+	firstTimestamp pcommon.Timestamp
+}
+
+func newFileReceiver(cfg *Config, set receiver.Settings) (*fileReceiver, error) {
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             set.ID,
+		Transport:              "file",
+		LongLivedCtx:           true,
+		ReceiverCreateSettings: set,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &fileReceiver{
+		cfg:        cfg,
+		set:        set,
+		obsrecv:    obsrecv,
+		newBackOff: newExponentialBackOff,
+	}, nil
+}
+
+// newExponentialBackOff returns a policy retrying until the receiver is shut down.
+func newExponentialBackOff() backoff.BackOff {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = retryInitialInterval
+	b.MaxInterval = retryMaxInterval
+	b.MaxElapsedTime = 0
+	return b
+}
+
+func (r *fileReceiver) Start(ctx context.Context, host component.Host) error {
+	if r.cfg.Format == FormatProto && len(r.signals()) > 1 {
+		return fmt.Errorf("the %q format cannot tell the signals apart, the receiver must only be used by pipelines of one signal", FormatProto)
+	}
+	if r.cfg.StorageID != nil {
+		client, err := toStorageClient(ctx, *r.cfg.StorageID, host, r.set.ID)
+		if err != nil {
+			return err
+		}
+		r.client = client
+	}
+
+	// The files are read in the background, independently of the context of Start.
+	runCtx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.run(runCtx)
+	}()
+	return nil
+}
+
+func (r *fileReceiver) Shutdown(ctx context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	if r.client != nil {
+		return r.client.Close(ctx)
+	}
+	return nil
+}
+
+// signals returns the signals of the pipelines the receiver is used by.
+func (r *fileReceiver) signals() []pipeline.Signal {
+	var signals []pipeline.Signal
+	if r.nextTraces != nil {
+		signals = append(signals, pipeline.SignalTraces)
+	}
+	if r.nextMetrics != nil {
+		signals = append(signals, pipeline.SignalMetrics)
+	}
+	if r.nextLogs != nil {
+		signals = append(signals, pipeline.SignalLogs)
+	}
+	if r.nextProfiles != nil {
+		signals = append(signals, pipelineprofiles.SignalProfiles)
+	}
+	return signals
+}
+
+func (r *fileReceiver) run(ctx context.Context) {
+	paths, err := r.matchFiles()
+	if err != nil {
+		r.set.Logger.Error("Failed to list the files to read", zap.Error(err))
+		return
+	}
+	for _, path := range paths {
+		if err = r.readFile(ctx, path); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			r.set.Logger.Error("Failed to read the file", zap.String("path", path), zap.Error(err))
+		}
+	}
+	r.set.Logger.Info("Finished reading the files", zap.Int("files", len(paths)))
+}
+
+// matchFiles returns the sorted paths of the files matching the include patterns.
+func (r *fileReceiver) matchFiles() ([]string, error) {
+	var paths []string
+	for _, pattern := range r.cfg.Include {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, matches...)
+	}
+	slices.Sort(paths)
+	return slices.Compact(paths), nil
+}
+
+// readFile reads the requests of the file at path, starting after the ones read before.
+func (r *fileReceiver) readFile(ctx context.Context, path string) error {
+	key, err := checkpointKey(path)
+	if err != nil {
+		return err
+	}
+	offset, err := r.loadOffset(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	rr, err := newRecordReader(f, r.cfg.Format, r.cfg.Compression)
+	if err != nil {
+		return err
+	}
+	defer rr.close()
+	if offset > 0 {
+		if err = rr.skip(offset); err != nil {
+			return err
+		}
+	}
+
+	for ctx.Err() == nil {
+		record, err := rr.next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			r.set.Logger.Warn("Ignoring the incomplete request at the end of the file", zap.String("path", path))
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		err = r.consume(ctx, record)
+		if ctx.Err() != nil {
+			// The request may not have been consumed, and is read again on the next start.
+			break
+		}
+		if err != nil {
+			// The request cannot be decoded, or was rejected with a permanent error.
+			r.set.Logger.Error("Dropping a request", zap.String("path", path), zap.Int64("offset", offset), zap.Error(err))
+		}
+		offset = rr.offset
+		if err = r.saveOffset(ctx, key, offset); err != nil {
+			return err
+		}
+	}
+	return ctx.Err()
+}
+
+// consume decodes the request and passes it to the next consumer of its signal. Requests
+// rejected with a non-permanent error are retried until the context is canceled.
+func (r *fileReceiver) consume(ctx context.Context, record []byte) error {
+	signal, err := r.recordSignal(record)
+	if err != nil {
+		return err
+	}
+	switch signal {
+	case pipeline.SignalTraces:
+		if r.nextTraces == nil {
+			return nil
+		}
+		td, err := r.tracesUnmarshaler().UnmarshalTraces(record)
+		if err != nil {
+			return err
+		}
+		if err = r.replay(ctx, func(fn timestampFunc) { walkTraces(td, fn) }); err != nil {
+			return err
+		}
+		return r.retry(ctx, func(ctx context.Context) error {
+			ctx = r.obsrecv.StartTracesOp(ctx)
+			err := r.nextTraces.ConsumeTraces(ctx, td)
+			r.obsrecv.EndTracesOp(ctx, r.cfg.Format, td.SpanCount(), err)
+			return err
+		})
+	case pipeline.SignalMetrics:
+		if r.nextMetrics == nil {
+			return nil
+		}
+		md, err := r.metricsUnmarshaler().UnmarshalMetrics(record)
+		if err != nil {
+			return err
+		}
+		if err = r.replay(ctx, func(fn timestampFunc) { walkMetrics(md, fn) }); err != nil {
+			return err
+		}
+		return r.retry(ctx, func(ctx context.Context) error {
+			ctx = r.obsrecv.StartMetricsOp(ctx)
+			err := r.nextMetrics.ConsumeMetrics(ctx, md)
+			r.obsrecv.EndMetricsOp(ctx, r.cfg.Format, md.DataPointCount(), err)
+			return err
+		})
+	case pipeline.SignalLogs:
+		if r.nextLogs == nil {
+			return nil
+		}
+		ld, err := r.logsUnmarshaler().UnmarshalLogs(record)
+		if err != nil {
+			return err
+		}
+		if err = r.replay(ctx, func(fn timestampFunc) { walkLogs(ld, fn) }); err != nil {
+			return err
+		}
+		return r.retry(ctx, func(ctx context.Context) error {
+			ctx = r.obsrecv.StartLogsOp(ctx)
+			err := r.nextLogs.ConsumeLogs(ctx, ld)
+			r.obsrecv.EndLogsOp(ctx, r.cfg.Format, ld.LogRecordCount(), err)
+			return err
+		})
+	case pipelineprofiles.SignalProfiles:
+		if r.nextProfiles == nil {
+			return nil
+		}
+		pd, err := r.profilesUnmarshaler().UnmarshalProfiles(record)
+		if err != nil {
+			return err
+		}
+		if err = r.replay(ctx, func(fn timestampFunc) { walkProfiles(pd, fn) }); err != nil {
+			return err
+		}
+		return r.retry(ctx, func(ctx context.Context) error {
+			return r.nextProfiles.ConsumeProfiles(ctx, pd)
+		})
+	}
+	return fmt.Errorf("unsupported signal %q", signal)
+}
+
+// retry calls consume until it succeeds, fails with a permanent error, or the context is canceled.
+func (r *fileReceiver) retry(ctx context.Context, consume func(context.Context) error) error {
+	b := r.newBackOff()
+	for {
+		err := consume(ctx)
+		if err == nil || consumererror.IsPermanent(err) {
+			return err
+		}
+		delay := b.NextBackOff()
+		r.set.Logger.Warn("The pipeline rejected a request, retrying", zap.Duration("interval", delay), zap.Error(err))
+		if err = sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// recordSignal returns the signal of the request.
+func (r *fileReceiver) recordSignal(record []byte) (pipeline.Signal, error) {
+	if r.cfg.Format == FormatProto {
+		// Validated on start to be the only signal.
+		return r.signals()[0], nil
+	}
+	// OTLP JSON requests are objects with a single field, named after their signal.
+	dec := json.NewDecoder(bytes.NewReader(record))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return pipeline.Signal{}, errors.New("request is not a JSON object")
+	}
+	tok, err := dec.Token()
+	if err != nil {
+		return pipeline.Signal{}, errors.New("request is not a JSON object")
+	}
+	key, _ := tok.(string)
+	signal, ok := jsonSignalKeys[key]
+	if !ok {
+		return pipeline.Signal{}, fmt.Errorf("request has no known signal field, got %q", key)
+	}
+	return signal, nil
+}
+
+// replay waits until the request is due according to the replay speed, then shifts its
+// timestamps if configured. walk applies its function to every timestamp of the request.
+func (r *fileReceiver) replay(ctx context.Context, walk func(timestampFunc)) error {
+	if r.cfg.Replay.Speed == 0 && !r.cfg.Replay.ShiftTimestamps {
+		return nil
+	}
+	var requestTimestamp pcommon.Timestamp
+	walk(earliest(&requestTimestamp))
+	if requestTimestamp == 0 {
+		return nil
+	}
+
+	if r.cfg.Replay.Speed > 0 {
+		if r.firstTimestamp == 0 {
+			r.firstTimestamp = requestTimestamp
+			r.replayStart = time.Now()
+		}
+		elapsed := time.Duration(float64(int64(requestTimestamp)-int64(r.firstTimestamp)) / r.cfg.Replay.Speed) //nolint:gosec // timestamps are far from overflowing
+		if err := sleep(ctx, time.Until(r.replayStart.Add(elapsed))); err != nil {
+			return err
+		}
+	}
+	if r.cfg.Replay.ShiftTimestamps {
+		walk(shift(time.Now().UnixNano() - int64(requestTimestamp))) //nolint:gosec // timestamps are far from overflowing
+	}
+	return nil
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (r *fileReceiver) tracesUnmarshaler() ptrace.Unmarshaler {
+	if r.cfg.Format == FormatProto {
+		return &ptrace.ProtoUnmarshaler{}
+	}
+	return &ptrace.JSONUnmarshaler{}
+}
+
+func (r *fileReceiver) metricsUnmarshaler() pmetric.Unmarshaler {
+	if r.cfg.Format == FormatProto {
+		return &pmetric.ProtoUnmarshaler{}
+	}
+	return &pmetric.JSONUnmarshaler{}
+}
+
+func (r *fileReceiver) logsUnmarshaler() plog.Unmarshaler {
+	if r.cfg.Format == FormatProto {
+		return &plog.ProtoUnmarshaler{}
+	}
+	return &plog.JSONUnmarshaler{}
+}
+
+func (r *fileReceiver) profilesUnmarshaler() pprofile.Unmarshaler {
+	if r.cfg.Format == FormatProto {
+		return &pprofile.ProtoUnmarshaler{}
+	}
+	return &pprofile.JSONUnmarshaler{}
+}
+
+// checkpointKey returns the storage key of the progress of reading the file at path.
+func checkpointKey(path string) (string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	return "offset:" + abs, nil
+}
+
+// loadOffset returns the number of bytes of uncompressed data of the file already read.
+func (r *fileReceiver) loadOffset(ctx context.Context, key string) (int64, error) {
+	if r.client == nil {
+		return 0, nil
+	}
+	value, err := r.client.Get(ctx, key)
+	if err != nil || value == nil {
+		return 0, err
+	}
+	if len(value) != 8 {
+		return 0, fmt.Errorf("invalid offset stored for key %q", key)
+	}
+	return int64(binary.BigEndian.Uint64(value)), nil //nolint:gosec // stored from an int64
+}
+
+func (r *fileReceiver) saveOffset(ctx context.Context, key string, offset int64) error {
+	if r.client == nil {
+		return nil
+	}
+	return r.client.Set(ctx, key, binary.BigEndian.AppendUint64(nil, uint64(offset))) //nolint:gosec // offsets are positive
+}
+
+func toStorageClient(ctx context.Context, storageID component.ID, host component.Host, ownerID component.ID) (storage.Client, error) {
+	ext, found := host.GetExtensions()[storageID]
+	if !found {
+		return nil, errNoStorageClient
+	}
+
+	storageExt, ok := ext.(storage.Extension)
+	if !ok {
+		return nil, errWrongExtensionType
+	}
+
+	return storageExt.GetClient(ctx, component.KindReceiver, ownerID, "")
+}
@@ -0,0 +1,476 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfilereceiver
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/configcompression"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumererror"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/extension/experimental/storage"
+	"go.opentelemetry.io/collector/internal/sharedcomponent"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/testdata"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+var storageID = component.MustNewID("storage")
+
+func TestReceiveJSONSignals(t *testing.T) {
+	dir := t.TempDir()
+	var data []byte
+	data = appendJSON(t, data, testdata.GenerateTraces(2))
+	data = appendJSON(t, data, testdata.GenerateMetrics(3))
+	data = append(data, '\n')
+	data = appendJSON(t, data, testdata.GenerateLogs(4))
+	data = appendJSON(t, data, testdata.GenerateProfiles(5))
+	writeFile(t, filepath.Join(dir, "a.jsonl"), data)
+	writeFile(t, filepath.Join(dir, "b.jsonl"), appendJSON(t, nil, testdata.GenerateTraces(1)))
+	writeFile(t, filepath.Join(dir, "ignored.txt"), appendJSON(t, nil, testdata.GenerateTraces(1)))
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Include = []string{filepath.Join(dir, "*.jsonl"), filepath.Join(dir, "a.*")}
+
+	tracesSink := new(consumertest.TracesSink)
+	metricsSink := new(consumertest.MetricsSink)
+	logsSink := new(consumertest.LogsSink)
+	profilesSink := new(consumertest.ProfilesSink)
+	set := receivertest.NewNopSettings()
+	tr, err := createTraces(context.Background(), set, cfg, tracesSink)
+	require.NoError(t, err)
+	mr, err := createMetrics(context.Background(), set, cfg, metricsSink)
+	require.NoError(t, err)
+	lr, err := createLogs(context.Background(), set, cfg, logsSink)
+	require.NoError(t, err)
+	pr, err := createProfiles(context.Background(), set, cfg, profilesSink)
+	require.NoError(t, err)
+	assert.Same(t, tr, mr)
+	assert.Same(t, tr, lr)
+	assert.Same(t, tr, pr)
+
+	require.NoError(t, tr.Start(context.Background(), newHost(nil)))
+	defer func() { require.NoError(t, tr.Shutdown(context.Background())) }()
+
+	assert.Eventually(t, func() bool {
+		return tracesSink.SpanCount() == 3 && metricsSink.DataPointCount() == 6 &&
+			logsSink.LogRecordCount() == 4 && profilesSink.SampleCount() == 5
+	}, 5*time.Second, 10*time.Millisecond)
+	// Files matching several patterns are read once.
+	assert.Len(t, tracesSink.AllTraces(), 2)
+}
+
+func TestReceiveProto(t *testing.T) {
+	dir := t.TempDir()
+	marshaler := &plog.ProtoMarshaler{}
+	var data []byte
+	for i := 1; i <= 3; i++ {
+		buf, err := marshaler.MarshalLogs(testdata.GenerateLogs(i))
+		require.NoError(t, err)
+		data = appendFrame(data, buf)
+	}
+	writeFile(t, filepath.Join(dir, "logs.binpb.gz"), compress(t, configcompression.TypeGzip, string(data)))
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Include = []string{filepath.Join(dir, "*")}
+	cfg.Format = FormatProto
+	cfg.Compression = configcompression.TypeGzip
+
+	sink := new(consumertest.LogsSink)
+	r, err := createLogs(context.Background(), receivertest.NewNopSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), newHost(nil)))
+	defer func() { require.NoError(t, r.Shutdown(context.Background())) }()
+
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 6
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Len(t, sink.AllLogs(), 3)
+}
+
+func TestProtoMultipleSignals(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Include = []string{filepath.Join(t.TempDir(), "*")}
+	cfg.Format = FormatProto
+
+	set := receivertest.NewNopSettings()
+	r, err := createTraces(context.Background(), set, cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	_, err = createMetrics(context.Background(), set, cfg, consumertest.NewNop())
+	require.NoError(t, err)
+	require.ErrorContains(t, r.Start(context.Background(), newHost(nil)), `the "proto" format cannot tell the signals apart`)
+	require.NoError(t, r.Shutdown(context.Background()))
+}
+
+func TestReplay(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var data []byte
+	for i := 0; i < 3; i++ {
+		td := ptrace.NewTraces()
+		span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+		span.SetStartTimestamp(pcommon.NewTimestampFromTime(start.Add(time.Duration(i) * 200 * time.Millisecond)))
+		span.SetEndTimestamp(pcommon.NewTimestampFromTime(start.Add(time.Duration(i)*200*time.Millisecond + time.Second)))
+		data = appendJSON(t, data, td)
+	}
+	writeFile(t, filepath.Join(dir, "traces.jsonl"), data)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Include = []string{filepath.Join(dir, "*")}
+	cfg.Replay = ReplayConfig{Speed: 2, ShiftTimestamps: true}
+
+	sink := new(consumertest.TracesSink)
+	r, err := createTraces(context.Background(), receivertest.NewNopSettings(), cfg, sink)
+	require.NoError(t, err)
+	before := time.Now()
+	require.NoError(t, r.Start(context.Background(), newHost(nil)))
+	defer func() { require.NoError(t, r.Shutdown(context.Background())) }()
+
+	assert.Eventually(t, func() bool {
+		return sink.SpanCount() == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	// The last request is 400ms after the first one, replayed twice as fast.
+	assert.GreaterOrEqual(t, time.Since(before), 200*time.Millisecond)
+
+	for i, td := range sink.AllTraces() {
+		span := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+		assert.WithinDuration(t, time.Now(), span.StartTimestamp().AsTime(), 5*time.Second, "request %d", i)
+		assert.Equal(t, time.Second, span.EndTimestamp().AsTime().Sub(span.StartTimestamp().AsTime()))
+	}
+}
+
+func TestReplayShutdown(t *testing.T) {
+	dir := t.TempDir()
+	var data []byte
+	for i := 0; i < 2; i++ {
+		td := ptrace.NewTraces()
+		span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+		span.SetStartTimestamp(pcommon.Timestamp(1 + int64(i)*int64(time.Hour)))
+		data = appendJSON(t, data, td)
+	}
+	writeFile(t, filepath.Join(dir, "traces.jsonl"), data)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Include = []string{filepath.Join(dir, "*")}
+	cfg.Replay.Speed = 1
+
+	sink := new(consumertest.TracesSink)
+	r, err := createTraces(context.Background(), receivertest.NewNopSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), newHost(nil)))
+
+	assert.Eventually(t, func() bool {
+		return sink.SpanCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	// Shutdown does not wait for the second request, due in an hour.
+	require.NoError(t, r.Shutdown(context.Background()))
+	assert.Equal(t, 1, sink.SpanCount())
+}
+
+func TestCheckpoint(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "logs.jsonl")
+	writeFile(t, path, appendJSON(t, appendJSON(t, nil, testdata.GenerateLogs(1)), testdata.GenerateLogs(2)))
+	ext := &mockStorageExtension{st: map[string][]byte{}}
+	host := newHost(map[component.ID]component.Component{storageID: ext})
+
+	receive := func(wantRecords int) *consumertest.LogsSink {
+		cfg := createDefaultConfig().(*Config)
+		cfg.Include = []string{path}
+		cfg.StorageID = &storageID
+		sink := new(consumertest.LogsSink)
+		r, err := createLogs(context.Background(), receivertest.NewNopSettings(), cfg, sink)
+		require.NoError(t, err)
+		require.NoError(t, r.Start(context.Background(), host))
+		assert.Eventually(t, func() bool {
+			return sink.LogRecordCount() == wantRecords
+		}, 5*time.Second, 10*time.Millisecond)
+		require.NoError(t, r.Shutdown(context.Background()))
+		return sink
+	}
+
+	assert.Len(t, receive(3).AllLogs(), 2)
+
+	// Only the requests appended since are read after a restart.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.Write(appendJSON(t, nil, testdata.GenerateLogs(4)))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	assert.Len(t, receive(4).AllLogs(), 1)
+}
+
+func TestStorageErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		ext     map[component.ID]component.Component
+		wantErr error
+	}{
+		{
+			name:    "not_found",
+			wantErr: errNoStorageClient,
+		},
+		{
+			name:    "wrong_type",
+			ext:     map[component.ID]component.Component{storageID: componentWithoutStorage{}},
+			wantErr: errWrongExtensionType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Include = []string{filepath.Join(t.TempDir(), "*")}
+			cfg.StorageID = &storageID
+			r, err := createLogs(context.Background(), receivertest.NewNopSettings(), cfg, consumertest.NewNop())
+			require.NoError(t, err)
+			require.ErrorIs(t, r.Start(context.Background(), newHost(tt.ext)), tt.wantErr)
+			require.NoError(t, r.Shutdown(context.Background()))
+		})
+	}
+}
+
+func TestDecodeErrorsSkipRequest(t *testing.T) {
+	dir := t.TempDir()
+	data := appendJSON(t, nil, testdata.GenerateLogs(1))
+	data = append(data, "{\"unknown\":[]}\nnot json\n"...)
+	data = appendJSON(t, data, testdata.GenerateLogs(2))
+	writeFile(t, filepath.Join(dir, "logs.jsonl"), data)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Include = []string{filepath.Join(dir, "*")}
+	sink := new(consumertest.LogsSink)
+	r, err := createLogs(context.Background(), receivertest.NewNopSettings(), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(context.Background(), newHost(nil)))
+	defer func() { require.NoError(t, r.Shutdown(context.Background())) }()
+
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 3
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestConsumeRetryNonPermanentErrors(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "logs.jsonl")
+	writeFile(t, path, appendJSON(t, appendJSON(t, nil, testdata.GenerateLogs(1)), testdata.GenerateLogs(2)))
+	ext := &mockStorageExtension{st: map[string][]byte{}}
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Include = []string{path}
+	cfg.StorageID = &storageID
+	sink := new(consumertest.LogsSink)
+	var calls atomic.Int32
+	next, err := consumer.NewLogs(func(ctx context.Context, ld plog.Logs) error {
+		// Every request is rejected twice before being accepted.
+		if calls.Add(1)%3 != 0 {
+			return errors.New("temporary error")
+		}
+		return sink.ConsumeLogs(ctx, ld)
+	})
+	require.NoError(t, err)
+	r := newFastRetryLogs(t, cfg, next)
+	require.NoError(t, r.Start(context.Background(), newHost(map[component.ID]component.Component{storageID: ext})))
+
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+	assert.Len(t, sink.AllLogs(), 2)
+	assert.EqualValues(t, 6, calls.Load())
+	assert.Equal(t, 1, sink.AllLogs()[0].LogRecordCount())
+	assert.Len(t, ext.st, 1, "the progress is recorded")
+}
+
+func TestConsumePermanentErrorSkipsRequest(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "logs.jsonl")
+	writeFile(t, path, appendJSON(t, appendJSON(t, nil, testdata.GenerateLogs(1)), testdata.GenerateLogs(2)))
+	ext := &mockStorageExtension{st: map[string][]byte{}}
+	host := newHost(map[component.ID]component.Component{storageID: ext})
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Include = []string{path}
+	cfg.StorageID = &storageID
+	sink := new(consumertest.LogsSink)
+	var calls atomic.Int32
+	next, err := consumer.NewLogs(func(ctx context.Context, ld plog.Logs) error {
+		if calls.Add(1) == 1 {
+			return consumererror.NewPermanent(errors.New("invalid data"))
+		}
+		return sink.ConsumeLogs(ctx, ld)
+	})
+	require.NoError(t, err)
+	r := newFastRetryLogs(t, cfg, next)
+	require.NoError(t, r.Start(context.Background(), host))
+
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+	assert.EqualValues(t, 2, calls.Load(), "the rejected request is not retried")
+
+	// The rejected request is not read again after a restart.
+	cfg = createDefaultConfig().(*Config)
+	cfg.Include = []string{path}
+	cfg.StorageID = &storageID
+	sink = new(consumertest.LogsSink)
+	r = newFastRetryLogs(t, cfg, sink)
+	require.NoError(t, r.Start(context.Background(), host))
+	require.NoError(t, r.Shutdown(context.Background()))
+	assert.Zero(t, sink.LogRecordCount())
+}
+
+func TestConsumeRetryUntilShutdown(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "logs.jsonl")
+	writeFile(t, path, appendJSON(t, nil, testdata.GenerateLogs(1)))
+	ext := &mockStorageExtension{st: map[string][]byte{}}
+	host := newHost(map[component.ID]component.Component{storageID: ext})
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.Include = []string{path}
+	cfg.StorageID = &storageID
+	var calls atomic.Int32
+	next, err := consumer.NewLogs(func(context.Context, plog.Logs) error {
+		calls.Add(1)
+		return errors.New("temporary error")
+	})
+	require.NoError(t, err)
+	r := newFastRetryLogs(t, cfg, next)
+	require.NoError(t, r.Start(context.Background(), host))
+	assert.Eventually(t, func() bool {
+		return calls.Load() > 3
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+
+	// The request which was never accepted is read again after a restart.
+	cfg = createDefaultConfig().(*Config)
+	cfg.Include = []string{path}
+	cfg.StorageID = &storageID
+	sink := new(consumertest.LogsSink)
+	r = newFastRetryLogs(t, cfg, sink)
+	require.NoError(t, r.Start(context.Background(), host))
+	assert.Eventually(t, func() bool {
+		return sink.LogRecordCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+}
+
+// newFastRetryLogs creates a logs receiver retrying the rejected requests without waiting.
+func newFastRetryLogs(t *testing.T, cfg *Config, next consumer.Logs) receiver.Logs {
+	r, err := createLogs(context.Background(), receivertest.NewNopSettings(), cfg, next)
+	require.NoError(t, err)
+	r.(*sharedcomponent.Component[*fileReceiver]).Unwrap().newBackOff = func() backoff.BackOff {
+		return backoff.NewConstantBackOff(time.Millisecond)
+	}
+	return r
+}
+
+func appendJSON(t *testing.T, data []byte, v any) []byte {
+	var buf []byte
+	var err error
+	switch v := v.(type) {
+	case ptrace.Traces:
+		buf, err = (&ptrace.JSONMarshaler{}).MarshalTraces(v)
+	case pmetric.Metrics:
+		buf, err = (&pmetric.JSONMarshaler{}).MarshalMetrics(v)
+	case plog.Logs:
+		buf, err = (&plog.JSONMarshaler{}).MarshalLogs(v)
+	case pprofile.Profiles:
+		buf, err = (&pprofile.JSONMarshaler{}).MarshalProfiles(v)
+	}
+	require.NoError(t, err)
+	data = append(data, buf...)
+	return append(data, '\n')
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+}
+
+type mockHost struct {
+	component.Host
+	ext map[component.ID]component.Component
+}
+
+func newHost(ext map[component.ID]component.Component) *mockHost {
+	return &mockHost{ext: ext}
+}
+
+func (h *mockHost) GetExtensions() map[component.ID]component.Component {
+	return h.ext
+}
+
+type componentWithoutStorage struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+// mockStorageExtension stores the data of all its clients in memory, and keeps it after they are closed.
+type mockStorageExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+	mu sync.Mutex
+	st map[string][]byte
+}
+
+func (m *mockStorageExtension) GetClient(context.Context, component.Kind, component.ID, string) (storage.Client, error) {
+	return &mockStorageClient{ext: m}, nil
+}
+
+type mockStorageClient struct {
+	ext *mockStorageExtension
+}
+
+func (c *mockStorageClient) Get(ctx context.Context, key string) ([]byte, error) {
+	op := storage.GetOperation(key)
+	err := c.Batch(ctx, op)
+	return op.Value, err
+}
+
+func (c *mockStorageClient) Set(ctx context.Context, key string, value []byte) error {
+	return c.Batch(ctx, storage.SetOperation(key, value))
+}
+
+func (c *mockStorageClient) Delete(ctx context.Context, key string) error {
+	return c.Batch(ctx, storage.DeleteOperation(key))
+}
+
+func (c *mockStorageClient) Batch(_ context.Context, ops ...storage.Operation) error {
+	c.ext.mu.Lock()
+	defer c.ext.mu.Unlock()
+	for _, op := range ops {
+		switch op.Type {
+		case storage.Get:
+			op.Value = c.ext.st[op.Key]
+		case storage.Set:
+			c.ext.st[op.Key] = op.Value
+		case storage.Delete:
+			delete(c.ext.st, op.Key)
+		}
+	}
+	return nil
+}
+
+func (c *mockStorageClient) Close(context.Context) error {
+	return nil
+}
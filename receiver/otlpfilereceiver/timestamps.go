@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfilereceiver // import "go.opentelemetry.io/collector/receiver/otlpfilereceiver"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// timestampFunc is applied to every timestamp of a request, and returns its new value.
+type timestampFunc func(pcommon.Timestamp) pcommon.Timestamp
+
+// earliest returns a timestampFunc recording the earliest timestamp into first,
+// leaving the timestamps unchanged.
+func earliest(first *pcommon.Timestamp) timestampFunc {
+	return func(ts pcommon.Timestamp) pcommon.Timestamp {
+		if *first == 0 || ts < *first {
+			*first = ts
+		}
+		return ts
+	}
+}
+
+// shift returns a timestampFunc shifting the timestamps by the given number of nanoseconds.
+func shift(delta int64) timestampFunc {
+	return func(ts pcommon.Timestamp) pcommon.Timestamp {
+		return pcommon.Timestamp(int64(ts) + delta) //nolint:gosec // timestamps are far from overflowing
+	}
+}
+
+// apply applies fn to ts unless it is not set.
+func apply(ts pcommon.Timestamp, set func(pcommon.Timestamp), fn timestampFunc) {
+	if ts != 0 {
+		set(fn(ts))
+	}
+}
+
+func walkTraces(td ptrace.Traces, fn timestampFunc) {
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		sss := td.ResourceSpans().At(i).ScopeSpans()
+		for j := 0; j < sss.Len(); j++ {
+			spans := sss.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				apply(span.StartTimestamp(), span.SetStartTimestamp, fn)
+				apply(span.EndTimestamp(), span.SetEndTimestamp, fn)
+				for l := 0; l < span.Events().Len(); l++ {
+					event := span.Events().At(l)
+					apply(event.Timestamp(), event.SetTimestamp, fn)
+				}
+			}
+		}
+	}
+}
+
+func walkMetrics(md pmetric.Metrics, fn timestampFunc) {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		sms := md.ResourceMetrics().At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			metrics := sms.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				walkMetric(metrics.At(k), fn)
+			}
+		}
+	}
+}
+
+func walkMetric(m pmetric.Metric, fn timestampFunc) {
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		walkNumberDataPoints(m.Gauge().DataPoints(), fn)
+	case pmetric.MetricTypeSum:
+		walkNumberDataPoints(m.Sum().DataPoints(), fn)
+	case pmetric.MetricTypeHistogram:
+		dps := m.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			apply(dp.StartTimestamp(), dp.SetStartTimestamp, fn)
+			apply(dp.Timestamp(), dp.SetTimestamp, fn)
+			walkExemplars(dp.Exemplars(), fn)
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		dps := m.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			apply(dp.StartTimestamp(), dp.SetStartTimestamp, fn)
+			apply(dp.Timestamp(), dp.SetTimestamp, fn)
+			walkExemplars(dp.Exemplars(), fn)
+		}
+	case pmetric.MetricTypeSummary:
+		dps := m.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			apply(dp.StartTimestamp(), dp.SetStartTimestamp, fn)
+			apply(dp.Timestamp(), dp.SetTimestamp, fn)
+		}
+	}
+}
+
+func walkNumberDataPoints(dps pmetric.NumberDataPointSlice, fn timestampFunc) {
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		apply(dp.StartTimestamp(), dp.SetStartTimestamp, fn)
+		apply(dp.Timestamp(), dp.SetTimestamp, fn)
+		walkExemplars(dp.Exemplars(), fn)
+	}
+}
+
+func walkExemplars(exemplars pmetric.ExemplarSlice, fn timestampFunc) {
+	for i := 0; i < exemplars.Len(); i++ {
+		exemplar := exemplars.At(i)
+		apply(exemplar.Timestamp(), exemplar.SetTimestamp, fn)
+	}
+}
+
+func walkLogs(ld plog.Logs, fn timestampFunc) {
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		sls := ld.ResourceLogs().At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			lrs := sls.At(j).LogRecords()
+			for k := 0; k < lrs.Len(); k++ {
+				lr := lrs.At(k)
+				apply(lr.Timestamp(), lr.SetTimestamp, fn)
+				apply(lr.ObservedTimestamp(), lr.SetObservedTimestamp, fn)
+			}
+		}
+	}
+}
+
+func walkProfiles(pd pprofile.Profiles, fn timestampFunc) {
+	for i := 0; i < pd.ResourceProfiles().Len(); i++ {
+		sps := pd.ResourceProfiles().At(i).ScopeProfiles()
+		for j := 0; j < sps.Len(); j++ {
+			profiles := sps.At(j).Profiles()
+			for k := 0; k < profiles.Len(); k++ {
+				// StartTime is an alias of Time, and must not be shifted twice.
+				profile := profiles.At(k)
+				apply(profile.Time(), profile.SetTime, fn)
+			}
+		}
+	}
+}
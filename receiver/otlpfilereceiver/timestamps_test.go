@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfilereceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func TestWalkTraces(t *testing.T) {
+	td := ptrace.NewTraces()
+	span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetStartTimestamp(200)
+	span.SetEndTimestamp(300)
+	span.Events().AppendEmpty().SetTimestamp(250)
+
+	var first pcommon.Timestamp
+	walkTraces(td, earliest(&first))
+	assert.Equal(t, pcommon.Timestamp(200), first)
+
+	walkTraces(td, shift(1000))
+	assert.Equal(t, pcommon.Timestamp(1200), span.StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(1300), span.EndTimestamp())
+	assert.Equal(t, pcommon.Timestamp(1250), span.Events().At(0).Timestamp())
+}
+
+func TestWalkMetrics(t *testing.T) {
+	md := pmetric.NewMetrics()
+	metrics := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
+	gauge := metrics.AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+	gauge.SetTimestamp(500)
+	gauge.Exemplars().AppendEmpty().SetTimestamp(450)
+	sum := metrics.AppendEmpty().SetEmptySum().DataPoints().AppendEmpty()
+	sum.SetStartTimestamp(100)
+	sum.SetTimestamp(500)
+	histogram := metrics.AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty()
+	histogram.SetStartTimestamp(200)
+	histogram.SetTimestamp(500)
+	histogram.Exemplars().AppendEmpty().SetTimestamp(400)
+	expHistogram := metrics.AppendEmpty().SetEmptyExponentialHistogram().DataPoints().AppendEmpty()
+	expHistogram.SetStartTimestamp(300)
+	expHistogram.SetTimestamp(500)
+	expHistogram.Exemplars().AppendEmpty().SetTimestamp(400)
+	summary := metrics.AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty()
+	summary.SetStartTimestamp(150)
+	summary.SetTimestamp(500)
+
+	var first pcommon.Timestamp
+	walkMetrics(md, earliest(&first))
+	assert.Equal(t, pcommon.Timestamp(100), first)
+
+	walkMetrics(md, shift(-50))
+	assert.Equal(t, pcommon.Timestamp(0), gauge.StartTimestamp(), "unset timestamps are not shifted")
+	assert.Equal(t, pcommon.Timestamp(450), gauge.Timestamp())
+	assert.Equal(t, pcommon.Timestamp(400), gauge.Exemplars().At(0).Timestamp())
+	assert.Equal(t, pcommon.Timestamp(50), sum.StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(150), histogram.StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(350), histogram.Exemplars().At(0).Timestamp())
+	assert.Equal(t, pcommon.Timestamp(250), expHistogram.StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(350), expHistogram.Exemplars().At(0).Timestamp())
+	assert.Equal(t, pcommon.Timestamp(100), summary.StartTimestamp())
+	assert.Equal(t, pcommon.Timestamp(450), summary.Timestamp())
+}
+
+func TestWalkLogs(t *testing.T) {
+	ld := plog.NewLogs()
+	lrs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	lr := lrs.AppendEmpty()
+	lr.SetTimestamp(300)
+	lr.SetObservedTimestamp(400)
+	observedOnly := lrs.AppendEmpty()
+	observedOnly.SetObservedTimestamp(350)
+
+	var first pcommon.Timestamp
+	walkLogs(ld, earliest(&first))
+	assert.Equal(t, pcommon.Timestamp(300), first)
+
+	walkLogs(ld, shift(100))
+	assert.Equal(t, pcommon.Timestamp(400), lr.Timestamp())
+	assert.Equal(t, pcommon.Timestamp(500), lr.ObservedTimestamp())
+	assert.Equal(t, pcommon.Timestamp(0), observedOnly.Timestamp())
+	assert.Equal(t, pcommon.Timestamp(450), observedOnly.ObservedTimestamp())
+}
+
+func TestWalkProfiles(t *testing.T) {
+	pd := pprofile.NewProfiles()
+	profile := pd.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty()
+	profile.SetTime(300)
+
+	var first pcommon.Timestamp
+	walkProfiles(pd, earliest(&first))
+	assert.Equal(t, pcommon.Timestamp(300), first)
+
+	walkProfiles(pd, shift(100))
+	assert.Equal(t, pcommon.Timestamp(400), profile.Time())
+}
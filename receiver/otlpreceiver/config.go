@@ -32,6 +32,12 @@ type HTTPConfig struct {
 
 	// The URL path to receive logs on. If omitted "/v1/logs" will be used.
 	LogsURLPath string `mapstructure:"logs_url_path,omitempty"`
+
+	// MaxRequestItems is the maximum number of spans, metric data points or log records of a protobuf
+	// request decoded while its body is read, when the receiver.otlp.streamProtoRequests feature gate
+	// is enabled. Larger requests are rejected as soon as the limit is exceeded. If omitted or 0,
+	// the number of items is not limited.
+	MaxRequestItems int `mapstructure:"max_request_items,omitempty"`
 }
 
 // Protocols is the configuration for the supported protocols.
@@ -54,6 +60,9 @@ func (cfg *Config) Validate() error {
 	if cfg.GRPC == nil && cfg.HTTP == nil {
 		return errors.New("must specify at least one protocol when using the OTLP receiver")
 	}
+	if cfg.HTTP != nil && cfg.HTTP.MaxRequestItems < 0 {
+		return errors.New("protocols::http::max_request_items must be positive")
+	}
 	return nil
 }
 
@@ -232,3 +232,9 @@ func TestUnmarshalConfigEmpty(t *testing.T) {
 	require.NoError(t, confmap.New().Unmarshal(&cfg))
 	assert.EqualError(t, component.ValidateConfig(cfg), "must specify at least one protocol when using the OTLP receiver")
 }
+
+func TestValidateNegativeMaxRequestItems(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.HTTP.MaxRequestItems = -1
+	assert.EqualError(t, component.ValidateConfig(cfg), "protocols::http::max_request_items must be positive")
+}
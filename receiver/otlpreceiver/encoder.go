@@ -5,15 +5,23 @@ package otlpreceiver // import "go.opentelemetry.io/collector/receiver/otlprecei
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
 	spb "google.golang.org/genproto/googleapis/rpc/status"
 
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
 	"go.opentelemetry.io/collector/pdata/pprofile/pprofileotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/metadata"
 )
 
 const (
@@ -27,6 +35,32 @@ var (
 	jsonPbMarshaler = &jsonpb.Marshaler{}
 )
 
+// errTooManyItems is returned when a request decoded while being read exceeds the max_request_items limit.
+var errTooManyItems = errors.New("too many items in the request")
+
+// itemsLimit counts the items of a request decoded while being read, one resource at a time.
+type itemsLimit struct {
+	max   int
+	count int
+}
+
+// add counts the n items of the last decoded resource, and fails if the request exceeds the limit.
+func (l *itemsLimit) add(n int) error {
+	l.count += n
+	if l.max > 0 && l.count > l.max {
+		return fmt.Errorf("%w: the limit is %d", errTooManyItems, l.max)
+	}
+	return nil
+}
+
+// streamErrorStatusCode returns the HTTP status code of an error decoding a request while it is read.
+func streamErrorStatusCode(err error) int {
+	if errors.Is(err, errTooManyItems) {
+		return http.StatusRequestEntityTooLarge
+	}
+	return http.StatusBadRequest
+}
+
 type encoder interface {
 	unmarshalTracesRequest(buf []byte) (ptraceotlp.ExportRequest, error)
 	unmarshalMetricsRequest(buf []byte) (pmetricotlp.ExportRequest, error)
@@ -45,24 +79,79 @@ type encoder interface {
 
 type protoEncoder struct{}
 
+func newProtoRequest[T any](newRequest func() T, newPooledRequest func() T) T {
+	if metadata.ReceiverOtlpUsePooledRequestsFeatureGate.IsEnabled() {
+		return newPooledRequest()
+	}
+	return newRequest()
+}
+
 func (protoEncoder) unmarshalTracesRequest(buf []byte) (ptraceotlp.ExportRequest, error) {
-	req := ptraceotlp.NewExportRequest()
+	req := newProtoRequest(ptraceotlp.NewExportRequest, ptraceotlp.NewPooledExportRequest)
 	err := req.UnmarshalProto(buf)
 	return req, err
 }
 
 func (protoEncoder) unmarshalMetricsRequest(buf []byte) (pmetricotlp.ExportRequest, error) {
-	req := pmetricotlp.NewExportRequest()
+	req := newProtoRequest(pmetricotlp.NewExportRequest, pmetricotlp.NewPooledExportRequest)
 	err := req.UnmarshalProto(buf)
 	return req, err
 }
 
 func (protoEncoder) unmarshalLogsRequest(buf []byte) (plogotlp.ExportRequest, error) {
-	req := plogotlp.NewExportRequest()
+	req := newProtoRequest(plogotlp.NewExportRequest, plogotlp.NewPooledExportRequest)
 	err := req.UnmarshalProto(buf)
 	return req, err
 }
 
+// streamTracesRequest decodes a request from r one resource at a time, without reading r entirely first.
+// It stops with errTooManyItems as soon as the request has more than maxItems spans, if maxItems is positive.
+func (protoEncoder) streamTracesRequest(r io.Reader, maxItems int) (ptraceotlp.ExportRequest, error) {
+	req := ptraceotlp.NewExportRequest()
+	rss := req.Traces().ResourceSpans()
+	limit := itemsLimit{max: maxItems}
+	err := ptraceotlp.UnmarshalProtoStream(r, func(td ptrace.Traces) error {
+		if err := limit.add(td.SpanCount()); err != nil {
+			return err
+		}
+		td.ResourceSpans().MoveAndAppendTo(rss)
+		return nil
+	})
+	return req, err
+}
+
+// streamMetricsRequest decodes a request from r one resource at a time, without reading r entirely first.
+// It stops with errTooManyItems as soon as the request has more than maxItems data points, if maxItems is positive.
+func (protoEncoder) streamMetricsRequest(r io.Reader, maxItems int) (pmetricotlp.ExportRequest, error) {
+	req := pmetricotlp.NewExportRequest()
+	rms := req.Metrics().ResourceMetrics()
+	limit := itemsLimit{max: maxItems}
+	err := pmetricotlp.UnmarshalProtoStream(r, func(md pmetric.Metrics) error {
+		if err := limit.add(md.DataPointCount()); err != nil {
+			return err
+		}
+		md.ResourceMetrics().MoveAndAppendTo(rms)
+		return nil
+	})
+	return req, err
+}
+
+// streamLogsRequest decodes a request from r one resource at a time, without reading r entirely first.
+// It stops with errTooManyItems as soon as the request has more than maxItems log records, if maxItems is positive.
+func (protoEncoder) streamLogsRequest(r io.Reader, maxItems int) (plogotlp.ExportRequest, error) {
+	req := plogotlp.NewExportRequest()
+	rls := req.Logs().ResourceLogs()
+	limit := itemsLimit{max: maxItems}
+	err := plogotlp.UnmarshalProtoStream(r, func(ld plog.Logs) error {
+		if err := limit.add(ld.LogRecordCount()); err != nil {
+			return err
+		}
+		ld.ResourceLogs().MoveAndAppendTo(rls)
+		return nil
+	})
+	return req, err
+}
+
 func (protoEncoder) unmarshalProfilesRequest(buf []byte) (pprofileotlp.ExportRequest, error) {
 	req := pprofileotlp.NewExportRequest()
 	err := req.UnmarshalProto(buf)
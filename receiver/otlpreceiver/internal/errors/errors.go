@@ -4,8 +4,10 @@
 package errors // import "go.opentelemetry.io/collector/receiver/otlpreceiver/internal/errors"
 
 import (
+	"math"
 	"net/http"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -56,3 +58,14 @@ func GetHTTPStatusCodeFromStatus(s *status.Status) int {
 		return http.StatusInternalServerError
 	}
 }
+
+// GetRetryAfterFromStatus returns the number of seconds, rounded up, the client is asked to wait for
+// before retrying, if the status carries a RetryInfo detail.
+func GetRetryAfterFromStatus(s *status.Status) (int, bool) {
+	for _, detail := range s.Details() {
+		if ri, ok := detail.(*errdetails.RetryInfo); ok && ri.RetryDelay != nil {
+			return int(math.Ceil(ri.RetryDelay.AsDuration().Seconds())), true
+		}
+	}
+	return 0, false
+}
@@ -7,10 +7,14 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"go.opentelemetry.io/collector/consumer/consumererror"
 )
@@ -94,3 +98,41 @@ func Test_GetHTTPStatusCodeFromStatus(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetRetryAfterFromStatus(t *testing.T) {
+	withRetryInfo := func(delay time.Duration) *status.Status {
+		s, err := status.New(codes.ResourceExhausted, "test").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+		require.NoError(t, err)
+		return s
+	}
+	tests := []struct {
+		name     string
+		input    *status.Status
+		expected int
+		ok       bool
+	}{
+		{
+			name:  "No RetryInfo",
+			input: status.New(codes.ResourceExhausted, "test"),
+		},
+		{
+			name:     "Whole seconds",
+			input:    withRetryInfo(5 * time.Second),
+			expected: 5,
+			ok:       true,
+		},
+		{
+			name:     "Rounded up",
+			input:    withRetryInfo(1500 * time.Millisecond),
+			expected: 2,
+			ok:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, ok := GetRetryAfterFromStatus(tt.input)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
 	"go.opentelemetry.io/collector/pdata/testdata"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
@@ -72,6 +73,29 @@ func TestExport_PermanentErrorConsumer(t *testing.T) {
 	assert.Equal(t, plogotlp.ExportResponse{}, resp)
 }
 
+func TestExport_ReleasePooledRequest(t *testing.T) {
+	set := receivertest.NewNopSettings()
+	obsreport, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             set.ID,
+		Transport:              "http",
+		ReceiverCreateSettings: set,
+	})
+	require.NoError(t, err)
+
+	for _, retains := range []bool{false, true} {
+		next, err := consumer.NewLogs(func(context.Context, plog.Logs) error { return nil },
+			consumer.WithCapabilities(consumer.Capabilities{DoesNotRetainData: !retains}))
+		require.NoError(t, err)
+
+		req := plogotlp.NewPooledExportRequest()
+		testdata.GenerateLogs(1).CopyTo(req.Logs())
+		_, err = New(next, obsreport).Export(context.Background(), req)
+		require.NoError(t, err)
+		// A released request is marked as read-only, the data must be left untouched if the pipeline retains it.
+		assert.Equal(t, !retains, req.Logs().IsReadOnly())
+	}
+}
+
 func makeLogsServiceClient(t *testing.T, lc consumer.Logs) plogotlp.GRPCClient {
 	addr := otlpReceiverOnGRPCServer(t, lc)
 	cc, err := grpc.NewClient(addr.String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
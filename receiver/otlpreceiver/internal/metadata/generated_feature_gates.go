@@ -0,0 +1,27 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+// ReceiverOtlpStreamProtoRequestsFeatureGate is the "receiver.otlp.streamProtoRequests" feature gate.
+// When enabled, the OTLP receiver decodes protobuf requests received over HTTP while reading their body, one resource at a time, instead of buffering the whole body first.
+var ReceiverOtlpStreamProtoRequestsFeatureGate = featuregate.GlobalRegistry().MustRegister(
+	"receiver.otlp.streamProtoRequests",
+	featuregate.StageAlpha,
+	featuregate.WithRegisterDescription("When enabled, the OTLP receiver decodes protobuf requests received over HTTP while reading their body, one resource at a time, instead of buffering the whole body first."),
+	featuregate.WithRegisterFromVersion("v0.116.0"),
+	featuregate.WithRegisterComponent("receiver/otlp"),
+)
+
+// ReceiverOtlpUsePooledRequestsFeatureGate is the "receiver.otlp.usePooledRequests" feature gate.
+// When enabled, the OTLP receiver reuses the memory of protobuf requests received over HTTP once they are consumed by pipelines whose components all declare they do not retain the data.
+var ReceiverOtlpUsePooledRequestsFeatureGate = featuregate.GlobalRegistry().MustRegister(
+	"receiver.otlp.usePooledRequests",
+	featuregate.StageAlpha,
+	featuregate.WithRegisterDescription("When enabled, the OTLP receiver reuses the memory of protobuf requests received over HTTP once they are consumed by pipelines whose components all declare they do not retain the data."),
+	featuregate.WithRegisterFromVersion("v0.116.0"),
+	featuregate.WithRegisterComponent("receiver/otlp"),
+)
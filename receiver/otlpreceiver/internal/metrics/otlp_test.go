@@ -20,6 +20,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
 	"go.opentelemetry.io/collector/pdata/testdata"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
@@ -72,6 +73,29 @@ func TestExport_PermanentErrorConsumer(t *testing.T) {
 	assert.Equal(t, pmetricotlp.ExportResponse{}, resp)
 }
 
+func TestExport_ReleasePooledRequest(t *testing.T) {
+	set := receivertest.NewNopSettings()
+	obsreport, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             set.ID,
+		Transport:              "http",
+		ReceiverCreateSettings: set,
+	})
+	require.NoError(t, err)
+
+	for _, retains := range []bool{false, true} {
+		next, err := consumer.NewMetrics(func(context.Context, pmetric.Metrics) error { return nil },
+			consumer.WithCapabilities(consumer.Capabilities{DoesNotRetainData: !retains}))
+		require.NoError(t, err)
+
+		req := pmetricotlp.NewPooledExportRequest()
+		testdata.GenerateMetrics(1).CopyTo(req.Metrics())
+		_, err = New(next, obsreport).Export(context.Background(), req)
+		require.NoError(t, err)
+		// A released request is marked as read-only, the data must be left untouched if the pipeline retains it.
+		assert.Equal(t, !retains, req.Metrics().IsReadOnly())
+	}
+}
+
 func makeMetricsServiceClient(t *testing.T, mc consumer.Metrics) pmetricotlp.GRPCClient {
 	addr := otlpReceiverOnGRPCServer(t, mc)
 
@@ -20,6 +20,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
 	"go.opentelemetry.io/collector/pdata/testdata"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
@@ -69,6 +70,29 @@ func TestExport_PermanentErrorConsumer(t *testing.T) {
 	assert.Equal(t, ptraceotlp.ExportResponse{}, resp)
 }
 
+func TestExport_ReleasePooledRequest(t *testing.T) {
+	set := receivertest.NewNopSettings()
+	obsreport, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             set.ID,
+		Transport:              "http",
+		ReceiverCreateSettings: set,
+	})
+	require.NoError(t, err)
+
+	for _, retains := range []bool{false, true} {
+		next, err := consumer.NewTraces(func(context.Context, ptrace.Traces) error { return nil },
+			consumer.WithCapabilities(consumer.Capabilities{DoesNotRetainData: !retains}))
+		require.NoError(t, err)
+
+		req := ptraceotlp.NewPooledExportRequest()
+		testdata.GenerateTraces(1).CopyTo(req.Traces())
+		_, err = New(next, obsreport).Export(context.Background(), req)
+		require.NoError(t, err)
+		// A released request is marked as read-only, the data must be left untouched if the pipeline retains it.
+		assert.Equal(t, !retains, req.Traces().IsReadOnly())
+	}
+}
+
 func makeTraceServiceClient(t *testing.T, tc consumer.Traces) ptraceotlp.GRPCClient {
 	addr := otlpReceiverOnGRPCServer(t, tc)
 	cc, err := grpc.NewClient(addr.String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
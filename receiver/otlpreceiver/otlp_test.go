@@ -21,12 +21,14 @@ import (
 	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	spb "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
@@ -37,6 +39,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumererror"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/internal/testutil"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
@@ -44,6 +47,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
 	"go.opentelemetry.io/collector/pdata/testdata"
+	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/metadata"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 )
 
@@ -152,6 +156,26 @@ func TestJsonHttp(t *testing.T) {
 	}
 }
 
+func TestHTTPRetryAfter(t *testing.T) {
+	addr := testutil.GetAvailableLocalAddress(t)
+	sink := newErrOrSinkConsumer()
+	recv := newHTTPReceiver(t, componenttest.NewNopTelemetrySettings(), addr, sink)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()), "Failed to start trace receiver")
+	t.Cleanup(func() { require.NoError(t, recv.Shutdown(context.Background())) })
+
+	st, err := status.New(codes.ResourceExhausted, "throttled").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(1500 * time.Millisecond)})
+	require.NoError(t, err)
+	sink.SetConsumeError(st.Err())
+
+	for _, dr := range generateDataRequests(t) {
+		resp, err := http.Post("http://"+addr+dr.path, "application/json", bytes.NewReader(dr.jsonBytes))
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		assert.Equal(t, "2", resp.Header.Get("Retry-After"))
+	}
+}
+
 func TestHandleInvalidRequests(t *testing.T) {
 	addr := testutil.GetAvailableLocalAddress(t)
 	sink := newErrOrSinkConsumer()
@@ -324,6 +348,14 @@ func TestHandleInvalidRequests(t *testing.T) {
 }
 
 func TestProtoHttp(t *testing.T) {
+	t.Run("buffered", testProtoHTTP)
+	t.Run("streamed", func(t *testing.T) {
+		setStreamProtoRequestsForTest(t)
+		testProtoHTTP(t)
+	})
+}
+
+func testProtoHTTP(t *testing.T) {
 	tests := []struct {
 		name               string
 		encoding           string
@@ -812,6 +844,90 @@ func testHTTPMaxRequestBodySize(t *testing.T, path string, contentType string, p
 }
 
 func TestHTTPMaxRequestBodySize(t *testing.T) {
+	t.Run("buffered", testHTTPMaxRequestBodySizes)
+	t.Run("streamed", func(t *testing.T) {
+		setStreamProtoRequestsForTest(t)
+		testHTTPMaxRequestBodySizes(t)
+	})
+}
+
+func TestHTTPStreamedInvalidProto(t *testing.T) {
+	setStreamProtoRequestsForTest(t)
+	addr := testutil.GetAvailableLocalAddress(t)
+	sink := newErrOrSinkConsumer()
+	recv := newHTTPReceiver(t, componenttest.NewNopTelemetrySettings(), addr, sink)
+	require.NoError(t, recv.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, recv.Shutdown(context.Background())) })
+
+	for _, dr := range generateDataRequests(t) {
+		// The request is cut in the middle of its first resource.
+		doHTTPRequest(t, "http://"+addr+dr.path, "", "application/x-protobuf", dr.protoBytes[:len(dr.protoBytes)/2], http.StatusBadRequest)
+		sink.checkData(t, dr.data, 0)
+	}
+}
+
+func TestHTTPStreamedMaxRequestItems(t *testing.T) {
+	setStreamProtoRequestsForTest(t)
+	dataReqs := []dataRequest{generateTracesRequest(t), generateMetricsRequests(t), generateLogsRequest(t)}
+	itemCount := func(data any) int {
+		switch d := data.(type) {
+		case ptrace.Traces:
+			return d.SpanCount()
+		case pmetric.Metrics:
+			return d.DataPointCount()
+		case plog.Logs:
+			return d.LogRecordCount()
+		}
+		return 0
+	}
+
+	for _, dr := range dataReqs {
+		t.Run(dr.path, func(t *testing.T) {
+			items := itemCount(dr.data)
+			require.Greater(t, items, 1)
+			for _, tt := range []struct {
+				maxItems         int
+				expectStatusCode int
+				expectedItems    int
+			}{
+				{maxItems: 0, expectStatusCode: http.StatusOK, expectedItems: items},
+				{maxItems: items, expectStatusCode: http.StatusOK, expectedItems: items},
+				{maxItems: items - 1, expectStatusCode: http.StatusRequestEntityTooLarge, expectedItems: 0},
+			} {
+				addr := testutil.GetAvailableLocalAddress(t)
+				cfg := createDefaultConfig().(*Config)
+				cfg.HTTP.Endpoint = addr
+				cfg.HTTP.MaxRequestItems = tt.maxItems
+				cfg.GRPC = nil
+				sink := new(consumertest.TracesSink)
+				metricsSink := new(consumertest.MetricsSink)
+				logsSink := new(consumertest.LogsSink)
+				set := receivertest.NewNopSettings()
+				r, err := newOtlpReceiver(cfg, &set)
+				require.NoError(t, err)
+				r.registerTraceConsumer(sink)
+				r.registerMetricsConsumer(metricsSink)
+				r.registerLogsConsumer(logsSink)
+				require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+
+				doHTTPRequest(t, "http://"+addr+dr.path, "", "application/x-protobuf", dr.protoBytes, tt.expectStatusCode)
+				assert.Equal(t, tt.expectedItems, sink.SpanCount()+metricsSink.DataPointCount()+logsSink.LogRecordCount())
+				require.NoError(t, r.Shutdown(context.Background()))
+			}
+		})
+	}
+}
+
+func setStreamProtoRequestsForTest(t *testing.T) {
+	gate := metadata.ReceiverOtlpStreamProtoRequestsFeatureGate
+	prev := gate.IsEnabled()
+	require.NoError(t, featuregate.GlobalRegistry().Set(gate.ID(), true))
+	t.Cleanup(func() {
+		require.NoError(t, featuregate.GlobalRegistry().Set(gate.ID(), prev))
+	})
+}
+
+func testHTTPMaxRequestBodySizes(t *testing.T) {
 	dataReqs := generateDataRequests(t)
 
 	for _, dr := range dataReqs {
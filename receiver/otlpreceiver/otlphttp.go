@@ -8,13 +8,18 @@ import (
 	"io"
 	"mime"
 	"net/http"
+	"strconv"
 
 	spb "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc/status"
 
 	"go.opentelemetry.io/collector/internal/httphelper"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
 	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/errors"
 	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/logs"
+	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/metadata"
 	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/metrics"
 	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/profiles"
 	"go.opentelemetry.io/collector/receiver/otlpreceiver/internal/trace"
@@ -25,23 +30,17 @@ var fallbackMsg = []byte(`{"code": 13, "message": "failed to marshal error messa
 
 const fallbackContentType = "application/json"
 
-func handleTraces(resp http.ResponseWriter, req *http.Request, tracesReceiver *trace.Receiver) {
+func handleTraces(resp http.ResponseWriter, req *http.Request, tracesReceiver *trace.Receiver, maxItems int) {
 	enc, ok := readContentType(resp, req)
 	if !ok {
 		return
 	}
 
-	body, ok := readAndCloseBody(resp, req, enc)
+	otlpReq, ok := readTracesRequest(resp, req, enc, maxItems)
 	if !ok {
 		return
 	}
 
-	otlpReq, err := enc.unmarshalTracesRequest(body)
-	if err != nil {
-		writeError(resp, enc, err, http.StatusBadRequest)
-		return
-	}
-
 	otlpResp, err := tracesReceiver.Export(req.Context(), otlpReq)
 	if err != nil {
 		writeError(resp, enc, err, http.StatusInternalServerError)
@@ -56,23 +55,17 @@ func handleTraces(resp http.ResponseWriter, req *http.Request, tracesReceiver *t
 	writeResponse(resp, enc.contentType(), http.StatusOK, msg)
 }
 
-func handleMetrics(resp http.ResponseWriter, req *http.Request, metricsReceiver *metrics.Receiver) {
+func handleMetrics(resp http.ResponseWriter, req *http.Request, metricsReceiver *metrics.Receiver, maxItems int) {
 	enc, ok := readContentType(resp, req)
 	if !ok {
 		return
 	}
 
-	body, ok := readAndCloseBody(resp, req, enc)
+	otlpReq, ok := readMetricsRequest(resp, req, enc, maxItems)
 	if !ok {
 		return
 	}
 
-	otlpReq, err := enc.unmarshalMetricsRequest(body)
-	if err != nil {
-		writeError(resp, enc, err, http.StatusBadRequest)
-		return
-	}
-
 	otlpResp, err := metricsReceiver.Export(req.Context(), otlpReq)
 	if err != nil {
 		writeError(resp, enc, err, http.StatusInternalServerError)
@@ -87,23 +80,17 @@ func handleMetrics(resp http.ResponseWriter, req *http.Request, metricsReceiver
 	writeResponse(resp, enc.contentType(), http.StatusOK, msg)
 }
 
-func handleLogs(resp http.ResponseWriter, req *http.Request, logsReceiver *logs.Receiver) {
+func handleLogs(resp http.ResponseWriter, req *http.Request, logsReceiver *logs.Receiver, maxItems int) {
 	enc, ok := readContentType(resp, req)
 	if !ok {
 		return
 	}
 
-	body, ok := readAndCloseBody(resp, req, enc)
+	otlpReq, ok := readLogsRequest(resp, req, enc, maxItems)
 	if !ok {
 		return
 	}
 
-	otlpReq, err := enc.unmarshalLogsRequest(body)
-	if err != nil {
-		writeError(resp, enc, err, http.StatusBadRequest)
-		return
-	}
-
 	otlpResp, err := logsReceiver.Export(req.Context(), otlpReq)
 	if err != nil {
 		writeError(resp, enc, err, http.StatusInternalServerError)
@@ -166,6 +153,76 @@ func readContentType(resp http.ResponseWriter, req *http.Request) (encoder, bool
 	}
 }
 
+func readTracesRequest(resp http.ResponseWriter, req *http.Request, enc encoder, maxItems int) (ptraceotlp.ExportRequest, bool) {
+	if streamProtoRequests(enc) {
+		otlpReq, err := pbEncoder.streamTracesRequest(req.Body, maxItems)
+		return otlpReq, closeStreamedBody(resp, req, enc, err)
+	}
+	body, ok := readAndCloseBody(resp, req, enc)
+	if !ok {
+		return ptraceotlp.ExportRequest{}, false
+	}
+	otlpReq, err := enc.unmarshalTracesRequest(body)
+	if err != nil {
+		writeError(resp, enc, err, http.StatusBadRequest)
+		return ptraceotlp.ExportRequest{}, false
+	}
+	return otlpReq, true
+}
+
+func readMetricsRequest(resp http.ResponseWriter, req *http.Request, enc encoder, maxItems int) (pmetricotlp.ExportRequest, bool) {
+	if streamProtoRequests(enc) {
+		otlpReq, err := pbEncoder.streamMetricsRequest(req.Body, maxItems)
+		return otlpReq, closeStreamedBody(resp, req, enc, err)
+	}
+	body, ok := readAndCloseBody(resp, req, enc)
+	if !ok {
+		return pmetricotlp.ExportRequest{}, false
+	}
+	otlpReq, err := enc.unmarshalMetricsRequest(body)
+	if err != nil {
+		writeError(resp, enc, err, http.StatusBadRequest)
+		return pmetricotlp.ExportRequest{}, false
+	}
+	return otlpReq, true
+}
+
+func readLogsRequest(resp http.ResponseWriter, req *http.Request, enc encoder, maxItems int) (plogotlp.ExportRequest, bool) {
+	if streamProtoRequests(enc) {
+		otlpReq, err := pbEncoder.streamLogsRequest(req.Body, maxItems)
+		return otlpReq, closeStreamedBody(resp, req, enc, err)
+	}
+	body, ok := readAndCloseBody(resp, req, enc)
+	if !ok {
+		return plogotlp.ExportRequest{}, false
+	}
+	otlpReq, err := enc.unmarshalLogsRequest(body)
+	if err != nil {
+		writeError(resp, enc, err, http.StatusBadRequest)
+		return plogotlp.ExportRequest{}, false
+	}
+	return otlpReq, true
+}
+
+// streamProtoRequests returns whether the body of requests encoded with enc is decoded while being read,
+// so that the whole body is never held in memory.
+func streamProtoRequests(enc encoder) bool {
+	return enc == pbEncoder && metadata.ReceiverOtlpStreamProtoRequestsFeatureGate.IsEnabled()
+}
+
+// closeStreamedBody closes the body of a request decoded while being read, and writes an error
+// response if decoding or closing failed.
+func closeStreamedBody(resp http.ResponseWriter, req *http.Request, enc encoder, err error) bool {
+	if closeErr := req.Body.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		writeError(resp, enc, err, streamErrorStatusCode(err))
+		return false
+	}
+	return true
+}
+
 func readAndCloseBody(resp http.ResponseWriter, req *http.Request, enc encoder) ([]byte, bool) {
 	body, err := io.ReadAll(req.Body)
 	if err != nil {
@@ -184,6 +241,9 @@ func writeError(w http.ResponseWriter, encoder encoder, err error, statusCode in
 	s, ok := status.FromError(err)
 	if ok {
 		statusCode = errors.GetHTTPStatusCodeFromStatus(s)
+		if retryAfter, hasRetryAfter := errors.GetRetryAfterFromStatus(s); hasRetryAfter {
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		}
 	} else {
 		s = httphelper.NewStatusFromMsgAndHTTPCode(err.Error(), statusCode)
 	}
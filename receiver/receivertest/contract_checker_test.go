@@ -9,26 +9,28 @@ import (
 	"sync/atomic"
 	"testing"
 
-	"github.com/stretchr/testify/require"
-
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumererror"
-	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/consumer/consumerprofiles"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pipeline"
+	"go.opentelemetry.io/collector/pipeline/pipelineprofiles"
 	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverprofiles"
 )
 
 // This file is an example that demonstrates how to use the CheckConsumeContract() function.
 // We declare a trivial example receiver, a data generator and then use them in TestConsumeContract().
 
 type exampleReceiver struct {
-	nextLogsConsumer    consumer.Logs
-	nextTracesConsumer  consumer.Traces
-	nextMetricsConsumer consumer.Metrics
+	nextLogsConsumer     consumer.Logs
+	nextTracesConsumer   consumer.Traces
+	nextMetricsConsumer  consumer.Metrics
+	nextProfilesConsumer consumerprofiles.Profiles
 }
 
 func (s *exampleReceiver) Start(context.Context, component.Host) error {
@@ -96,6 +98,23 @@ func (s *exampleReceiver) ReceiveTraces(data ptrace.Traces) {
 	}
 }
 
+func (s *exampleReceiver) ReceiveProfiles(data pprofile.Profiles) {
+	// This very simple implementation demonstrates how a single items receiving should happen.
+	for {
+		err := s.nextProfilesConsumer.ConsumeProfiles(context.Background(), data)
+		if err != nil {
+			// The next consumer returned an error.
+			if !consumererror.IsPermanent(err) {
+				// It is not a permanent error, so we must retry sending it again.
+				continue
+			}
+		}
+		// Either the ConsumeProfiles returned success or it returned a permanent error.
+		// In either case we don't need to retry the same data, we are done.
+		return
+	}
+}
+
 // A config for exampleReceiver.
 type exampleReceiverConfig struct {
 	generator Generator
@@ -192,15 +211,42 @@ func (g *exampleMetricGenerator) Generate() []UniqueIDAttrVal {
 	return []UniqueIDAttrVal{id}
 }
 
+// A generator that can send data to exampleReceiver.
+type exampleProfileGenerator struct {
+	t           *testing.T
+	receiver    *exampleReceiver
+	sequenceNum int64
+}
+
+func (g *exampleProfileGenerator) Start() {
+	g.sequenceNum = 0
+}
+
+func (g *exampleProfileGenerator) Stop() {}
+
+func (g *exampleProfileGenerator) Generate() []UniqueIDAttrVal {
+	// Make sure the id is atomically incremented. Generate() may be called concurrently.
+	id := UniqueIDAttrVal(strconv.FormatInt(atomic.AddInt64(&g.sequenceNum, 1), 10))
+
+	data := CreateOneProfileWithID(id)
+
+	// Send the generated data to the receiver.
+	g.receiver.ReceiveProfiles(data)
+
+	// And return the ids for bookkeeping by the test.
+	return []UniqueIDAttrVal{id}
+}
+
 func newExampleFactory() receiver.Factory {
-	return receiver.NewFactory(
+	return receiverprofiles.NewFactory(
 		component.MustNewType("example_receiver"),
 		func() component.Config {
 			return &exampleReceiverConfig{}
 		},
-		receiver.WithLogs(createLog, component.StabilityLevelBeta),
-		receiver.WithMetrics(createMetric, component.StabilityLevelBeta),
-		receiver.WithTraces(createTrace, component.StabilityLevelBeta),
+		receiverprofiles.WithLogs(createLog, component.StabilityLevelBeta),
+		receiverprofiles.WithMetrics(createMetric, component.StabilityLevelBeta),
+		receiverprofiles.WithTraces(createTrace, component.StabilityLevelBeta),
+		receiverprofiles.WithProfiles(createProfile, component.StabilityLevelAlpha),
 	)
 }
 
@@ -227,6 +273,17 @@ func createLog(
 	return rcv, nil
 }
 
+func createProfile(
+	_ context.Context,
+	_ receiver.Settings,
+	cfg component.Config,
+	consumer consumerprofiles.Profiles,
+) (receiverprofiles.Profiles, error) {
+	rcv := &exampleReceiver{nextProfilesConsumer: consumer}
+	cfg.(*exampleReceiverConfig).generator.(*exampleProfileGenerator).receiver = rcv
+	return rcv, nil
+}
+
 // TestConsumeContract is an example of testing of the receiver for the contract between the
 // receiver and next consumer.
 func TestConsumeContract(t *testing.T) {
@@ -293,68 +350,24 @@ func TestConsumeTracesContract(t *testing.T) {
 	CheckConsumeContract(params)
 }
 
-func TestIDSetFromDataPoint(t *testing.T) {
-	require.Error(t, idSetFromDataPoint(map[UniqueIDAttrVal]bool{}, pcommon.NewMap()))
-	m := pcommon.NewMap()
-	m.PutStr("foo", "bar")
-	require.Error(t, idSetFromDataPoint(map[UniqueIDAttrVal]bool{}, m))
-	m.PutInt(UniqueIDAttrName, 64)
-	require.Error(t, idSetFromDataPoint(map[UniqueIDAttrVal]bool{}, m))
-	m.PutStr(UniqueIDAttrName, "myid")
-	result := map[UniqueIDAttrVal]bool{}
-	require.NoError(t, idSetFromDataPoint(result, m))
-	require.True(t, result["myid"])
-}
+// TestConsumeProfilesContract is an example of testing of the receiver for the contract between the
+// receiver and next consumer.
+func TestConsumeProfilesContract(t *testing.T) {
+	// Number of profiles to send per scenario.
+	const profilesPerTest = 100
 
-func TestBadMetricPoint(t *testing.T) {
-	for _, test := range []struct {
-		name    string
-		metrics pmetric.Metrics
-	}{
-		{
-			name: "gauge",
-			metrics: func() pmetric.Metrics {
-				m := pmetric.NewMetrics()
-				m.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
-				return m
-			}(),
-		},
-		{
-			name: "sum",
-			metrics: func() pmetric.Metrics {
-				m := pmetric.NewMetrics()
-				m.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptySum().DataPoints().AppendEmpty()
-				return m
-			}(),
-		},
-		{
-			name: "summary",
-			metrics: func() pmetric.Metrics {
-				m := pmetric.NewMetrics()
-				m.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptySummary().DataPoints().AppendEmpty()
-				return m
-			}(),
-		},
-		{
-			name: "histogram",
-			metrics: func() pmetric.Metrics {
-				m := pmetric.NewMetrics()
-				m.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyHistogram().DataPoints().AppendEmpty()
-				return m
-			}(),
-		},
-		{
-			name: "exponential histogram",
-			metrics: func() pmetric.Metrics {
-				m := pmetric.NewMetrics()
-				m.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyExponentialHistogram().DataPoints().AppendEmpty()
-				return m
-			}(),
-		},
-	} {
-		t.Run(test.name, func(t *testing.T) {
-			_, err := idSetFromMetrics(test.metrics)
-			require.Error(t, err)
-		})
+	generator := &exampleProfileGenerator{t: t}
+	cfg := &exampleReceiverConfig{generator: generator}
+
+	params := CheckConsumeContractParams{
+		T:             t,
+		Factory:       newExampleFactory(),
+		Signal:        pipelineprofiles.SignalProfiles,
+		Config:        cfg,
+		Generator:     generator,
+		GenerateCount: profilesPerTest,
 	}
+
+	// Run the contract checker. This will trigger test failures if any problems are found.
+	CheckConsumeContract(params)
 }
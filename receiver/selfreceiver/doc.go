@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package selfreceiver provides the self receiver, injecting the collector's own metrics,
+// spans and logs into pipelines without going through the network.
+package selfreceiver // import "go.opentelemetry.io/collector/receiver/selfreceiver"
@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package selfreceiver // import "go.opentelemetry.io/collector/receiver/selfreceiver"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pipeline"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/selfreceiver/internal/metadata"
+)
+
+// NewFactory returns a receiver.Factory that constructs self receivers.
+func NewFactory() receiver.Factory {
+	return receiver.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		receiver.WithTraces(createTraces, metadata.TracesStability),
+		receiver.WithMetrics(createMetrics, metadata.MetricsStability),
+		receiver.WithLogs(createLogs, metadata.LogsStability))
+}
+
+func createTraces(_ context.Context, set receiver.Settings, cfg component.Config, next consumer.Traces) (receiver.Traces, error) {
+	return newSelfReceiver(set, cfg.(*Config), pipeline.SignalTraces, func(ctx context.Context, sub subscription) error {
+		ts, ok := sub.(tracesSubscription)
+		if !ok {
+			return errUnsupportedSubscription
+		}
+		td, dropped := ts.Traces()
+		logDropped(ctx, set, dropped)
+		if td.SpanCount() == 0 {
+			return nil
+		}
+		return next.ConsumeTraces(ctx, td)
+	}), nil
+}
+
+func createMetrics(_ context.Context, set receiver.Settings, cfg component.Config, next consumer.Metrics) (receiver.Metrics, error) {
+	return newSelfReceiver(set, cfg.(*Config), pipeline.SignalMetrics, func(ctx context.Context, sub subscription) error {
+		ms, ok := sub.(metricsSubscription)
+		if !ok {
+			return errUnsupportedSubscription
+		}
+		md, err := ms.Metrics(ctx)
+		if err != nil {
+			return err
+		}
+		if md.DataPointCount() == 0 {
+			return nil
+		}
+		return next.ConsumeMetrics(ctx, md)
+	}), nil
+}
+
+func createLogs(_ context.Context, set receiver.Settings, cfg component.Config, next consumer.Logs) (receiver.Logs, error) {
+	return newSelfReceiver(set, cfg.(*Config), pipeline.SignalLogs, func(ctx context.Context, sub subscription) error {
+		ls, ok := sub.(logsSubscription)
+		if !ok {
+			return errUnsupportedSubscription
+		}
+		ld, dropped := ls.Logs()
+		logDropped(ctx, set, dropped)
+		if ld.LogRecordCount() == 0 {
+			return nil
+		}
+		return next.ConsumeLogs(ctx, ld)
+	}), nil
+}
@@ -0,0 +1,36 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package selfreceiver
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Config defines the configuration of the self receiver.
+//
+// Configuration of the self receiver.
+type Config struct {
+	// Interval at which the collector's own metrics are collected, and its buffered spans and logs are delivered.
+	CollectionInterval time.Duration `mapstructure:"collection_interval"`
+}
+
+var _ component.Config = (*Config)(nil)
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		CollectionInterval: 10 * time.Second,
+	}
+}
+
+// Validate checks if the configuration is valid.
+func (cfg *Config) Validate() error {
+	var errs error
+	if cfg.CollectionInterval < 1*time.Second {
+		errs = errors.Join(errs, fmt.Errorf("`collection_interval` must be greater than or equal to 1s, got %v", cfg.CollectionInterval))
+	}
+	return errs
+}
@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package selfreceiver // import "go.opentelemetry.io/collector/receiver/selfreceiver"
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pipeline"
+	"go.opentelemetry.io/collector/receiver"
+)
+
+// selfTelemetryHost is implemented by the host of the service collecting its own telemetry.
+// GetSelfTelemetry returns a subscription to the telemetry of the given signal, or nil when
+// the telemetry isn't collected.
+type selfTelemetryHost interface {
+	GetSelfTelemetry(signal pipeline.Signal) any
+}
+
+// subscription is the subscription returned by the host, extended with the method
+// reading the telemetry of its signal: tracesSubscription, metricsSubscription or logsSubscription.
+type subscription interface {
+	// Deliver calls deliver to pass the telemetry to the pipeline. The spans started from the
+	// context given to deliver, and the logs written with it as a field, are not collected,
+	// to avoid feedback loops.
+	Deliver(ctx context.Context, deliver func(context.Context) error) error
+	Unsubscribe()
+}
+
+type tracesSubscription interface {
+	subscription
+	Traces() (ptrace.Traces, int)
+}
+
+type metricsSubscription interface {
+	subscription
+	Metrics(ctx context.Context) (pmetric.Metrics, error)
+}
+
+type logsSubscription interface {
+	subscription
+	Logs() (plog.Logs, int)
+}
+
+// errUnsupportedSubscription is returned when the subscription returned by the host cannot read the signal of the receiver.
+var errUnsupportedSubscription = errors.New("the subscription to the collector's own telemetry does not support the signal of the receiver")
+
+type deliverFunc func(ctx context.Context, sub subscription) error
+
+type selfReceiver struct {
+	set     receiver.Settings
+	cfg     *Config
+	signal  pipeline.Signal
+	deliver deliverFunc
+
+	sub    subscription
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newSelfReceiver(set receiver.Settings, cfg *Config, signal pipeline.Signal, deliver deliverFunc) *selfReceiver {
+	return &selfReceiver{
+		set:     set,
+		cfg:     cfg,
+		signal:  signal,
+		deliver: deliver,
+	}
+}
+
+func (r *selfReceiver) Start(_ context.Context, host component.Host) error {
+	sth, ok := host.(selfTelemetryHost)
+	if !ok {
+		r.set.Logger.Warn("The collector's own telemetry is not available from the host, no data will be received")
+		return nil
+	}
+	sub, ok := sth.GetSelfTelemetry(r.signal).(subscription)
+	if !ok {
+		r.set.Logger.Warn("The collector's own telemetry is not collected by the host, no data will be received")
+		return nil
+	}
+	r.sub = sub
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(r.cfg.CollectionInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_ = r.sub.Deliver(ctx, func(ctx context.Context) error {
+					// The error is logged with the delivery context, so that it is not collected in turn.
+					if err := r.deliver(ctx, r.sub); err != nil {
+						r.set.Logger.Error("Failed to deliver the collector's own telemetry", zap.Error(err), contextField(ctx))
+					}
+					return nil
+				})
+			}
+		}
+	}()
+	return nil
+}
+
+func (r *selfReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+	if r.sub != nil {
+		r.sub.Unsubscribe()
+	}
+	return nil
+}
+
+func logDropped(ctx context.Context, set receiver.Settings, dropped int) {
+	if dropped > 0 {
+		set.Logger.Warn("Dropped some of the collector's own telemetry, the buffer was full", zap.Int("dropped", dropped), contextField(ctx))
+	}
+}
+
+// contextField passes the delivery context to the logger without encoding it, so that the
+// collector doesn't collect the logs written about the delivery of its own telemetry.
+func contextField(ctx context.Context) zap.Field {
+	return zap.Field{Key: "context", Type: zapcore.SkipType, Interface: ctx}
+}
@@ -0,0 +1,209 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package selfreceiver
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pipeline"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+)
+
+// testSubscription implements the subscriptions of all the signals, returning the telemetry
+// it is given once.
+type testSubscription struct {
+	mu      sync.Mutex
+	traces  ptrace.Traces
+	metrics pmetric.Metrics
+	logs    plog.Logs
+	dropped int
+
+	delivering   atomic.Bool
+	unsubscribed atomic.Bool
+}
+
+type deliveryContextKey struct{}
+
+func (s *testSubscription) Deliver(ctx context.Context, deliver func(context.Context) error) error {
+	s.delivering.Store(true)
+	defer s.delivering.Store(false)
+	return deliver(context.WithValue(ctx, deliveryContextKey{}, true))
+}
+
+// requireDeliveryContext checks that the entry was logged with the context given by Deliver,
+// so that the collector doesn't collect it.
+func requireDeliveryContext(t *testing.T, entry observer.LoggedEntry) {
+	for _, f := range entry.Context {
+		if ctx, ok := f.Interface.(context.Context); ok {
+			require.Equal(t, zapcore.SkipType, f.Type)
+			delivering, _ := ctx.Value(deliveryContextKey{}).(bool)
+			require.True(t, delivering)
+			return
+		}
+	}
+	require.Fail(t, "the entry was not logged with the delivery context")
+}
+
+func (s *testSubscription) Unsubscribe() {
+	s.unsubscribed.Store(true)
+}
+
+func (s *testSubscription) Traces() (ptrace.Traces, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	td, dropped := s.traces, s.dropped
+	s.traces, s.dropped = ptrace.NewTraces(), 0
+	return td, dropped
+}
+
+func (s *testSubscription) Metrics(context.Context) (pmetric.Metrics, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	md := s.metrics
+	s.metrics = pmetric.NewMetrics()
+	return md, nil
+}
+
+func (s *testSubscription) Logs() (plog.Logs, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ld, dropped := s.logs, s.dropped
+	s.logs, s.dropped = plog.NewLogs(), 0
+	return ld, dropped
+}
+
+type testHost struct {
+	component.Host
+	sub    any
+	signal pipeline.Signal
+}
+
+func (h *testHost) GetSelfTelemetry(signal pipeline.Signal) any {
+	h.signal = signal
+	return h.sub
+}
+
+func newTestHost(sub any) *testHost {
+	return &testHost{Host: componenttest.NewNopHost(), sub: sub}
+}
+
+func newTestSubscription() *testSubscription {
+	return &testSubscription{traces: ptrace.NewTraces(), metrics: pmetric.NewMetrics(), logs: plog.NewLogs()}
+}
+
+func testConfig() *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.CollectionInterval = 10 * time.Millisecond
+	return cfg
+}
+
+func TestReceiveTraces(t *testing.T) {
+	sub := newTestSubscription()
+	sub.traces.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty().SetName("span")
+	host := newTestHost(sub)
+
+	var delivering atomic.Bool
+	sink := new(consumertest.TracesSink)
+	next, err := consumer.NewTraces(func(ctx context.Context, td ptrace.Traces) error {
+		delivering.Store(sub.delivering.Load())
+		return sink.ConsumeTraces(ctx, td)
+	})
+	require.NoError(t, err)
+	rcv, err := NewFactory().CreateTraces(context.Background(), receivertest.NewNopSettings(), testConfig(), next)
+	require.NoError(t, err)
+	require.NoError(t, rcv.Start(context.Background(), host))
+	assert.Equal(t, pipeline.SignalTraces, host.signal)
+
+	assert.Eventually(t, func() bool { return sink.SpanCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, rcv.Shutdown(context.Background()))
+	assert.Equal(t, "span", sink.AllTraces()[0].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Name())
+	assert.True(t, delivering.Load(), "the data is consumed while delivering")
+	assert.True(t, sub.unsubscribed.Load())
+}
+
+func TestReceiveMetrics(t *testing.T) {
+	sub := newTestSubscription()
+	sub.metrics.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().
+		SetEmptyGauge().DataPoints().AppendEmpty().SetIntValue(1)
+	sub.metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).SetName("gauge")
+	host := newTestHost(sub)
+
+	sink := new(consumertest.MetricsSink)
+	rcv, err := NewFactory().CreateMetrics(context.Background(), receivertest.NewNopSettings(), testConfig(), sink)
+	require.NoError(t, err)
+	require.NoError(t, rcv.Start(context.Background(), host))
+	assert.Equal(t, pipeline.SignalMetrics, host.signal)
+
+	assert.Eventually(t, func() bool { return sink.DataPointCount() > 0 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, rcv.Shutdown(context.Background()))
+	assert.Equal(t, "gauge", sink.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Name())
+}
+
+func TestReceiveLogs(t *testing.T) {
+	sub := newTestSubscription()
+	sub.logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("message")
+	sub.dropped = 2
+	host := newTestHost(sub)
+
+	core, observed := observer.New(zap.WarnLevel)
+	set := receivertest.NewNopSettings()
+	set.Logger = zap.New(core)
+	sink := new(consumertest.LogsSink)
+	rcv, err := NewFactory().CreateLogs(context.Background(), set, testConfig(), sink)
+	require.NoError(t, err)
+	require.NoError(t, rcv.Start(context.Background(), host))
+	assert.Equal(t, pipeline.SignalLogs, host.signal)
+
+	assert.Eventually(t, func() bool { return sink.LogRecordCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, rcv.Shutdown(context.Background()))
+	assert.Equal(t, "message", sink.AllLogs()[0].ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+	dropped := observed.FilterMessage("Dropped some of the collector's own telemetry, the buffer was full").All()
+	require.Len(t, dropped, 1)
+	requireDeliveryContext(t, dropped[0])
+}
+
+func TestReceiveError(t *testing.T) {
+	sub := newTestSubscription()
+	sub.logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	host := newTestHost(sub)
+
+	core, observed := observer.New(zap.ErrorLevel)
+	set := receivertest.NewNopSettings()
+	set.Logger = zap.New(core)
+	rcv, err := NewFactory().CreateLogs(context.Background(), set, testConfig(), consumertest.NewErr(errors.New("pipeline error")))
+	require.NoError(t, err)
+	require.NoError(t, rcv.Start(context.Background(), host))
+
+	assert.Eventually(t, func() bool { return observed.Len() > 0 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, rcv.Shutdown(context.Background()))
+	assert.Equal(t, "Failed to deliver the collector's own telemetry", observed.All()[0].Message)
+	requireDeliveryContext(t, observed.All()[0])
+}
+
+func TestStartWithoutSelfTelemetry(t *testing.T) {
+	for _, host := range []component.Host{componenttest.NewNopHost(), newTestHost(nil), newTestHost("unsupported")} {
+		rcv, err := NewFactory().CreateLogs(context.Background(), receivertest.NewNopSettings(), testConfig(), consumertest.NewNop())
+		require.NoError(t, err)
+		require.NoError(t, rcv.Start(context.Background(), host))
+		require.NoError(t, rcv.Shutdown(context.Background()))
+	}
+}
@@ -21,6 +21,10 @@ type Config struct {
 
 	// Pipelines are the set of data pipelines configured for the service.
 	Pipelines pipelines.Config `mapstructure:"pipelines"`
+
+	// FeatureGates enables or disables feature gates by ID, like the --feature-gates flag.
+	// They are applied before the components are built and can't be changed without restarting the collector.
+	FeatureGates map[string]bool `mapstructure:"feature_gates"`
 }
 
 func (cfg *Config) Validate() error {
@@ -214,7 +214,9 @@ func (n *connectorNode) buildProfiles(
 func aggregateCap(base baseConsumer, nexts []baseConsumer) consumer.Capabilities {
 	capabilities := base.Capabilities()
 	for _, next := range nexts {
-		capabilities.MutatesData = capabilities.MutatesData || next.Capabilities().MutatesData
+		nextCap := next.Capabilities()
+		capabilities.MutatesData = capabilities.MutatesData || nextCap.MutatesData
+		capabilities.DoesNotRetainData = capabilities.DoesNotRetainData && nextCap.DoesNotRetainData
 	}
 	return capabilities
 }
@@ -297,12 +297,16 @@ func (g *Graph) buildComponents(ctx context.Context, set Settings) error {
 		case *connectorNode:
 			err = n.buildComponent(ctx, set.Telemetry, set.BuildInfo, set.ConnectorBuilder, g.nextConsumers(n.ID()))
 		case *capabilitiesNode:
+			// The fanOutNode represents the aggregate capabilities of the exporters in the pipeline.
+			fanOutCap := g.pipelines[n.pipelineID].fanOutNode.getConsumer().Capabilities()
 			capability := consumer.Capabilities{
-				// The fanOutNode represents the aggregate capabilities of the exporters in the pipeline.
-				MutatesData: g.pipelines[n.pipelineID].fanOutNode.getConsumer().Capabilities().MutatesData,
+				MutatesData:       fanOutCap.MutatesData,
+				DoesNotRetainData: fanOutCap.DoesNotRetainData,
 			}
 			for _, proc := range g.pipelines[n.pipelineID].processors {
-				capability.MutatesData = capability.MutatesData || proc.getConsumer().Capabilities().MutatesData
+				procCap := proc.getConsumer().Capabilities()
+				capability.MutatesData = capability.MutatesData || procCap.MutatesData
+				capability.DoesNotRetainData = capability.DoesNotRetainData && procCap.DoesNotRetainData
 			}
 			next := g.nextConsumers(n.ID())[0]
 			switch n.pipelineID.Signal() {
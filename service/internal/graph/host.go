@@ -4,9 +4,11 @@
 package graph // import "go.opentelemetry.io/collector/service/internal/graph"
 
 import (
+	"encoding/json"
 	"net/http"
 	"path"
 	"runtime"
+	"strconv"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -16,6 +18,7 @@ import (
 	"go.opentelemetry.io/collector/pipeline"
 	"go.opentelemetry.io/collector/service/extensions"
 	"go.opentelemetry.io/collector/service/internal/builders"
+	"go.opentelemetry.io/collector/service/internal/selftelemetry"
 	"go.opentelemetry.io/collector/service/internal/status"
 	"go.opentelemetry.io/collector/service/internal/zpages"
 )
@@ -45,6 +48,9 @@ type Host struct {
 	ServiceExtensions *extensions.Extensions
 
 	Reporter status.Reporter
+
+	// SelfTelemetry collects the collector's own telemetry for the self receivers, nil unless one is configured.
+	SelfTelemetry *selftelemetry.Hub
 }
 
 func (host *Host) GetFactory(kind component.Kind, componentType component.Type) component.Factory {
@@ -63,6 +69,19 @@ func (host *Host) GetFactory(kind component.Kind, componentType component.Type)
 	return nil
 }
 
+// GetSelfTelemetry subscribes to the collector's own telemetry of the given signal, or returns nil
+// when it is not collected. It is used by the self receivers, see go.opentelemetry.io/collector/receiver/selfreceiver,
+// and isn't part of the component.Host interface.
+//
+// The subscription is returned as any so that receivers don't depend on the service, and
+// implements the methods of *selftelemetry.Subscription.
+func (host *Host) GetSelfTelemetry(signal pipeline.Signal) any {
+	if host.SelfTelemetry == nil {
+		return nil
+	}
+	return host.SelfTelemetry.Subscribe(signal)
+}
+
 func (host *Host) GetExtensions() map[component.ID]component.Component {
 	return host.ServiceExtensions.GetExtensions()
 }
@@ -111,7 +130,7 @@ func (host *Host) RegisterZPages(mux *http.ServeMux, pathPrefix string) {
 	mux.HandleFunc(path.Join(pathPrefix, zServicePath), host.zPagesRequest)
 	mux.HandleFunc(path.Join(pathPrefix, zPipelinePath), host.Pipelines.HandleZPages)
 	mux.HandleFunc(path.Join(pathPrefix, zExtensionPath), host.ServiceExtensions.HandleZPages)
-	mux.HandleFunc(path.Join(pathPrefix, zFeaturePath), handleFeaturezRequest)
+	mux.HandleFunc(path.Join(pathPrefix, zFeaturePath), host.handleFeaturezRequest)
 }
 
 func (host *Host) zPagesRequest(w http.ResponseWriter, _ *http.Request) {
@@ -137,26 +156,41 @@ func (host *Host) zPagesRequest(w http.ResponseWriter, _ *http.Request) {
 	zpages.WriteHTMLPageFooter(w)
 }
 
-func handleFeaturezRequest(w http.ResponseWriter, _ *http.Request) {
+// handleFeaturezRequest writes the feature gates table, or the JSON report of the feature gates
+// when the "format=json" query parameter is set.
+func (host *Host) handleFeaturezRequest(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("format") == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		reports := featuregate.GlobalRegistry().Report(host.BuildInfo.Version)
+		if err := json.NewEncoder(w).Encode(reports); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 	zpages.WriteHTMLPageHeader(w, zpages.HeaderData{Title: "Feature Gates"})
-	zpages.WriteHTMLFeaturesTable(w, getFeaturesTableData())
+	zpages.WriteHTMLFeaturesTable(w, getFeaturesTableData(host.BuildInfo.Version))
 	zpages.WriteHTMLPageFooter(w)
 }
 
-func getFeaturesTableData() zpages.FeatureGateTableData {
+func getFeaturesTableData(version string) zpages.FeatureGateTableData {
 	data := zpages.FeatureGateTableData{}
-	featuregate.GlobalRegistry().VisitAll(func(gate *featuregate.Gate) {
-		data.Rows = append(data.Rows, zpages.FeatureGateTableRowData{
-			ID:           gate.ID(),
-			Enabled:      gate.IsEnabled(),
-			Description:  gate.Description(),
-			Stage:        gate.Stage().String(),
-			FromVersion:  gate.FromVersion(),
-			ToVersion:    gate.ToVersion(),
-			ReferenceURL: gate.ReferenceURL(),
-		})
-	})
+	for _, report := range featuregate.GlobalRegistry().Report(version) {
+		row := zpages.FeatureGateTableRowData{
+			ID:           report.ID,
+			Enabled:      report.Enabled,
+			Description:  report.Description,
+			Stage:        report.Stage,
+			FromVersion:  report.FromVersion,
+			ToVersion:    report.ToVersion,
+			ReferenceURL: report.ReferenceURL,
+			Expired:      report.Expired,
+		}
+		if report.Age != nil {
+			row.Age = strconv.Itoa(*report.Age)
+		}
+		data.Rows = append(data.Rows, row)
+	}
 	return data
 }
 
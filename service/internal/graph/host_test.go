@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+var _ = featuregate.GlobalRegistry().MustRegister("graph.test.featurez", featuregate.StageStable,
+	featuregate.WithRegisterFromVersion("v0.100.0"), featuregate.WithRegisterToVersion("v0.110.0"))
+
+func TestHostFeaturezJSON(t *testing.T) {
+	host := &Host{BuildInfo: component.BuildInfo{Version: "v0.115.0"}}
+	rr := httptest.NewRecorder()
+	host.handleFeaturezRequest(rr, httptest.NewRequest(http.MethodGet, "/featurez?format=json", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+
+	var reports []featuregate.GateReport
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &reports))
+	var found bool
+	for _, report := range reports {
+		if report.ID != "graph.test.featurez" {
+			continue
+		}
+		found = true
+		require.NotNil(t, report.Age)
+		assert.Equal(t, 15, *report.Age)
+		assert.True(t, report.Expired)
+	}
+	assert.True(t, found)
+}
+
+func TestHostFeaturezHTML(t *testing.T) {
+	host := &Host{BuildInfo: component.BuildInfo{Version: "latest"}}
+	rr := httptest.NewRecorder()
+	host.handleFeaturezRequest(rr, httptest.NewRequest(http.MethodGet, "/featurez", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "text/html; charset=utf-8", rr.Header().Get("Content-Type"))
+	assert.Contains(t, rr.Body.String(), "graph.test.featurez")
+}
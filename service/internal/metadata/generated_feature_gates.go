@@ -0,0 +1,44 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"go.opentelemetry.io/collector/featuregate"
+)
+
+// ServiceNoopTracerProviderFeatureGate is the "service.noopTracerProvider" feature gate.
+// Sets a Noop OpenTelemetry TracerProvider to reduce memory allocations. This featuregate is incompatible with the zPages extension.
+var ServiceNoopTracerProviderFeatureGate = featuregate.GlobalRegistry().MustRegister(
+	"service.noopTracerProvider",
+	featuregate.StageAlpha,
+	featuregate.WithRegisterDescription("Sets a Noop OpenTelemetry TracerProvider to reduce memory allocations. This featuregate is incompatible with the zPages extension."),
+	featuregate.WithRegisterFromVersion("v0.107.0"),
+	featuregate.WithRegisterToVersion("v0.109.0"),
+)
+
+// TelemetryUseLocalHostAsDefaultMetricsAddressFeatureGate is the "telemetry.UseLocalHostAsDefaultMetricsAddress" feature gate.
+// controls whether default Prometheus metrics server use localhost as the default host for their endpoints
+var TelemetryUseLocalHostAsDefaultMetricsAddressFeatureGate = featuregate.GlobalRegistry().MustRegister(
+	"telemetry.UseLocalHostAsDefaultMetricsAddress",
+	featuregate.StageBeta,
+	featuregate.WithRegisterDescription("controls whether default Prometheus metrics server use localhost as the default host for their endpoints"),
+	featuregate.WithRegisterFromVersion("v0.111.0"),
+)
+
+// TelemetryDisableAddressFieldForInternalTelemetryFeatureGate is the "telemetry.disableAddressFieldForInternalTelemetry" feature gate.
+// controls whether the deprecated address field for internal telemetry is still supported
+var TelemetryDisableAddressFieldForInternalTelemetryFeatureGate = featuregate.GlobalRegistry().MustRegister(
+	"telemetry.disableAddressFieldForInternalTelemetry",
+	featuregate.StageAlpha,
+	featuregate.WithRegisterDescription("controls whether the deprecated address field for internal telemetry is still supported"),
+	featuregate.WithRegisterFromVersion("v0.111.0"),
+	featuregate.WithRegisterToVersion("v0.114.0"),
+)
+
+// TelemetryDisableHighCardinalityMetricsFeatureGate is the "telemetry.disableHighCardinalityMetrics" feature gate.
+// controls whether the collector should enable potentially high cardinality metrics. The gate will be removed when the collector allows for view configuration.
+var TelemetryDisableHighCardinalityMetricsFeatureGate = featuregate.GlobalRegistry().MustRegister(
+	"telemetry.disableHighCardinalityMetrics",
+	featuregate.StageAlpha,
+	featuregate.WithRegisterDescription("controls whether the collector should enable potentially high cardinality metrics. The gate will be removed when the collector allows for view configuration."),
+)
@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package selftelemetry // import "go.opentelemetry.io/collector/service/internal/selftelemetry"
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func putAttributes(dest pcommon.Map, attrs []attribute.KeyValue) {
+	dest.EnsureCapacity(len(attrs))
+	for _, kv := range attrs {
+		putAttribute(dest, kv)
+	}
+}
+
+func putAttributeSet(dest pcommon.Map, set attribute.Set) {
+	putAttributes(dest, set.ToSlice())
+}
+
+func putAttribute(dest pcommon.Map, kv attribute.KeyValue) {
+	key := string(kv.Key)
+	switch kv.Value.Type() {
+	case attribute.BOOL:
+		dest.PutBool(key, kv.Value.AsBool())
+	case attribute.INT64:
+		dest.PutInt(key, kv.Value.AsInt64())
+	case attribute.FLOAT64:
+		dest.PutDouble(key, kv.Value.AsFloat64())
+	case attribute.STRING:
+		dest.PutStr(key, kv.Value.AsString())
+	case attribute.BOOLSLICE:
+		s := dest.PutEmptySlice(key)
+		for _, v := range kv.Value.AsBoolSlice() {
+			s.AppendEmpty().SetBool(v)
+		}
+	case attribute.INT64SLICE:
+		s := dest.PutEmptySlice(key)
+		for _, v := range kv.Value.AsInt64Slice() {
+			s.AppendEmpty().SetInt(v)
+		}
+	case attribute.FLOAT64SLICE:
+		s := dest.PutEmptySlice(key)
+		for _, v := range kv.Value.AsFloat64Slice() {
+			s.AppendEmpty().SetDouble(v)
+		}
+	case attribute.STRINGSLICE:
+		s := dest.PutEmptySlice(key)
+		for _, v := range kv.Value.AsStringSlice() {
+			s.AppendEmpty().SetStr(v)
+		}
+	default:
+		dest.PutStr(key, kv.Value.Emit())
+	}
+}
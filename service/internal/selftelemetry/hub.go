@@ -0,0 +1,263 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package selftelemetry collects the collector's own metrics, spans and logs in-process,
+// for receivers to inject them into pipelines.
+package selftelemetry // import "go.opentelemetry.io/collector/service/internal/selftelemetry"
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap/zapcore"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+// maxBuffered is the maximum number of spans or log records buffered by a subscription
+// between two reads. Newer items are dropped once the limit is reached.
+const maxBuffered = 10000
+
+// Hub collects the collector's own telemetry and dispatches it to its subscriptions.
+type Hub struct {
+	resource pcommon.Resource
+	reader   *sdkmetric.ManualReader
+
+	mu            sync.Mutex
+	subscriptions map[*Subscription]struct{}
+	// numTraces and numLogs count the subscriptions per signal, avoiding
+	// taking the lock on the hot path when nobody is subscribed.
+	numTraces atomic.Int32
+	numLogs   atomic.Int32
+
+	// skippedSpans holds the IDs of the spans started while delivering self telemetry,
+	// which are not collected to avoid feedback loops.
+	skippedSpans spanIDSet
+}
+
+// NewHub creates a Hub describing the telemetry with the given resource.
+func NewHub(res pcommon.Resource) *Hub {
+	return &Hub{
+		resource:      res,
+		reader:        sdkmetric.NewManualReader(),
+		subscriptions: map[*Subscription]struct{}{},
+	}
+}
+
+// MetricReader returns the reader to register on the collector's MeterProvider.
+func (h *Hub) MetricReader() sdkmetric.Reader {
+	return h.reader
+}
+
+// SpanProcessor returns the processor to register on the collector's TracerProvider.
+func (h *Hub) SpanProcessor() sdktrace.SpanProcessor {
+	return (*spanProcessor)(h)
+}
+
+// WrapCore returns a core writing the entries enabled at the given level to the
+// subscriptions, in addition to the given core.
+func (h *Hub) WrapCore(core zapcore.Core, level zapcore.LevelEnabler) zapcore.Core {
+	return zapcore.NewTee(core, &logsCore{hub: h, LevelEnabler: level})
+}
+
+// Subscribe creates a subscription to the given signal.
+func (h *Hub) Subscribe(signal pipeline.Signal) *Subscription {
+	s := &Subscription{hub: h, signal: signal}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.subscriptions[s] = struct{}{}
+	switch signal {
+	case pipeline.SignalTraces:
+		h.numTraces.Add(1)
+	case pipeline.SignalLogs:
+		h.numLogs.Add(1)
+	}
+	return s
+}
+
+func (h *Hub) unsubscribe(s *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subscriptions[s]; !ok {
+		return
+	}
+	delete(h.subscriptions, s)
+	switch s.signal {
+	case pipeline.SignalTraces:
+		h.numTraces.Add(-1)
+	case pipeline.SignalLogs:
+		h.numLogs.Add(-1)
+	}
+}
+
+func (h *Hub) dispatch(signal pipeline.Signal, add func(s *Subscription)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for s := range h.subscriptions {
+		if s.signal == signal {
+			add(s)
+		}
+	}
+}
+
+type selfTelemetryContextKey struct{}
+
+// contextWithSelfTelemetry marks the context as delivering self telemetry. The spans
+// started from such a context, and the log entries written with it as a field, are not
+// collected, to avoid feedback loops.
+func contextWithSelfTelemetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, selfTelemetryContextKey{}, true)
+}
+
+func isSelfTelemetry(ctx context.Context) bool {
+	v, _ := ctx.Value(selfTelemetryContextKey{}).(bool)
+	return v
+}
+
+// Subscription buffers the telemetry of a signal until it is read.
+type Subscription struct {
+	hub    *Hub
+	signal pipeline.Signal
+
+	mu           sync.Mutex
+	spans        []sdktrace.ReadOnlySpan
+	droppedSpans int
+	logs         []logEntry
+	droppedLogs  int
+}
+
+// Unsubscribe stops the subscription from receiving telemetry.
+func (s *Subscription) Unsubscribe() {
+	s.hub.unsubscribe(s)
+}
+
+// Deliver calls deliver to pass the telemetry read from the subscription to a pipeline.
+// The spans started from the context given to deliver, and the log entries having this
+// context as a field, are not collected, to avoid feedback loops.
+func (s *Subscription) Deliver(ctx context.Context, deliver func(context.Context) error) error {
+	return deliver(contextWithSelfTelemetry(ctx))
+}
+
+func (s *Subscription) addSpan(span sdktrace.ReadOnlySpan) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.spans) >= maxBuffered {
+		s.droppedSpans++
+		return
+	}
+	s.spans = append(s.spans, span)
+}
+
+func (s *Subscription) addLog(entry logEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.logs) >= maxBuffered {
+		s.droppedLogs++
+		return
+	}
+	s.logs = append(s.logs, entry)
+}
+
+// Traces returns the spans ended since the previous call, and the number of spans dropped
+// because the buffer was full.
+func (s *Subscription) Traces() (ptrace.Traces, int) {
+	s.mu.Lock()
+	spans, dropped := s.spans, s.droppedSpans
+	s.spans, s.droppedSpans = nil, 0
+	s.mu.Unlock()
+	return tracesFromSpans(s.hub.resource, spans), dropped
+}
+
+// Logs returns the log records written since the previous call, and the number of records
+// dropped because the buffer was full.
+func (s *Subscription) Logs() (plog.Logs, int) {
+	s.mu.Lock()
+	entries, dropped := s.logs, s.droppedLogs
+	s.logs, s.droppedLogs = nil, 0
+	s.mu.Unlock()
+	return logsFromEntries(s.hub.resource, entries), dropped
+}
+
+// Metrics collects the current value of the collector's metrics.
+func (s *Subscription) Metrics(ctx context.Context) (pmetric.Metrics, error) {
+	var rm metricdata.ResourceMetrics
+	if err := s.hub.reader.Collect(ctx, &rm); err != nil {
+		return pmetric.NewMetrics(), err
+	}
+	return metricsFromSDK(s.hub.resource, &rm), nil
+}
+
+// spanProcessor is the sdktrace.SpanProcessor view of the Hub.
+type spanProcessor Hub
+
+func (p *spanProcessor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {
+	if isSelfTelemetry(parent) {
+		p.skippedSpans.add(s.SpanContext().SpanID())
+	}
+}
+
+func (p *spanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	if p.skippedSpans.remove(s.SpanContext().SpanID()) {
+		return
+	}
+	if p.numTraces.Load() == 0 || !s.SpanContext().IsSampled() {
+		return
+	}
+	(*Hub)(p).dispatch(pipeline.SignalTraces, func(sub *Subscription) { sub.addSpan(s) })
+}
+
+func (p *spanProcessor) Shutdown(context.Context) error {
+	return nil
+}
+
+func (p *spanProcessor) ForceFlush(context.Context) error {
+	return nil
+}
+
+// maxSkippedSpans is the number of span IDs held by a spanIDSet generation.
+const maxSkippedSpans = 10000
+
+// spanIDSet is a set of span IDs whose size is bounded, since some spans never end.
+// The IDs are held in two generations: once the current one is full, it replaces the
+// previous one, and the IDs of the previous one are forgotten.
+type spanIDSet struct {
+	mu       sync.Mutex
+	current  map[trace.SpanID]struct{}
+	previous map[trace.SpanID]struct{}
+}
+
+func (s *spanIDSet) add(id trace.SpanID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.current) >= maxSkippedSpans {
+		s.previous, s.current = s.current, nil
+	}
+	if s.current == nil {
+		s.current = map[trace.SpanID]struct{}{}
+	}
+	s.current[id] = struct{}{}
+}
+
+// remove removes id from the set, and returns whether it was in it.
+func (s *spanIDSet) remove(id trace.SpanID) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.current[id]; ok {
+		delete(s.current, id)
+		return true
+	}
+	if _, ok := s.previous[id]; ok {
+		delete(s.previous, id)
+		return true
+	}
+	return false
+}
@@ -0,0 +1,209 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package selftelemetry
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+func newTestHub() *Hub {
+	res := pcommon.NewResource()
+	res.Attributes().PutStr("service.name", "otelcol")
+	return NewHub(res)
+}
+
+func TestHubTraces(t *testing.T) {
+	hub := newTestHub()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(hub.SpanProcessor()))
+	defer func() { require.NoError(t, tp.Shutdown(context.Background())) }()
+	tracer := tp.Tracer("test")
+
+	// Spans ended without subscriptions are not buffered.
+	_, span := tracer.Start(context.Background(), "before")
+	span.End()
+
+	sub := hub.Subscribe(pipeline.SignalTraces)
+	ctx, parent := tracer.Start(context.Background(), "parent")
+	_, child := tracer.Start(ctx, "child")
+	child.SetAttributes(attribute.String("key", "value"))
+	child.End()
+	parent.End()
+
+	// Spans started while delivering self telemetry are skipped.
+	require.NoError(t, sub.Deliver(context.Background(), func(ctx context.Context) error {
+		_, skipped := tracer.Start(ctx, "skipped")
+		skipped.End()
+		return nil
+	}))
+
+	td, dropped := sub.Traces()
+	assert.Zero(t, dropped)
+	require.Equal(t, 2, td.SpanCount())
+	rs := td.ResourceSpans().At(0)
+	v, ok := rs.Resource().Attributes().Get("service.name")
+	require.True(t, ok)
+	assert.Equal(t, "otelcol", v.Str())
+	spans := rs.ScopeSpans().At(0).Spans()
+	assert.Equal(t, "child", spans.At(0).Name())
+	assert.Equal(t, spans.At(1).SpanID(), spans.At(0).ParentSpanID())
+	v, ok = spans.At(0).Attributes().Get("key")
+	require.True(t, ok)
+	assert.Equal(t, "value", v.Str())
+
+	// The buffer is emptied by the read.
+	td, _ = sub.Traces()
+	assert.Zero(t, td.SpanCount())
+
+	sub.Unsubscribe()
+	_, span = tracer.Start(context.Background(), "after")
+	span.End()
+	td, _ = sub.Traces()
+	assert.Zero(t, td.SpanCount())
+}
+
+func TestHubTracesDropped(t *testing.T) {
+	hub := newTestHub()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(hub.SpanProcessor()))
+	defer func() { require.NoError(t, tp.Shutdown(context.Background())) }()
+	tracer := tp.Tracer("test")
+
+	sub := hub.Subscribe(pipeline.SignalTraces)
+	defer sub.Unsubscribe()
+	for i := 0; i < maxBuffered+5; i++ {
+		_, span := tracer.Start(context.Background(), "span")
+		span.End()
+	}
+	td, dropped := sub.Traces()
+	assert.Equal(t, maxBuffered, td.SpanCount())
+	assert.Equal(t, 5, dropped)
+}
+
+func TestHubLogs(t *testing.T) {
+	hub := newTestHub()
+	logger := zap.New(hub.WrapCore(zapcore.NewNopCore(), zapcore.InfoLevel))
+
+	logger.Info("before")
+
+	sub := hub.Subscribe(pipeline.SignalLogs)
+	defer sub.Unsubscribe()
+	logger.Debug("disabled")
+	logger.With(zap.String("kind", "processor"), zap.String("name", "batch")).
+		Warn("collected", zap.Int("count", 3), zap.Strings("values", []string{"a", "b"}))
+	// Entries written with the context of a delivery of self telemetry are skipped.
+	require.NoError(t, sub.Deliver(context.Background(), func(ctx context.Context) error {
+		ctxField := zap.Field{Key: "ctx", Type: zapcore.SkipType, Interface: ctx}
+		logger.Error("skipped", ctxField)
+		logger.With(ctxField).Error("skipped")
+		logger.With(zap.Any("ctx", ctx)).Named("child").Error("skipped")
+		// Other entries written meanwhile are collected.
+		logger.Info("delivering")
+		return nil
+	}))
+	logger.Info("unrelated context", zap.Field{Key: "ctx", Type: zapcore.SkipType, Interface: context.Background()})
+
+	ld, dropped := sub.Logs()
+	assert.Zero(t, dropped)
+	require.Equal(t, 3, ld.LogRecordCount())
+	sl := ld.ResourceLogs().At(0).ScopeLogs().At(0)
+	assert.Equal(t, scopeName, sl.Scope().Name())
+	lr := sl.LogRecords().At(0)
+	assert.Equal(t, "collected", lr.Body().Str())
+	assert.Equal(t, plog.SeverityNumberWarn, lr.SeverityNumber())
+	assert.Equal(t, "WARN", lr.SeverityText())
+	assert.Equal(t, map[string]any{
+		"kind":   "processor",
+		"name":   "batch",
+		"count":  int64(3),
+		"values": []any{"a", "b"},
+	}, lr.Attributes().AsRaw())
+	assert.Equal(t, "delivering", sl.LogRecords().At(1).Body().Str())
+	assert.Equal(t, "unrelated context", sl.LogRecords().At(2).Body().Str())
+}
+
+func TestSubscriptionDroppedPerSignal(t *testing.T) {
+	sub := newTestHub().Subscribe(pipeline.SignalLogs)
+	defer sub.Unsubscribe()
+	for i := 0; i < maxBuffered+5; i++ {
+		sub.addLog(logEntry{entry: zapcore.Entry{Message: "log"}})
+	}
+	// Dropping spans doesn't change the number of dropped log records.
+	sub.spans = make([]sdktrace.ReadOnlySpan, maxBuffered)
+	sub.addSpan(nil)
+
+	ld, dropped := sub.Logs()
+	assert.Equal(t, maxBuffered, ld.LogRecordCount())
+	assert.Equal(t, 5, dropped)
+	sub.spans = nil
+	_, dropped = sub.Traces()
+	assert.Equal(t, 1, dropped)
+}
+
+func TestSpanIDSet(t *testing.T) {
+	spanID := func(i int) trace.SpanID {
+		var id trace.SpanID
+		binary.BigEndian.PutUint64(id[:], uint64(i)) //nolint:gosec // i is positive
+		return id
+	}
+	var set spanIDSet
+	for i := 0; i < 2*maxSkippedSpans+1; i++ {
+		set.add(spanID(i))
+	}
+	// The oldest IDs, e.g. of spans which never ended, are forgotten.
+	assert.False(t, set.remove(spanID(maxSkippedSpans-1)))
+	assert.True(t, set.remove(spanID(maxSkippedSpans)))
+	assert.True(t, set.remove(spanID(2*maxSkippedSpans)))
+	assert.False(t, set.remove(spanID(2*maxSkippedSpans)))
+	assert.Len(t, set.previous, maxSkippedSpans-1)
+	assert.Empty(t, set.current)
+}
+
+func TestHubMetrics(t *testing.T) {
+	hub := newTestHub()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(hub.MetricReader()))
+	defer func() { require.NoError(t, mp.Shutdown(context.Background())) }()
+	meter := mp.Meter("test")
+
+	counter, err := meter.Int64Counter("counter")
+	require.NoError(t, err)
+	counter.Add(context.Background(), 2)
+	histogram, err := meter.Float64Histogram("histogram")
+	require.NoError(t, err)
+	histogram.Record(context.Background(), 1.5)
+
+	sub := hub.Subscribe(pipeline.SignalMetrics)
+	defer sub.Unsubscribe()
+	md, err := sub.Metrics(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, md.MetricCount())
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+
+	sum := metrics.At(0)
+	assert.Equal(t, "counter", sum.Name())
+	require.Equal(t, pmetric.MetricTypeSum, sum.Type())
+	assert.True(t, sum.Sum().IsMonotonic())
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, sum.Sum().AggregationTemporality())
+	assert.Equal(t, int64(2), sum.Sum().DataPoints().At(0).IntValue())
+
+	hist := metrics.At(1)
+	assert.Equal(t, "histogram", hist.Name())
+	require.Equal(t, pmetric.MetricTypeHistogram, hist.Type())
+	assert.Equal(t, uint64(1), hist.Histogram().DataPoints().At(0).Count())
+	assert.InDelta(t, 1.5, hist.Histogram().DataPoints().At(0).Sum(), 0.01)
+}
@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package selftelemetry // import "go.opentelemetry.io/collector/service/internal/selftelemetry"
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap/zapcore"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pipeline"
+)
+
+// scopeName is the instrumentation scope of the collected log records.
+const scopeName = "go.opentelemetry.io/collector/service"
+
+type logEntry struct {
+	entry  zapcore.Entry
+	fields map[string]any
+}
+
+// logsCore is a zapcore.Core buffering the log entries into the log subscriptions.
+type logsCore struct {
+	zapcore.LevelEnabler
+	hub    *Hub
+	fields []zapcore.Field
+	// skip is set on the loggers having the context of a self telemetry delivery as a field.
+	skip bool
+}
+
+// Enabled reports whether entries at the given level are collected.
+func (c *logsCore) Enabled(level zapcore.Level) bool {
+	return c.hub.numLogs.Load() > 0 && !c.skip && c.LevelEnabler.Enabled(level)
+}
+
+func (c *logsCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := *c
+	clone.fields = append(c.fields[:len(c.fields):len(c.fields)], fields...)
+	clone.skip = c.skip || hasSelfTelemetryContext(fields)
+	return &clone
+}
+
+func (c *logsCore) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return ce.AddCore(entry, c)
+	}
+	return ce
+}
+
+func (c *logsCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	if hasSelfTelemetryContext(fields) {
+		return nil
+	}
+	enc := zapcore.NewMapObjectEncoder()
+	for _, f := range c.fields {
+		f.AddTo(enc)
+	}
+	for _, f := range fields {
+		f.AddTo(enc)
+	}
+	le := logEntry{entry: entry, fields: enc.Fields}
+	c.hub.dispatch(pipeline.SignalLogs, func(s *Subscription) { s.addLog(le) })
+	return nil
+}
+
+func (c *logsCore) Sync() error {
+	return nil
+}
+
+// hasSelfTelemetryContext reports whether one of the fields holds the context of a self telemetry
+// delivery, e.g. a zapcore.SkipType field, which the encoders ignore, having the context as Interface.
+// The entries written with such a field are not collected, to avoid feedback loops.
+func hasSelfTelemetryContext(fields []zapcore.Field) bool {
+	for _, f := range fields {
+		if ctx, ok := f.Interface.(context.Context); ok && isSelfTelemetry(ctx) {
+			return true
+		}
+	}
+	return false
+}
+
+func logsFromEntries(res pcommon.Resource, entries []logEntry) plog.Logs {
+	ld := plog.NewLogs()
+	if len(entries) == 0 {
+		return ld
+	}
+	rl := ld.ResourceLogs().AppendEmpty()
+	res.CopyTo(rl.Resource())
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(scopeName)
+	records := sl.LogRecords()
+	records.EnsureCapacity(len(entries))
+	for _, le := range entries {
+		lr := records.AppendEmpty()
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(le.entry.Time))
+		lr.SetObservedTimestamp(pcommon.NewTimestampFromTime(le.entry.Time))
+		lr.SetSeverityNumber(severityNumber(le.entry.Level))
+		lr.SetSeverityText(le.entry.Level.CapitalString())
+		lr.Body().SetStr(le.entry.Message)
+		if le.entry.LoggerName != "" {
+			lr.Attributes().PutStr("logger", le.entry.LoggerName)
+		}
+		if le.entry.Caller.Defined {
+			lr.Attributes().PutStr("caller", le.entry.Caller.TrimmedPath())
+		}
+		if le.entry.Stack != "" {
+			lr.Attributes().PutStr("stacktrace", le.entry.Stack)
+		}
+		for k, v := range le.fields {
+			putRaw(lr.Attributes().PutEmpty(k), v)
+		}
+	}
+	return ld
+}
+
+// putRaw sets the value from one encoded by zapcore.MapObjectEncoder.
+func putRaw(dest pcommon.Value, v any) {
+	switch t := v.(type) {
+	case string, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, []byte:
+		_ = dest.FromRaw(t)
+	case map[string]any:
+		m := dest.SetEmptyMap()
+		for k, mv := range t {
+			putRaw(m.PutEmpty(k), mv)
+		}
+	case []any:
+		s := dest.SetEmptySlice()
+		for _, sv := range t {
+			putRaw(s.AppendEmpty(), sv)
+		}
+	default:
+		dest.SetStr(fmt.Sprint(t))
+	}
+}
+
+func severityNumber(level zapcore.Level) plog.SeverityNumber {
+	switch level {
+	case zapcore.DebugLevel:
+		return plog.SeverityNumberDebug
+	case zapcore.InfoLevel:
+		return plog.SeverityNumberInfo
+	case zapcore.WarnLevel:
+		return plog.SeverityNumberWarn
+	case zapcore.ErrorLevel:
+		return plog.SeverityNumberError
+	case zapcore.DPanicLevel, zapcore.PanicLevel:
+		return plog.SeverityNumberFatal
+	case zapcore.FatalLevel:
+		return plog.SeverityNumberFatal4
+	}
+	return plog.SeverityNumberUnspecified
+}
@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package selftelemetry // import "go.opentelemetry.io/collector/service/internal/selftelemetry"
+
+import (
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func metricsFromSDK(res pcommon.Resource, rm *metricdata.ResourceMetrics) pmetric.Metrics {
+	md := pmetric.NewMetrics()
+	if len(rm.ScopeMetrics) == 0 {
+		return md
+	}
+	rms := md.ResourceMetrics().AppendEmpty()
+	res.CopyTo(rms.Resource())
+	for _, sm := range rm.ScopeMetrics {
+		sms := rms.ScopeMetrics().AppendEmpty()
+		sms.Scope().SetName(sm.Scope.Name)
+		sms.Scope().SetVersion(sm.Scope.Version)
+		sms.SetSchemaUrl(sm.Scope.SchemaURL)
+		for _, m := range sm.Metrics {
+			dest := sms.Metrics().AppendEmpty()
+			dest.SetName(m.Name)
+			dest.SetDescription(m.Description)
+			dest.SetUnit(m.Unit)
+			switch data := m.Data.(type) {
+			case metricdata.Gauge[int64]:
+				gaugeFromSDK(data, dest.SetEmptyGauge())
+			case metricdata.Gauge[float64]:
+				gaugeFromSDK(data, dest.SetEmptyGauge())
+			case metricdata.Sum[int64]:
+				sumFromSDK(data, dest.SetEmptySum())
+			case metricdata.Sum[float64]:
+				sumFromSDK(data, dest.SetEmptySum())
+			case metricdata.Histogram[int64]:
+				histogramFromSDK(data, dest.SetEmptyHistogram())
+			case metricdata.Histogram[float64]:
+				histogramFromSDK(data, dest.SetEmptyHistogram())
+			case metricdata.ExponentialHistogram[int64]:
+				exponentialHistogramFromSDK(data, dest.SetEmptyExponentialHistogram())
+			case metricdata.ExponentialHistogram[float64]:
+				exponentialHistogramFromSDK(data, dest.SetEmptyExponentialHistogram())
+			}
+		}
+	}
+	return md
+}
+
+func temporality(t metricdata.Temporality) pmetric.AggregationTemporality {
+	switch t {
+	case metricdata.CumulativeTemporality:
+		return pmetric.AggregationTemporalityCumulative
+	case metricdata.DeltaTemporality:
+		return pmetric.AggregationTemporalityDelta
+	}
+	return pmetric.AggregationTemporalityUnspecified
+}
+
+func setNumberValue[N int64 | float64](dest pmetric.NumberDataPoint, value N) {
+	switch v := any(value).(type) {
+	case int64:
+		dest.SetIntValue(v)
+	case float64:
+		dest.SetDoubleValue(v)
+	}
+}
+
+func numberDataPointsFromSDK[N int64 | float64](dps []metricdata.DataPoint[N], dest pmetric.NumberDataPointSlice) {
+	dest.EnsureCapacity(len(dps))
+	for _, dp := range dps {
+		ndp := dest.AppendEmpty()
+		putAttributeSet(ndp.Attributes(), dp.Attributes)
+		ndp.SetStartTimestamp(pcommon.NewTimestampFromTime(dp.StartTime))
+		ndp.SetTimestamp(pcommon.NewTimestampFromTime(dp.Time))
+		setNumberValue(ndp, dp.Value)
+	}
+}
+
+func gaugeFromSDK[N int64 | float64](data metricdata.Gauge[N], dest pmetric.Gauge) {
+	numberDataPointsFromSDK(data.DataPoints, dest.DataPoints())
+}
+
+func sumFromSDK[N int64 | float64](data metricdata.Sum[N], dest pmetric.Sum) {
+	dest.SetIsMonotonic(data.IsMonotonic)
+	dest.SetAggregationTemporality(temporality(data.Temporality))
+	numberDataPointsFromSDK(data.DataPoints, dest.DataPoints())
+}
+
+func histogramFromSDK[N int64 | float64](data metricdata.Histogram[N], dest pmetric.Histogram) {
+	dest.SetAggregationTemporality(temporality(data.Temporality))
+	dest.DataPoints().EnsureCapacity(len(data.DataPoints))
+	for _, dp := range data.DataPoints {
+		hdp := dest.DataPoints().AppendEmpty()
+		putAttributeSet(hdp.Attributes(), dp.Attributes)
+		hdp.SetStartTimestamp(pcommon.NewTimestampFromTime(dp.StartTime))
+		hdp.SetTimestamp(pcommon.NewTimestampFromTime(dp.Time))
+		hdp.SetCount(dp.Count)
+		hdp.SetSum(float64(dp.Sum))
+		if v, ok := dp.Min.Value(); ok {
+			hdp.SetMin(float64(v))
+		}
+		if v, ok := dp.Max.Value(); ok {
+			hdp.SetMax(float64(v))
+		}
+		hdp.ExplicitBounds().FromRaw(dp.Bounds)
+		hdp.BucketCounts().FromRaw(dp.BucketCounts)
+	}
+}
+
+func exponentialHistogramFromSDK[N int64 | float64](data metricdata.ExponentialHistogram[N], dest pmetric.ExponentialHistogram) {
+	dest.SetAggregationTemporality(temporality(data.Temporality))
+	dest.DataPoints().EnsureCapacity(len(data.DataPoints))
+	for _, dp := range data.DataPoints {
+		edp := dest.DataPoints().AppendEmpty()
+		putAttributeSet(edp.Attributes(), dp.Attributes)
+		edp.SetStartTimestamp(pcommon.NewTimestampFromTime(dp.StartTime))
+		edp.SetTimestamp(pcommon.NewTimestampFromTime(dp.Time))
+		edp.SetCount(dp.Count)
+		edp.SetSum(float64(dp.Sum))
+		if v, ok := dp.Min.Value(); ok {
+			edp.SetMin(float64(v))
+		}
+		if v, ok := dp.Max.Value(); ok {
+			edp.SetMax(float64(v))
+		}
+		edp.SetScale(dp.Scale)
+		edp.SetZeroCount(dp.ZeroCount)
+		edp.SetZeroThreshold(dp.ZeroThreshold)
+		edp.Positive().SetOffset(dp.PositiveBucket.Offset)
+		edp.Positive().BucketCounts().FromRaw(dp.PositiveBucket.Counts)
+		edp.Negative().SetOffset(dp.NegativeBucket.Offset)
+		edp.Negative().BucketCounts().FromRaw(dp.NegativeBucket.Counts)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package selftelemetry // import "go.opentelemetry.io/collector/service/internal/selftelemetry"
+
+import (
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+func tracesFromSpans(res pcommon.Resource, spans []sdktrace.ReadOnlySpan) ptrace.Traces {
+	td := ptrace.NewTraces()
+	if len(spans) == 0 {
+		return td
+	}
+	rs := td.ResourceSpans().AppendEmpty()
+	res.CopyTo(rs.Resource())
+
+	scopes := map[instrumentation.Scope]ptrace.SpanSlice{}
+	for _, span := range spans {
+		dest, ok := scopes[span.InstrumentationScope()]
+		if !ok {
+			ss := rs.ScopeSpans().AppendEmpty()
+			ss.Scope().SetName(span.InstrumentationScope().Name)
+			ss.Scope().SetVersion(span.InstrumentationScope().Version)
+			ss.SetSchemaUrl(span.InstrumentationScope().SchemaURL)
+			dest = ss.Spans()
+			scopes[span.InstrumentationScope()] = dest
+		}
+		spanFromSDK(span, dest.AppendEmpty())
+	}
+	return td
+}
+
+func spanFromSDK(span sdktrace.ReadOnlySpan, dest ptrace.Span) {
+	sc := span.SpanContext()
+	dest.SetTraceID(pcommon.TraceID(sc.TraceID()))
+	dest.SetSpanID(pcommon.SpanID(sc.SpanID()))
+	dest.TraceState().FromRaw(sc.TraceState().String())
+	if span.Parent().HasSpanID() {
+		dest.SetParentSpanID(pcommon.SpanID(span.Parent().SpanID()))
+	}
+	dest.SetName(span.Name())
+	dest.SetKind(spanKind(span.SpanKind()))
+	dest.SetStartTimestamp(pcommon.NewTimestampFromTime(span.StartTime()))
+	dest.SetEndTimestamp(pcommon.NewTimestampFromTime(span.EndTime()))
+	putAttributes(dest.Attributes(), span.Attributes())
+	dest.SetDroppedAttributesCount(uint32(span.DroppedAttributes())) //nolint:gosec // the SDK limits are far below the uint32 range
+
+	for _, event := range span.Events() {
+		e := dest.Events().AppendEmpty()
+		e.SetName(event.Name)
+		e.SetTimestamp(pcommon.NewTimestampFromTime(event.Time))
+		putAttributes(e.Attributes(), event.Attributes)
+		e.SetDroppedAttributesCount(uint32(event.DroppedAttributeCount)) //nolint:gosec // the SDK limits are far below the uint32 range
+	}
+	dest.SetDroppedEventsCount(uint32(span.DroppedEvents())) //nolint:gosec // the SDK limits are far below the uint32 range
+
+	for _, link := range span.Links() {
+		l := dest.Links().AppendEmpty()
+		l.SetTraceID(pcommon.TraceID(link.SpanContext.TraceID()))
+		l.SetSpanID(pcommon.SpanID(link.SpanContext.SpanID()))
+		l.TraceState().FromRaw(link.SpanContext.TraceState().String())
+		putAttributes(l.Attributes(), link.Attributes)
+		l.SetDroppedAttributesCount(uint32(link.DroppedAttributeCount)) //nolint:gosec // the SDK limits are far below the uint32 range
+	}
+	dest.SetDroppedLinksCount(uint32(span.DroppedLinks())) //nolint:gosec // the SDK limits are far below the uint32 range
+
+	switch span.Status().Code {
+	case codes.Ok:
+		dest.Status().SetCode(ptrace.StatusCodeOk)
+	case codes.Error:
+		dest.Status().SetCode(ptrace.StatusCodeError)
+	}
+	dest.Status().SetMessage(span.Status().Description)
+}
+
+func spanKind(kind trace.SpanKind) ptrace.SpanKind {
+	switch kind {
+	case trace.SpanKindInternal:
+		return ptrace.SpanKindInternal
+	case trace.SpanKindServer:
+		return ptrace.SpanKindServer
+	case trace.SpanKindClient:
+		return ptrace.SpanKindClient
+	case trace.SpanKindProducer:
+		return ptrace.SpanKindProducer
+	case trace.SpanKindConsumer:
+		return ptrace.SpanKindConsumer
+	}
+	return ptrace.SpanKindUnspecified
+}
@@ -164,6 +164,10 @@ type FeatureGateTableRowData struct {
 	FromVersion  string
 	ToVersion    string
 	ReferenceURL string
+	// Age is the number of minor releases since the gate was added, empty if unknown.
+	Age string
+	// Expired is true if the collector version is past the removal version of the gate.
+	Expired bool
 }
 
 // WriteHTMLFeaturesTable writes a table summarizing registered feature gates.
@@ -14,9 +14,12 @@ import (
 	"go.opentelemetry.io/contrib/config"
 	"go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configtelemetry"
@@ -34,6 +37,7 @@ import (
 	"go.opentelemetry.io/collector/service/internal/graph"
 	"go.opentelemetry.io/collector/service/internal/proctelemetry"
 	"go.opentelemetry.io/collector/service/internal/resource"
+	"go.opentelemetry.io/collector/service/internal/selftelemetry"
 	"go.opentelemetry.io/collector/service/internal/status"
 	"go.opentelemetry.io/collector/service/telemetry"
 )
@@ -47,6 +51,10 @@ var _ = featuregate.GlobalRegistry().MustRegister(
 	featuregate.WithRegisterDescription("controls whether the collector supports extended OpenTelemetry"+
 		"configuration for internal telemetry"))
 
+// selfReceiverType is the type of the receivers delivering the collector's own telemetry,
+// see go.opentelemetry.io/collector/receiver/selfreceiver.
+var selfReceiverType = component.MustNewType("self")
+
 // Settings holds configuration for building a new Service.
 type Settings struct {
 	// BuildInfo provides collector start information.
@@ -151,6 +159,13 @@ func New(ctx context.Context, set Settings, cfg Config) (*Service, error) {
 		SDK:        &sdk,
 	}
 
+	// The collector's own telemetry is only collected in-process when a pipeline receives it.
+	var hub *selftelemetry.Hub
+	if hasSelfReceiver(cfg) {
+		hub = selftelemetry.NewHub(pcommonRes)
+		telset.MetricReaders = []sdkmetric.Reader{hub.MetricReader()}
+	}
+
 	logger, lp, err := telFactory.CreateLogger(ctx, telset, &cfg.Telemetry)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create logger: %w", err)
@@ -162,6 +177,18 @@ func New(ctx context.Context, set Settings, cfg Config) (*Service, error) {
 		return nil, fmt.Errorf("failed to create tracer provider: %w", err)
 	}
 
+	if hub != nil {
+		logger = logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return hub.WrapCore(core, cfg.Telemetry.Logs.Level)
+		}))
+		if tp, ok := tracerProvider.(interface {
+			RegisterSpanProcessor(sdktrace.SpanProcessor)
+		}); ok {
+			tp.RegisterSpanProcessor(hub.SpanProcessor())
+		}
+		srv.host.SelfTelemetry = hub
+	}
+
 	logger.Info("Setting up own telemetry...")
 
 	mp, err := telFactory.CreateMeterProvider(ctx, telset, &cfg.Telemetry)
@@ -170,6 +197,7 @@ func New(ctx context.Context, set Settings, cfg Config) (*Service, error) {
 	}
 
 	logsAboutMeterProvider(logger, cfg.Telemetry.Metrics, mp)
+	logsAboutExpiredFeatureGates(logger, featuregate.GlobalRegistry(), set.BuildInfo.Version)
 	srv.telemetrySettings = component.TelemetrySettings{
 		Logger:         logger,
 		MeterProvider:  mp,
@@ -203,6 +231,18 @@ func New(ctx context.Context, set Settings, cfg Config) (*Service, error) {
 	return srv, nil
 }
 
+// hasSelfReceiver returns whether any pipeline receives the collector's own telemetry.
+func hasSelfReceiver(cfg Config) bool {
+	for _, pipe := range cfg.Pipelines {
+		for _, id := range pipe.Receivers {
+			if id.Type() == selfReceiverType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func logsAboutMeterProvider(logger *zap.Logger, cfg telemetry.MetricsConfig, mp metric.MeterProvider) {
 	if cfg.Level == configtelemetry.LevelNone || len(cfg.Readers) == 0 {
 		logger.Info("Skipped telemetry setup.")
@@ -221,6 +261,23 @@ func logsAboutMeterProvider(logger *zap.Logger, cfg telemetry.MetricsConfig, mp
 	}
 }
 
+// logsAboutExpiredFeatureGates warns about the feature gates past their removal version, which
+// should have been removed from the collector. Development versions, e.g. "latest", are skipped.
+func logsAboutExpiredFeatureGates(logger *zap.Logger, reg *featuregate.Registry, version string) {
+	expired, err := reg.ExpiredGates(version)
+	if err != nil {
+		return
+	}
+	for _, g := range expired {
+		logger.Warn("Feature gate is past its removal version and should have been removed",
+			zap.String("id", g.ID()),
+			zap.String("stage", g.Stage().String()),
+			zap.String("to_version", g.ToVersion()),
+			zap.String("version", version),
+		)
+	}
+}
+
 // Start starts the extensions and pipelines. If Start fails Shutdown should be called to ensure a clean state.
 // Start does the following steps in order:
 // 1. Start all extensions.
@@ -20,18 +20,23 @@ import (
 	"go.opentelemetry.io/contrib/config"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componentstatus"
 	"go.opentelemetry.io/collector/config/confighttp"
 	"go.opentelemetry.io/collector/config/configtelemetry"
 	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/extension"
 	"go.opentelemetry.io/collector/extension/zpagesextension"
+	"go.opentelemetry.io/collector/featuregate"
 	"go.opentelemetry.io/collector/internal/testutil"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pipeline"
 	"go.opentelemetry.io/collector/pipeline/pipelineprofiles"
+	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/service/extensions"
 	"go.opentelemetry.io/collector/service/internal/builders"
 	"go.opentelemetry.io/collector/service/internal/promtest"
@@ -596,6 +601,7 @@ func assertZPages(t *testing.T, zpagesAddr string) {
 		"/debug/pipelinez",
 		"/debug/servicez",
 		"/debug/extensionz",
+		"/debug/featurez",
 	}
 
 	testZPagePathFn := func(t *testing.T, path string) {
@@ -724,3 +730,67 @@ func newConfigWatcherExtensionFactory(name component.Type) extension.Factory {
 func newPtr[T int | string](str T) *T {
 	return &str
 }
+
+func TestLogsAboutExpiredFeatureGates(t *testing.T) {
+	reg := featuregate.NewRegistry()
+	reg.MustRegister("alpha", featuregate.StageAlpha)
+	reg.MustRegister("stable", featuregate.StageStable, featuregate.WithRegisterToVersion("v0.110.0"))
+
+	core, observed := observer.New(zap.WarnLevel)
+	logsAboutExpiredFeatureGates(zap.New(core), reg, "v0.110.1")
+	assert.Zero(t, observed.Len())
+
+	logsAboutExpiredFeatureGates(zap.New(core), reg, "latest")
+	assert.Zero(t, observed.Len())
+
+	logsAboutExpiredFeatureGates(zap.New(core), reg, "v0.111.0")
+	require.Equal(t, 1, observed.Len())
+	entry := observed.All()[0]
+	assert.Equal(t, "Feature gate is past its removal version and should have been removed", entry.Message)
+	assert.Equal(t, "stable", entry.ContextMap()["id"])
+	assert.Equal(t, "v0.110.0", entry.ContextMap()["to_version"])
+}
+
+func TestServiceSelfTelemetry(t *testing.T) {
+	set := newNopSettings()
+	// The self receivers are detected by their type, see go.opentelemetry.io/collector/receiver/selfreceiver.
+	selfFactory := receiver.NewFactory(selfReceiverType, func() component.Config { return &struct{}{} },
+		receiver.WithLogs(func(context.Context, receiver.Settings, component.Config, consumer.Logs) (receiver.Logs, error) {
+			return struct {
+				component.StartFunc
+				component.ShutdownFunc
+			}{}, nil
+		}, component.StabilityLevelAlpha))
+	set.ReceiversFactories[selfFactory.Type()] = selfFactory
+	set.ReceiversConfigs[component.NewID(selfFactory.Type())] = selfFactory.CreateDefaultConfig()
+
+	cfg := newNopConfig()
+	cfg.Pipelines[pipeline.NewID(pipeline.SignalLogs)].Receivers = []component.ID{component.NewID(selfFactory.Type())}
+
+	srv, err := New(context.Background(), set, cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, srv.Shutdown(context.Background()))
+	})
+
+	sub, ok := srv.host.GetSelfTelemetry(pipeline.SignalLogs).(interface {
+		Logs() (plog.Logs, int)
+		Unsubscribe()
+	})
+	require.True(t, ok)
+	defer sub.Unsubscribe()
+	srv.telemetrySettings.Logger.Info("collected")
+	srv.telemetrySettings.Logger.Debug("below the configured level")
+	ld, _ := sub.Logs()
+	require.Equal(t, 1, ld.LogRecordCount())
+	assert.Equal(t, "collected", ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Body().Str())
+}
+
+func TestServiceWithoutSelfTelemetry(t *testing.T) {
+	srv, err := New(context.Background(), newNopSettings(), newNopConfig())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, srv.Shutdown(context.Background()))
+	})
+	assert.Nil(t, srv.host.GetSelfTelemetry(pipeline.SignalLogs))
+}
@@ -15,17 +15,12 @@ import (
 
 	"go.opentelemetry.io/collector/config/configtelemetry"
 	"go.opentelemetry.io/collector/confmap"
-	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/service/internal/metadata"
 )
 
 var _ confmap.Unmarshaler = (*Config)(nil)
 
-var disableAddressFieldForInternalTelemetryFeatureGate = featuregate.GlobalRegistry().MustRegister(
-	"telemetry.disableAddressFieldForInternalTelemetry",
-	featuregate.StageAlpha,
-	featuregate.WithRegisterFromVersion("v0.111.0"),
-	featuregate.WithRegisterToVersion("v0.114.0"),
-	featuregate.WithRegisterDescription("controls whether the deprecated address field for internal telemetry is still supported"))
+var disableAddressFieldForInternalTelemetryFeatureGate = metadata.TelemetryDisableAddressFieldForInternalTelemetryFeatureGate
 
 // Config defines the configurable settings for service telemetry.
 type Config struct {
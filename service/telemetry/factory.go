@@ -10,30 +10,22 @@ import (
 	"go.opentelemetry.io/contrib/config"
 	"go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config/configtelemetry"
-	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/service/internal/metadata"
 	"go.opentelemetry.io/collector/service/internal/resource"
 )
 
-var useLocalHostAsDefaultMetricsAddressFeatureGate = featuregate.GlobalRegistry().MustRegister(
-	"telemetry.UseLocalHostAsDefaultMetricsAddress",
-	featuregate.StageBeta,
-	featuregate.WithRegisterFromVersion("v0.111.0"),
-	featuregate.WithRegisterDescription("controls whether default Prometheus metrics server use localhost as the default host for their endpoints"),
-)
+var useLocalHostAsDefaultMetricsAddressFeatureGate = metadata.TelemetryUseLocalHostAsDefaultMetricsAddressFeatureGate
 
 // disableHighCardinalityMetricsFeatureGate is the feature gate that controls whether the collector should enable
 // potentially high cardinality metrics. The gate will be removed when the collector allows for view configuration.
-var disableHighCardinalityMetricsFeatureGate = featuregate.GlobalRegistry().MustRegister(
-	"telemetry.disableHighCardinalityMetrics",
-	featuregate.StageAlpha,
-	featuregate.WithRegisterDescription("controls whether the collector should enable potentially high"+
-		"cardinality metrics. The gate will be removed when the collector allows for view configuration."))
+var disableHighCardinalityMetricsFeatureGate = metadata.TelemetryDisableHighCardinalityMetricsFeatureGate
 
 // Settings holds configuration for building Telemetry.
 type Settings struct {
@@ -41,6 +33,10 @@ type Settings struct {
 	AsyncErrorChannel chan error
 	ZapOptions        []zap.Option
 	SDK               *config.SDK
+
+	// MetricReaders are registered on the created MeterProvider in addition to
+	// the configured readers, e.g. to collect the metrics in-process.
+	MetricReaders []sdkmetric.Reader
 }
 
 // Factory is factory interface for telemetry.
@@ -83,6 +79,7 @@ func NewFactory() Factory {
 					res:               resource.New(set.BuildInfo, c.Resource),
 					cfg:               c.Metrics,
 					asyncErrorChannel: set.AsyncErrorChannel,
+					readers:           set.MetricReaders,
 				},
 				disableHighCard,
 			)
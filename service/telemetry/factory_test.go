@@ -12,6 +12,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/contrib/config"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
@@ -164,3 +166,26 @@ func TestSampledLogger(t *testing.T) {
 		})
 	}
 }
+
+func TestMeterProviderMetricReaders(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	cfg := NewFactory().CreateDefaultConfig().(*Config)
+	cfg.Metrics.Readers = nil
+
+	mp, err := NewFactory().CreateMeterProvider(context.Background(), Settings{MetricReaders: []sdkmetric.Reader{reader}}, cfg)
+	require.NoError(t, err)
+	defer func() {
+		if prov, ok := mp.(interface{ Shutdown(context.Context) error }); ok {
+			assert.NoError(t, prov.Shutdown(context.Background()))
+		}
+	}()
+
+	counter, err := mp.Meter("test").Int64Counter("counter")
+	require.NoError(t, err)
+	counter.Add(context.Background(), 1)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	assert.Equal(t, "counter", rm.ScopeMetrics[0].Metrics[0].Name)
+}
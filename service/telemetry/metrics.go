@@ -34,11 +34,12 @@ type meterProviderSettings struct {
 	res               *resource.Resource
 	cfg               MetricsConfig
 	asyncErrorChannel chan error
+	readers           []sdkmetric.Reader
 }
 
 // newMeterProvider creates a new MeterProvider from Config.
 func newMeterProvider(set meterProviderSettings, disableHighCardinality bool) (metric.MeterProvider, error) {
-	if set.cfg.Level == configtelemetry.LevelNone || len(set.cfg.Readers) == 0 {
+	if set.cfg.Level == configtelemetry.LevelNone || (len(set.cfg.Readers) == 0 && len(set.readers) == 0) {
 		return noop.NewMeterProvider(), nil
 	}
 
@@ -55,6 +56,9 @@ func newMeterProvider(set meterProviderSettings, disableHighCardinality bool) (m
 		}
 		opts = append(opts, sdkmetric.WithReader(r))
 	}
+	for _, r := range set.readers {
+		opts = append(opts, sdkmetric.WithReader(r))
+	}
 
 	var err error
 	mp.MeterProvider, err = otelinit.InitOpenTelemetry(set.res, opts, disableHighCardinality)
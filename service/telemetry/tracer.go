@@ -15,14 +15,10 @@ import (
 	"go.opentelemetry.io/otel/trace/noop"
 
 	"go.opentelemetry.io/collector/config/configtelemetry"
-	"go.opentelemetry.io/collector/featuregate"
+	"go.opentelemetry.io/collector/service/internal/metadata"
 )
 
-var noopTracerProvider = featuregate.GlobalRegistry().MustRegister("service.noopTracerProvider",
-	featuregate.StageAlpha,
-	featuregate.WithRegisterFromVersion("v0.107.0"),
-	featuregate.WithRegisterToVersion("v0.109.0"),
-	featuregate.WithRegisterDescription("Sets a Noop OpenTelemetry TracerProvider to reduce memory allocations. This featuregate is incompatible with the zPages extension."))
+var noopTracerProvider = metadata.ServiceNoopTracerProviderFeatureGate
 
 const (
 	// supported trace propagators